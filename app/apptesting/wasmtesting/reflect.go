@@ -0,0 +1,161 @@
+// Package wasmtesting provides the reflect contract and app setup shared by the
+// omniflix wasm bindings tests.
+package wasmtesting
+
+import (
+	_ "embed"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	onftkeeper "github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// reflectCode forwards execute msgs as custom msgs and query msgs as custom queries,
+// and requires the onft, marketplace, itc and medianode capabilities
+//
+//go:embed testdata/omniflix_reflect.wasm
+var reflectCode []byte
+
+func CreateTestInput(t *testing.T) (*app.OmniFlixApp, sdk.Context) {
+	t.Helper()
+	tdir, _ := os.MkdirTemp(os.TempDir(), "omniflixhub-test-home")
+	omniflix := app.SetupWithCustomHome(false, tdir)
+	ctx := omniflix.BaseApp.NewContextLegacy(false, tmproto.Header{Height: 1, ChainID: "omniflixhub-1", Time: time.Now().UTC()})
+	return omniflix, ctx
+}
+
+func RandomAccountAddress() sdk.AccAddress {
+	return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+}
+
+func FundAccount(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+
+	err := banktestutil.FundAccount(
+		ctx,
+		customApp.AppKeepers.BankKeeper,
+		addr,
+		coins,
+	)
+	require.NoError(t, err)
+}
+
+func storeReflectCode(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, addr sdk.AccAddress) {
+	t.Helper()
+
+	// Quick hack to allow code upload
+	originalParams := customApp.WasmKeeper.GetParams(ctx)
+	temporaryParams := originalParams
+	temporaryParams.CodeUploadAccess.Permission = wasmtypes.AccessTypeEverybody
+	_ = customApp.WasmKeeper.SetParams(ctx, temporaryParams)
+
+	msg := wasmtypes.MsgStoreCodeFixture(func(m *wasmtypes.MsgStoreCode) {
+		m.WASMByteCode = reflectCode
+		m.Sender = addr.String()
+	})
+	_, err := customApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+
+	_ = customApp.WasmKeeper.SetParams(ctx, originalParams)
+}
+
+// SetupCustomApp creates a test app and stores the reflect contract as code id 1
+func SetupCustomApp(t *testing.T, addr sdk.AccAddress) (*app.OmniFlixApp, sdk.Context) {
+	t.Helper()
+
+	customApp, ctx := CreateTestInput(t)
+	wasmKeeper := customApp.AppKeepers.WasmKeeper
+
+	storeReflectCode(t, ctx, customApp, addr)
+
+	cInfo := wasmKeeper.GetCodeInfo(ctx, 1)
+	require.NotNil(t, cInfo)
+
+	return customApp, ctx
+}
+
+func InstantiateReflectContract(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, funder sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+
+	initMsgBz := []byte("{}")
+	contractKeeper := keeper.NewDefaultPermissionKeeper(customApp.AppKeepers.WasmKeeper)
+	codeID := uint64(1)
+	addr, _, err := contractKeeper.Instantiate(ctx, codeID, funder, funder, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+
+	return addr
+}
+
+// ExecuteCustom executes the reflect contract, which dispatches customMsg as a custom msg
+func ExecuteCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract, sender sdk.AccAddress, customMsg interface{}) error {
+	t.Helper()
+
+	customBz, err := json.Marshal(customMsg)
+	require.NoError(t, err)
+
+	contractKeeper := keeper.NewDefaultPermissionKeeper(customApp.AppKeepers.WasmKeeper)
+	_, err = contractKeeper.Execute(ctx, contract, sender, customBz, nil)
+	return err
+}
+
+// QueryCustom queries the reflect contract, which forwards customQuery as a custom chain query,
+// and decodes the result into response
+func QueryCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, customQuery, response interface{}) {
+	t.Helper()
+
+	queryBz, err := json.Marshal(customQuery)
+	require.NoError(t, err)
+
+	resBz, err := customApp.AppKeepers.WasmKeeper.QuerySmart(ctx, contract, queryBz)
+	require.NoError(t, err)
+	err = json.Unmarshal(resBz, response)
+	require.NoError(t, err)
+}
+
+// CreateTestONFT creates a denom of the creator and mints a transferable oNFT to the owner
+func CreateTestONFT(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, creator, owner sdk.AccAddress, denomId, onftId string) {
+	t.Helper()
+
+	msgServer := onftkeeper.NewMsgServerImpl(customApp.ONFTKeeper)
+	if _, err := customApp.ONFTKeeper.GetDenomInfo(ctx, denomId); err != nil {
+		FundAccount(t, ctx, customApp, creator, sdk.NewCoins(onfttypes.DefaultDenomCreationFee))
+		msgCreateDenom := onfttypes.NewMsgCreateDenom(
+			denomId, denomId, "", "", "", "", "", "",
+			creator.String(),
+			onfttypes.DefaultDenomCreationFee,
+			nil, false, 0, false, false,
+		)
+		msgCreateDenom.Id = denomId
+		_, err := msgServer.CreateDenom(ctx, msgCreateDenom)
+		require.NoError(t, err)
+	}
+
+	msgMintONFT := onfttypes.NewMsgMintONFT(
+		denomId,
+		creator.String(),
+		owner.String(),
+		onfttypes.Metadata{Name: onftId, MediaURI: "ipfs://" + onftId},
+		"", true, true, false,
+		sdkmath.LegacyZeroDec(),
+		nil,
+		nil,
+	)
+	msgMintONFT.Id = onftId
+	_, err := msgServer.MintONFT(ctx, msgMintONFT)
+	require.NoError(t, err)
+}
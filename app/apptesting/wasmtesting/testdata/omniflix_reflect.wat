@@ -0,0 +1,103 @@
+;; omniflix-reflect: a minimal CosmWasm contract used to test the omniflix custom bindings.
+;;
+;; execute forwards the execute message as a custom message:
+;;   {"onft": {...}} -> CosmosMsg::Custom({"onft": {...}})
+;; query forwards the query message as a custom chain query and returns its result:
+;;   {"onft": {...}} -> QueryRequest::Custom({"onft": {...}})
+;;
+;; Regions are {offset: u32, capacity: u32, length: u32}, memory is a bump allocator.
+(module
+  (type $t_i32_i32 (func (param i32) (result i32)))
+  (type $t_i32 (func (param i32)))
+  (type $t_void (func))
+  (type $t_entry (func (param i32 i32 i32) (result i32)))
+  (type $t_query (func (param i32 i32) (result i32)))
+  (type $t_copy (func (param i32 i32 i32)))
+
+  (import "env" "query_chain" (func $query_chain (type $t_i32_i32)))
+
+  (memory (export "memory") 2)
+  (global $heap (mut i32) (i32.const 65536))
+
+  ;; {"ok":{"messages":[],"attributes":[],"events":[]}}
+  (data (i32.const 1024) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[]}}")
+  ;; execute response around the forwarded custom message
+  (data (i32.const 2048) "{\"ok\":{\"messages\":[{\"id\":0,\"msg\":{\"custom\":")
+  (data (i32.const 2304) "},\"reply_on\":\"never\"}],\"attributes\":[],\"events\":[]}}")
+  ;; custom query request around the forwarded query message
+  (data (i32.const 2560) "{\"custom\":")
+  (data (i32.const 2600) "}")
+
+  (func $allocate (export "allocate") (type $t_i32_i32) (param $size i32) (result i32)
+    (local $region i32)
+    (local.set $region (global.get $heap))
+    (i32.store offset=0 (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    ;; heap = align8(region + 12 + size)
+    (global.set $heap
+      (i32.and (i32.add (i32.add (local.get $region) (i32.const 19)) (local.get $size)) (i32.const -8)))
+    (block $done
+      (loop $grow
+        (br_if $done (i32.le_u (global.get $heap) (i32.shl (memory.size) (i32.const 16))))
+        (drop (memory.grow (i32.const 1)))
+        (br $grow)))
+    (local.get $region))
+
+  (func $deallocate (export "deallocate") (type $t_i32) (param $region i32))
+
+  (func $interface_version_8 (export "interface_version_8") (type $t_void))
+
+  (func $requires_onft (export "requires_onft") (type $t_void))
+  (func $requires_marketplace (export "requires_marketplace") (type $t_void))
+  (func $requires_itc (export "requires_itc") (type $t_void))
+  (func $requires_medianode (export "requires_medianode") (type $t_void))
+
+  (func $copy (type $t_copy) (param $dst i32) (param $src i32) (param $n i32)
+    (block $done
+      (loop $next
+        (br_if $done (i32.eqz (local.get $n)))
+        (i32.store8 (local.get $dst) (i32.load8_u (local.get $src)))
+        (local.set $dst (i32.add (local.get $dst) (i32.const 1)))
+        (local.set $src (i32.add (local.get $src) (i32.const 1)))
+        (local.set $n (i32.sub (local.get $n) (i32.const 1)))
+        (br $next))))
+
+  (func $instantiate (export "instantiate") (type $t_entry)
+    (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (local $res i32)
+    (local.set $res (call $allocate (i32.const 50)))
+    (call $copy (i32.load (local.get $res)) (i32.const 1024) (i32.const 50))
+    (i32.store offset=8 (local.get $res) (i32.const 50))
+    (local.get $res))
+
+  (func $execute (export "execute") (type $t_entry)
+    (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (local $res i32) (local $len i32) (local $dst i32)
+    (local.set $len (i32.load offset=8 (local.get $msg)))
+    (local.set $res (call $allocate (i32.add (local.get $len) (i32.const 95))))
+    (local.set $dst (i32.load (local.get $res)))
+    (call $copy (local.get $dst) (i32.const 2048) (i32.const 43))
+    (call $copy (i32.add (local.get $dst) (i32.const 43)) (i32.load (local.get $msg)) (local.get $len))
+    (call $copy (i32.add (i32.add (local.get $dst) (i32.const 43)) (local.get $len)) (i32.const 2304) (i32.const 52))
+    (i32.store offset=8 (local.get $res) (i32.add (local.get $len) (i32.const 95)))
+    (local.get $res))
+
+  (func $query (export "query") (type $t_query)
+    (param $env i32) (param $msg i32) (result i32)
+    (local $req i32) (local $len i32) (local $dst i32) (local $res i32)
+    (local.set $len (i32.load offset=8 (local.get $msg)))
+    (local.set $req (call $allocate (i32.add (local.get $len) (i32.const 11))))
+    (local.set $dst (i32.load (local.get $req)))
+    (call $copy (local.get $dst) (i32.const 2560) (i32.const 10))
+    (call $copy (i32.add (local.get $dst) (i32.const 10)) (i32.load (local.get $msg)) (local.get $len))
+    (call $copy (i32.add (i32.add (local.get $dst) (i32.const 10)) (local.get $len)) (i32.const 2600) (i32.const 1))
+    (i32.store offset=8 (local.get $req) (i32.add (local.get $len) (i32.const 11)))
+    ;; the chain responds {"ok":{"ok":"<base64>"}} or {"ok":{"error":"<msg>"}},
+    ;; the inner contract result is returned by trimming the outer {"ok": and }
+    (local.set $res (call $query_chain (local.get $req)))
+    (i32.store offset=0 (local.get $res) (i32.add (i32.load offset=0 (local.get $res)) (i32.const 6)))
+    (i32.store offset=8 (local.get $res) (i32.sub (i32.load offset=8 (local.get $res)) (i32.const 7)))
+    (i32.store offset=4 (local.get $res) (i32.load offset=8 (local.get $res)))
+    (local.get $res))
+)
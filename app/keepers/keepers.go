@@ -121,6 +121,10 @@ import (
 	ibcnfttransferkeeper "github.com/bianjieai/nft-transfer/keeper"
 	ibcnfttransfertypes "github.com/bianjieai/nft-transfer/types"
 
+	itcbindings "github.com/OmniFlix/omniflixhub/v6/x/itc/bindings"
+	marketplacebindings "github.com/OmniFlix/omniflixhub/v6/x/marketplace/bindings"
+	medianodebindings "github.com/OmniFlix/omniflixhub/v6/x/medianode/bindings"
+	onftbindings "github.com/OmniFlix/omniflixhub/v6/x/onft/bindings"
 	tfbindings "github.com/OmniFlix/omniflixhub/v6/x/tokenfactory/bindings"
 
 	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
@@ -510,6 +514,7 @@ func NewAppKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
+		appKeepers.TokenFactoryKeeper,
 		govModAddress,
 	)
 
@@ -521,7 +526,13 @@ func NewAppKeeper(
 		appKeepers.IBCKeeper.ChannelKeeper,
 		appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
-		ics721nft.NewKeeper(appCodec, appKeepers.ONFTKeeper, appKeepers.AccountKeeper, appKeepers.BankKeeper),
+		ics721nft.NewKeeper(
+			appCodec,
+			appKeepers.ONFTKeeper,
+			appKeepers.AccountKeeper,
+			appKeepers.BankKeeper,
+			appKeepers.IBCKeeper.ChannelKeeper,
+		),
 		appKeepers.ScopedNFTTransferKeeper,
 	)
 
@@ -598,6 +609,18 @@ func NewAppKeeper(
 	tfOpts := tfbindings.RegisterCustomPlugins(appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper)
 	wasmOpts = append(wasmOpts, tfOpts...)
 
+	// custom onft messages and queries
+	onftOpts := onftbindings.RegisterCustomPlugins(&appKeepers.ONFTKeeper)
+	wasmOpts = append(wasmOpts, onftOpts...)
+
+	// custom marketplace, itc and medianode messages and queries
+	marketplaceOpts := marketplacebindings.RegisterCustomPlugins(&appKeepers.MarketplaceKeeper)
+	wasmOpts = append(wasmOpts, marketplaceOpts...)
+	itcOpts := itcbindings.RegisterCustomPlugins(&appKeepers.ItcKeeper)
+	wasmOpts = append(wasmOpts, itcOpts...)
+	medianodeOpts := medianodebindings.RegisterCustomPlugins(&appKeepers.MedianodeKeeper)
+	wasmOpts = append(wasmOpts, medianodeOpts...)
+
 	querierOpts := wasmkeeper.WithQueryPlugins(
 		&wasmkeeper.QueryPlugins{
 			Stargate: wasmkeeper.AcceptListStargateQuerier(
@@ -2,8 +2,12 @@ package keepers
 
 import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	itcbindings "github.com/OmniFlix/omniflixhub/v6/x/itc/bindings"
 	itctypes "github.com/OmniFlix/omniflixhub/v6/x/itc/types"
+	marketplacebindings "github.com/OmniFlix/omniflixhub/v6/x/marketplace/bindings"
 	marketplacetypes "github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+	medianodebindings "github.com/OmniFlix/omniflixhub/v6/x/medianode/bindings"
+	onftbindings "github.com/OmniFlix/omniflixhub/v6/x/onft/bindings"
 	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 	tokenfactorytypes "github.com/OmniFlix/omniflixhub/v6/x/tokenfactory/types"
 	streampaytypes "github.com/OmniFlix/streampay/v2/x/streampay/types"
@@ -28,6 +32,10 @@ var wasmCapabilities = []string{
 	"cosmwasm_1_4",
 	"cosmwasm_1_5",
 	"token_factory",
+	onftbindings.Capability,
+	marketplacebindings.Capability,
+	itcbindings.Capability,
+	medianodebindings.Capability,
 }
 
 func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
@@ -61,6 +69,7 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/OmniFlix.onft.v1beta1.Query/ONFT":          &onfttypes.QueryONFTResponse{},
 		"/OmniFlix.onft.v1beta1.Query/Supply":        &onfttypes.QuerySupplyResponse{},
 		"/OmniFlix.onft.v1beta1.Query/Params":        &onfttypes.QueryParamsResponse{},
+		"/OmniFlix.onft.v1beta1.Query/UserOf":        &onfttypes.QueryUserOfResponse{},
 
 		// marketplace
 		"/OmniFlix.marketplace.v1beta1.Query/Listings":        &marketplacetypes.QueryListingsResponse{},
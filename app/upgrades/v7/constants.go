@@ -0,0 +1,16 @@
+package v7
+
+import (
+	store "cosmossdk.io/store/types"
+	"github.com/OmniFlix/omniflixhub/v6/app/upgrades"
+)
+
+const UpgradeName = "v7"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateV7UpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{},
+	},
+}
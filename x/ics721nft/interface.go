@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
 type (
@@ -16,6 +17,10 @@ type (
 	BankKeeper interface {
 		BlockedAddr(addr sdk.AccAddress) bool
 	}
+	// ChannelKeeper defines the contract required for channel APIs.
+	ChannelKeeper interface {
+		GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+	}
 
 	ICS721Class struct {
 		ID   string
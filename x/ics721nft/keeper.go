@@ -8,7 +8,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	"cosmossdk.io/log"
-	"cosmossdk.io/x/nft"
 	nftkeeper "cosmossdk.io/x/nft/keeper"
 	onftkeeper "github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
 	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
@@ -23,9 +22,11 @@ import (
 // Keeper defines the ICS721 Keeper
 type Keeper struct {
 	nk  nftkeeper.Keeper
+	ok  onftkeeper.Keeper
 	cdc codec.Codec
 	ak  AccountKeeper
 	bk  BankKeeper
+	ck  ChannelKeeper
 	cb  onfttypes.ClassBuilder
 	nb  onfttypes.NFTBuilder
 }
@@ -35,12 +36,15 @@ func NewKeeper(cdc codec.Codec,
 	k onftkeeper.Keeper,
 	ak AccountKeeper,
 	bk BankKeeper,
+	ck ChannelKeeper,
 ) Keeper {
 	return Keeper{
 		nk:  k.NFTkeeper(),
+		ok:  k,
 		cdc: cdc,
 		ak:  ak,
 		bk:  bk,
+		ck:  ck,
 		cb:  onfttypes.NewClassBuilder(cdc, ak.GetModuleAddress),
 		nb:  onfttypes.NewNFTBuilder(cdc),
 	}
@@ -52,37 +56,14 @@ func (k Keeper) CreateOrUpdateClass(ctx sdk.Context,
 	classURI,
 	classData string,
 ) error {
-	var (
-		class nft.Class
-		err   error
-	)
-	if len(classData) != 0 {
-		class, err = k.cb.Build(classID, classURI, classData)
-		if err != nil {
-			k.Logger(ctx).Error("unable to build class from packet data", "error:", err.Error())
-			return err
-		}
-	} else {
-		denomMetadata := &onfttypes.DenomMetadata{
-			Creator:          k.ak.GetModuleAddress(onfttypes.ModuleName).String(),
-			PreviewUri:       "",
-			Schema:           "",
-			Description:      "",
-			Data:             "",
-			UriHash:          "",
-			RoyaltyReceivers: nil,
-		}
-
-		metadata, err := codectypes.NewAnyWithValue(denomMetadata)
-		if err != nil {
-			k.Logger(ctx).Error("unable to build class metadata from packet data", "error:", err.Error())
-			return err
-		}
-		class = nft.Class{
-			Id:   classID,
-			Uri:  classURI,
-			Data: metadata,
-		}
+	// packets without class data don't overwrite the metadata of an existing class
+	if len(classData) == 0 && k.nk.HasClass(ctx, classID) {
+		return nil
+	}
+	class, err := k.cb.Build(classID, classURI, classData)
+	if err != nil {
+		k.Logger(ctx).Error("unable to build class from packet data", "error:", err.Error())
+		return err
 	}
 	var message proto.Message
 	if err := k.cdc.UnpackAny(class.Data, &message); err != nil {
@@ -119,7 +100,11 @@ func (k Keeper) Mint(ctx sdk.Context,
 		k.Logger(ctx).Error("unable to build token from packet data", "error:", err.Error())
 		return err
 	}
-	return k.nk.Mint(ctx, token, receiver)
+	if err := k.nk.Mint(ctx, token, receiver); err != nil {
+		return err
+	}
+	k.ok.RecordOwnershipChange(ctx, classID, tokenID, nil, receiver, onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_IBC)
+	return k.ok.IndexONFTTraits(ctx, classID, tokenID)
 }
 
 // Transfer implement the method of ICS721Keeper.Transfer
@@ -139,9 +124,17 @@ func (k Keeper) Transfer(
 		k.Logger(ctx).Error("non-transferable nft")
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "non-transferable nft")
 	}
+	owner := k.nk.GetOwner(ctx, classID, tokenID)
+	// nfts released from a channel escrow on receive or refund are not restricted
+	if !k.isEscrowAddress(ctx, owner) {
+		if err := k.validateSend(ctx, classID, tokenID); err != nil {
+			return err
+		}
+	}
 	if err := k.nk.Transfer(ctx, classID, tokenID, receiver); err != nil {
 		return err
 	}
+	k.ok.RecordOwnershipChange(ctx, classID, tokenID, owner, receiver, onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_IBC)
 	return nil
 }
 
@@ -185,6 +178,12 @@ func (k Keeper) GetNFT(ctx sdk.Context, classID, tokenID string) (nfttransfer.NF
 
 // Burn implement the method of ICS721Keeper.Burn
 func (k Keeper) Burn(ctx sdk.Context, classID string, tokenID string) error {
+	// vouchers are only burned when sent back to their origin chain
+	if err := k.validateSend(ctx, classID, tokenID); err != nil {
+		return err
+	}
+	k.ok.UnindexONFTTraits(ctx, classID, tokenID)
+	k.ok.DeleteOwnershipHistory(ctx, classID, tokenID)
 	return k.nk.Burn(ctx, classID, tokenID)
 }
 
@@ -203,6 +202,25 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "ics721/NFTKeeper")
 }
 
+// validateSend returns an error if the nft can't leave the chain, nested nfts
+// are not carried by the packet so nfts with children can't be sent
+func (k Keeper) validateSend(ctx sdk.Context, classID, tokenID string) error {
+	if k.ok.HasChildren(ctx, classID, tokenID) {
+		return errorsmod.Wrapf(onfttypes.ErrHasChildren, "nft %s has nested nfts", tokenID)
+	}
+	return k.ok.ValidateTransferRestrictions(ctx, classID, tokenID)
+}
+
+// isEscrowAddress returns true if the address is the escrow account of a nft-transfer channel
+func (k Keeper) isEscrowAddress(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, channel := range k.ck.GetAllChannelsWithPortPrefix(ctx, nfttransfer.PortID) {
+		if nfttransfer.GetEscrowAddress(channel.PortId, channel.ChannelId).Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
 func (k Keeper) validRoyaltyReceiverAddresses(addresses []*onfttypes.WeightedAddress) bool {
 	weightSum := sdkmath.LegacyNewDec(0)
 	for _, addr := range addresses {
@@ -0,0 +1,286 @@
+package ics721nft_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting"
+	"github.com/OmniFlix/omniflixhub/v6/x/ics721nft"
+	onftkeeper "github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+	nfttransfer "github.com/bianjieai/nft-transfer/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	// counterparty is a second chain receiving the nfts sent from the suite chain
+	counterparty *apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+	suite.counterparty = &apptesting.KeeperTestHelper{}
+	suite.counterparty.SetT(suite.T())
+	suite.counterparty.SetupWithCustomChainId("omniflixhub-2")
+
+	fee := sdk.NewCoins(onfttypes.DefaultDenomCreationFee)
+	for _, acc := range suite.TestAccs {
+		suite.FundAcc(acc, fee)
+	}
+}
+
+func newICS721Keeper(a *app.OmniFlixApp) ics721nft.Keeper {
+	return ics721nft.NewKeeper(
+		a.AppCodec(),
+		a.ONFTKeeper,
+		a.AccountKeeper,
+		a.BankKeeper,
+		a.IBCKeeper.ChannelKeeper,
+	)
+}
+
+func (suite *KeeperTestSuite) createDenomAndMint(data, nftData string) (string, string) {
+	creator := suite.TestAccs[0]
+	msgServer := onftkeeper.NewMsgServerImpl(suite.App.ONFTKeeper)
+	royaltyReceivers := []*onfttypes.WeightedAddress{
+		{Address: creator.String(), Weight: sdkmath.LegacyNewDecWithPrec(6, 1)},
+		{Address: suite.TestAccs[1].String(), Weight: sdkmath.LegacyNewDecWithPrec(4, 1)},
+	}
+
+	createMsg := onfttypes.NewMsgCreateDenom(
+		"ics", "ics721 denom", "", "round trip denom", "ipfs://denom", "denomhash",
+		"ipfs://denompreview", data, creator.String(), onfttypes.DefaultDenomCreationFee,
+		royaltyReceivers, true, 10, true, true,
+	)
+	_, err := msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+
+	lockedUntil := suite.Ctx.BlockTime().Add(-time.Hour).UTC()
+	mintMsg := onfttypes.NewMsgMintONFT(
+		createMsg.Id, creator.String(), creator.String(),
+		onfttypes.Metadata{
+			Name:        "ics721 onft",
+			Description: "round trip onft",
+			MediaURI:    "ipfs://onft",
+			UriHash:     "onfthash",
+			PreviewURI:  "ipfs://onftpreview",
+		},
+		nftData, true, false, true, sdkmath.LegacyNewDecWithPrec(5, 2), royaltyReceivers, &lockedUntil,
+	)
+	_, err = msgServer.MintONFT(suite.Ctx, mintMsg)
+	suite.Require().NoError(err)
+
+	_, err = msgServer.CloseMinting(suite.Ctx, onfttypes.NewMsgCloseMinting(createMsg.Id, creator.String()))
+	suite.Require().NoError(err)
+	return createMsg.Id, mintMsg.Id
+}
+
+// receive creates the class and mints the token sent from the suite chain on the counterparty
+func (suite *KeeperTestSuite) receive(class nfttransfer.Class, token nfttransfer.NFT) string {
+	classID := nfttransfer.ParseClassTrace("nft-transfer/channel-0/" + class.GetID()).IBCClassID()
+	keeper := newICS721Keeper(suite.counterparty.App)
+	suite.Require().NoError(keeper.CreateOrUpdateClass(suite.counterparty.Ctx, classID, class.GetURI(), class.GetData()))
+	suite.Require().NoError(keeper.Mint(
+		suite.counterparty.Ctx,
+		classID,
+		token.GetID(),
+		token.GetURI(),
+		token.GetData(),
+		suite.counterparty.TestAccs[0],
+	))
+	return classID
+}
+
+func (suite *KeeperTestSuite) TestMetadataRoundTrip() {
+	denomID, onftID := suite.createDenomAndMint(
+		`{"collection": {"tier": 1}, "supply": 12345678901234567890}`,
+		`{"attributes": [{"trait_type": "background", "value": "blue"}], "power": 0.1}`,
+	)
+	keeper := newICS721Keeper(suite.App)
+	class, found := keeper.GetClass(suite.Ctx, denomID)
+	suite.Require().True(found)
+	token, found := keeper.GetNFT(suite.Ctx, denomID, onftID)
+	suite.Require().True(found)
+
+	classID := suite.receive(class, token)
+	cdc := suite.App.AppCodec()
+
+	// every denom field survives the transfer, the voucher denom is owned by the module
+	denom, err := suite.App.ONFTKeeper.GetDenomInfo(suite.Ctx, denomID)
+	suite.Require().NoError(err)
+	voucherDenom, err := suite.counterparty.App.ONFTKeeper.GetDenomInfo(suite.counterparty.Ctx, classID)
+	suite.Require().NoError(err)
+	suite.Require().True(denom.Revocable)
+	suite.Require().Equal(uint64(10), denom.MaxSupply)
+	suite.Require().JSONEq(denom.Data, voucherDenom.Data)
+	denom.Id, denom.Data = classID, ""
+	denom.Creator = suite.counterparty.App.AccountKeeper.GetModuleAddress(onfttypes.ModuleName).String()
+	denom.MaxSupply, denom.Revocable = 0, false
+	voucherDenom.Data = ""
+	suite.Require().Equal(cdc.MustMarshal(denom), cdc.MustMarshal(voucherDenom))
+
+	// every onft field survives the transfer
+	onft, found := suite.App.ONFTKeeper.NFTkeeper().GetNFT(suite.Ctx, denomID, onftID)
+	suite.Require().True(found)
+	voucher, found := suite.counterparty.App.ONFTKeeper.NFTkeeper().GetNFT(suite.counterparty.Ctx, classID, onftID)
+	suite.Require().True(found)
+	suite.Require().Equal(onft.Uri, voucher.Uri)
+	suite.Require().Equal(onft.UriHash, voucher.UriHash)
+	metadata, err := onfttypes.UnmarshalNFTMetadata(cdc, onft.Data.GetValue())
+	suite.Require().NoError(err)
+	voucherMetadata, err := onfttypes.UnmarshalNFTMetadata(cdc, voucher.Data.GetValue())
+	suite.Require().NoError(err)
+	suite.Require().True(metadata.Nsfw)
+	suite.Require().NotNil(metadata.TransferLockedUntil)
+	suite.Require().Len(metadata.RoyaltyReceivers, 2)
+	suite.Require().JSONEq(metadata.Data, voucherMetadata.Data)
+	metadata.Data, voucherMetadata.Data = "", ""
+	suite.Require().Equal(cdc.MustMarshal(&metadata), cdc.MustMarshal(&voucherMetadata))
+
+	// the counterparty sends back the data it received
+	counterpartyKeeper := newICS721Keeper(suite.counterparty.App)
+	voucherClass, found := counterpartyKeeper.GetClass(suite.counterparty.Ctx, classID)
+	suite.Require().True(found)
+	voucherToken, found := counterpartyKeeper.GetNFT(suite.counterparty.Ctx, classID, onftID)
+	suite.Require().True(found)
+	suite.Require().Equal(class.GetURI(), voucherClass.GetURI())
+	suite.Require().Equal(token.GetData(), voucherToken.GetData())
+	suite.Require().Equal(token.GetURI(), voucherToken.GetURI())
+
+	// the voucher keeps the trait index of the denom
+	resp, err := suite.counterparty.App.ONFTKeeper.ONFTsByTrait(suite.counterparty.Ctx, &onfttypes.QueryONFTsByTraitRequest{
+		DenomId:   classID,
+		TraitType: "background",
+		Value:     "blue",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.ONFTs, 1)
+}
+
+func (suite *KeeperTestSuite) TestVoucherDenomNotManageable() {
+	denomID, onftID := suite.createDenomAndMint(`{}`, `{}`)
+	keeper := newICS721Keeper(suite.App)
+	class, _ := keeper.GetClass(suite.Ctx, denomID)
+	token, _ := keeper.GetNFT(suite.Ctx, denomID, onftID)
+	classID := suite.receive(class, token)
+
+	// the source creator has no control over the vouchers of a revocable denom
+	ctx := suite.counterparty.Ctx
+	onftKeeper := suite.counterparty.App.ONFTKeeper
+	voucherDenom, err := onftKeeper.GetDenomInfo(ctx, classID)
+	suite.Require().NoError(err)
+	suite.Require().False(voucherDenom.Revocable)
+	suite.Require().False(voucherDenom.Frozen)
+	suite.Require().NotEqual(suite.TestAccs[0].String(), voucherDenom.Creator)
+
+	holder := suite.counterparty.TestAccs[0]
+	err = onftKeeper.RevokeONFT(ctx, classID, onftID, "revoked", true, suite.TestAccs[0])
+	suite.Require().Error(err)
+	err = onftKeeper.FreezeDenom(ctx, classID, suite.TestAccs[0])
+	suite.Require().ErrorIs(err, onfttypes.ErrInvalidDenom)
+	suite.Require().Equal(holder, onftKeeper.NFTkeeper().GetOwner(ctx, classID, onftID))
+
+	// no creator msg can be used on a voucher denom, not even by the module address
+	moduleAddr := suite.counterparty.App.AccountKeeper.GetModuleAddress(onfttypes.ModuleName)
+	err = onftKeeper.RevokeONFT(ctx, classID, onftID, "revoked", true, moduleAddr)
+	suite.Require().ErrorIs(err, onfttypes.ErrInvalidDenom)
+	err = onftKeeper.TransferDenomOwner(ctx, classID, moduleAddr, holder)
+	suite.Require().ErrorIs(err, onfttypes.ErrInvalidDenom)
+	err = onftKeeper.AuthorizeDenomCreator(ctx, classID, moduleAddr)
+	suite.Require().ErrorIs(err, onfttypes.ErrInvalidDenom)
+}
+
+func (suite *KeeperTestSuite) TestNonObjectDataRoundTrip() {
+	denomID, onftID := suite.createDenomAndMint("denom data", `["onft", "data"]`)
+	keeper := newICS721Keeper(suite.App)
+	class, _ := keeper.GetClass(suite.Ctx, denomID)
+	token, _ := keeper.GetNFT(suite.Ctx, denomID, onftID)
+
+	// data that isn't a json object is carried as is
+	var classData map[string]interface{}
+	bz, err := base64.StdEncoding.DecodeString(class.GetData())
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal(bz, &classData))
+	suite.Require().Equal(map[string]interface{}{"value": onfttypes.ICS721DataVersion}, classData[onfttypes.ClassKeyVersion])
+	suite.Require().Equal(map[string]interface{}{"value": "denom data"}, classData[onfttypes.ClassKeyData])
+
+	classID := suite.receive(class, token)
+	voucherDenom, err := suite.counterparty.App.ONFTKeeper.GetDenomInfo(suite.counterparty.Ctx, classID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("denom data", voucherDenom.Data)
+	suite.Require().Zero(voucherDenom.MaxSupply)
+	suite.Require().True(voucherDenom.MintingClosed)
+	voucher, err := suite.counterparty.App.ONFTKeeper.GetONFT(suite.counterparty.Ctx, classID, onftID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`["onft", "data"]`, voucher.GetData())
+	suite.Require().False(voucher.IsExtensible())
+}
+
+func (suite *KeeperTestSuite) TestCWICS721ClassData() {
+	keeper := newICS721Keeper(suite.counterparty.App)
+	ctx := suite.counterparty.Ctx
+	classID := nfttransfer.ParseClassTrace("nft-transfer/channel-0/wasm.stars1contract").IBCClassID()
+	cwClassData := `{"owner":"stars1owner","contract_info":{"code_id":1},"name":"Bad Kids","symbol":"BAD","num_tokens":9999}`
+
+	suite.Require().NoError(keeper.CreateOrUpdateClass(ctx, classID, "ipfs://badkids",
+		base64.StdEncoding.EncodeToString([]byte(cwClassData))))
+	denom, err := suite.counterparty.App.ONFTKeeper.GetDenomInfo(ctx, classID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("Bad Kids", denom.Name)
+	suite.Require().Equal("BAD", denom.Symbol)
+	suite.Require().JSONEq(cwClassData, denom.Data)
+
+	// packets without class data keep the existing class
+	suite.Require().NoError(keeper.CreateOrUpdateClass(ctx, classID, "ipfs://badkids", ""))
+	denom, err = suite.counterparty.App.ONFTKeeper.GetDenomInfo(ctx, classID)
+	suite.Require().NoError(err)
+	suite.Require().Equal("Bad Kids", denom.Name)
+
+	// tokens without data are transferable and extensible
+	suite.Require().NoError(keeper.Mint(ctx, classID, "1", "ipfs://badkids/1", "", suite.counterparty.TestAccs[0]))
+	voucher, err := suite.counterparty.App.ONFTKeeper.GetONFT(ctx, classID, "1")
+	suite.Require().NoError(err)
+	suite.Require().True(voucher.IsTransferable())
+	suite.Require().True(voucher.IsExtensible())
+
+	// the class data sent back keeps the cw-ics721 fields
+	class, found := keeper.GetClass(ctx, classID)
+	suite.Require().True(found)
+	bz, err := base64.StdEncoding.DecodeString(class.GetData())
+	suite.Require().NoError(err)
+	var classData map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(bz, &classData))
+	suite.Require().Equal("stars1owner", classData["owner"])
+	suite.Require().Equal(float64(9999), classData["num_tokens"])
+}
+
+func (suite *KeeperTestSuite) TestLegacyTokenData() {
+	keeper := newICS721Keeper(suite.counterparty.App)
+	ctx := suite.counterparty.Ctx
+	classID := nfttransfer.ParseClassTrace("nft-transfer/channel-0/onftdenomlegacy").IBCClassID()
+	suite.Require().NoError(keeper.CreateOrUpdateClass(ctx, classID, "", ""))
+
+	legacyData := `{"omniflix:name":{"value":"legacy"},"omniflix:nsfw":{"value":true},"level":3}`
+	suite.Require().NoError(keeper.Mint(ctx, classID, "legacy", "ipfs://legacy",
+		base64.StdEncoding.EncodeToString([]byte(legacyData)), suite.counterparty.TestAccs[0]))
+	voucher, err := suite.counterparty.App.ONFTKeeper.GetONFT(ctx, classID, "legacy")
+	suite.Require().NoError(err)
+	suite.Require().Equal("legacy", voucher.GetName())
+	suite.Require().True(voucher.IsNSFW())
+	suite.Require().True(voucher.IsTransferable())
+	suite.Require().True(voucher.IsExtensible())
+	suite.Require().True(voucher.GetRoyaltyShare().IsZero())
+	suite.Require().Equal(`{"level":3}`, voucher.GetData())
+}
@@ -0,0 +1,140 @@
+package bindings_test
+
+import (
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/itc/bindings/types"
+	"github.com/OmniFlix/omniflixhub/v6/x/itc/types"
+)
+
+const (
+	testDenomId = "onftdenomitc"
+	testONFTId  = "onftitc1"
+)
+
+var (
+	testTokensPerClaim = wasmvmtypes.Coin{Denom: "uflix", Amount: "10"}
+	testDeposit        = wasmvmtypes.Coin{Denom: "uflix", Amount: "100"}
+)
+
+// createTestCampaign creates a fungible hold campaign of the contract starting in a minute
+func createTestCampaign(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, reflect, sender sdk.AccAddress) error {
+	t.Helper()
+
+	creationFee := types.DefaultCampaignCreationFee
+	msg := bindings.ItcMsg{CreateCampaign: &bindings.CreateCampaign{
+		Name:             "reflect campaign",
+		Interaction:      "hold",
+		ClaimType:        "fungible",
+		NftDenomId:       testDenomId,
+		MaxAllowedClaims: 10,
+		TokensPerClaim:   &testTokensPerClaim,
+		Deposit:          &testDeposit,
+		Distribution:     &bindings.Distribution{Type: "instant"},
+		StartTime:        uint64(ctx.BlockTime().Add(time.Minute).Unix()),
+		Duration:         3600,
+		CreationFee: wasmvmtypes.Coin{
+			Denom:  creationFee.Denom,
+			Amount: creationFee.Amount.String(),
+		},
+	}}
+	return executeCustom(t, ctx, customApp, reflect, sender, msg)
+}
+
+func TestCreateCampaignMsg(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	require.NotEmpty(t, reflect)
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, reflect, testDenomId, testONFTId)
+
+	// creation fee and deposit are paid by the contract
+	err := createTestCampaign(t, ctx, customApp, reflect, lucky)
+	require.Error(t, err)
+
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(
+		types.DefaultCampaignCreationFee.AddAmount(types.DefaultCampaignCreationFee.Amount.AddRaw(100)),
+	))
+	err = createTestCampaign(t, ctx, customApp, reflect, lucky)
+	require.NoError(t, err)
+
+	campaign, found := customApp.ItcKeeper.GetCampaign(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, reflect.String(), campaign.Creator)
+	require.Equal(t, types.INTERACTION_TYPE_HOLD, campaign.Interaction)
+	require.Equal(t, types.CLAIM_TYPE_FT, campaign.ClaimType)
+	require.Equal(t, ctx.BlockTime().Add(time.Minute+time.Hour).Unix(), campaign.EndTime.Unix())
+
+	// enums must use the cli names
+	msg := bindings.ItcMsg{CreateCampaign: &bindings.CreateCampaign{
+		Name:        "invalid campaign",
+		Interaction: "INTERACTION_TYPE_HOLD",
+		ClaimType:   "fungible",
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.ErrorContains(t, err, "invalid interaction")
+}
+
+func TestClaimAndDepositMsgs(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	claimer := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, claimer, testDenomId, testONFTId)
+
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(
+		types.DefaultCampaignCreationFee.AddAmount(types.DefaultCampaignCreationFee.Amount.AddRaw(150)),
+	))
+	err := createTestCampaign(t, ctx, customApp, reflect, lucky)
+	require.NoError(t, err)
+
+	msg := bindings.ItcMsg{DepositCampaign: &bindings.DepositCampaign{
+		CampaignId: 1,
+		Amount:     wasmvmtypes.Coin{Denom: "uflix", Amount: "50"},
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+
+	campaign, _ := customApp.ItcKeeper.GetCampaign(ctx, 1)
+	require.Equal(t, int64(150), campaign.AvailableTokens.Amount.Int64())
+
+	// claims are not allowed before the campaign starts
+	msg = bindings.ItcMsg{Claim: &bindings.Claim{
+		CampaignId:  1,
+		NftId:       testONFTId,
+		Interaction: "hold",
+	}}
+	err = executeCustom(t, ctx, customApp, claimer, lucky, msg)
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+	err = executeCustom(t, ctx, customApp, claimer, lucky, msg)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(10), customApp.BankKeeper.GetBalance(ctx, claimer, "uflix").Amount.Int64())
+	campaign, _ = customApp.ItcKeeper.GetCampaign(ctx, 1)
+	require.Equal(t, uint64(1), campaign.ClaimCount)
+	require.Equal(t, int64(140), campaign.AvailableTokens.Amount.Int64())
+
+	// the same nft can't claim twice
+	err = executeCustom(t, ctx, customApp, claimer, lucky, msg)
+	require.Error(t, err)
+}
+
+func executeCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, sender sdk.AccAddress, msg bindings.ItcMsg) error {
+	t.Helper()
+
+	return wasmtesting.ExecuteCustom(t, ctx, customApp, contract, sender, bindings.ItcCustomMsg{Itc: &msg})
+}
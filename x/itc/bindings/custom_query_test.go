@@ -0,0 +1,52 @@
+package bindings_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/itc/bindings/types"
+	"github.com/OmniFlix/omniflixhub/v6/x/itc/types"
+)
+
+func TestQueryCampaign(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, reflect, testDenomId, testONFTId)
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(
+		types.DefaultCampaignCreationFee.AddAmount(types.DefaultCampaignCreationFee.Amount.AddRaw(100)),
+	))
+	err := createTestCampaign(t, ctx, customApp, reflect, lucky)
+	require.NoError(t, err)
+
+	campaignResp := bindings.CampaignResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.ItcQuery{
+		Campaign: &bindings.GetCampaign{Id: 1},
+	}, &campaignResp)
+	campaign := campaignResp.Campaign
+	require.Equal(t, uint64(1), campaign.Id)
+	require.Equal(t, "reflect campaign", campaign.Name)
+	require.Equal(t, reflect.String(), campaign.Creator)
+	require.Equal(t, "hold", campaign.Interaction)
+	require.Equal(t, "fungible", campaign.ClaimType)
+	require.Equal(t, testTokensPerClaim, campaign.TokensPerClaim)
+	require.Equal(t, testDeposit, campaign.AvailableTokens)
+	require.Equal(t, uint64(ctx.BlockTime().Add(time.Minute).Unix()), campaign.StartTime)
+	require.Equal(t, &bindings.Distribution{Type: "instant"}, campaign.Distribution)
+	require.Nil(t, campaign.NftMintDetails)
+	require.Empty(t, campaign.ReceivedNftIds)
+}
+
+func queryCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, request bindings.ItcQuery, response interface{}) {
+	t.Helper()
+
+	wasmtesting.QueryCustom(t, ctx, customApp, contract, bindings.ItcCustomQuery{Itc: &request}, response)
+}
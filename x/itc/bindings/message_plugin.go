@@ -0,0 +1,266 @@
+package bindings
+
+import (
+	"encoding/json"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/itc/bindings/types"
+	itckeeper "github.com/OmniFlix/omniflixhub/v6/x/itc/keeper"
+	itctypes "github.com/OmniFlix/omniflixhub/v6/x/itc/types"
+)
+
+// enum names accepted from contracts, same as the cli
+var (
+	interactionTypes = map[string]itctypes.InteractionType{
+		"burn":     itctypes.INTERACTION_TYPE_BURN,
+		"transfer": itctypes.INTERACTION_TYPE_TRANSFER,
+		"hold":     itctypes.INTERACTION_TYPE_HOLD,
+	}
+	claimTypes = map[string]itctypes.ClaimType{
+		"fungible":                  itctypes.CLAIM_TYPE_FT,
+		"non-fungible":              itctypes.CLAIM_TYPE_NFT,
+		"fungible-and-non-fungible": itctypes.CLAIM_TYPE_FT_AND_NFT,
+	}
+	distributionTypes = map[string]itctypes.DistributionType{
+		"instant": itctypes.DISTRIBUTION_TYPE_INSTANT,
+		"stream":  itctypes.DISTRIBUTION_TYPE_STREAM,
+	}
+)
+
+// CustomMessageDecorator returns decorator for itc custom CosmWasm bindings messages
+func CustomMessageDecorator(itc *itckeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			itc:     itc,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	itc     *itckeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom != nil {
+		// only handle messages namespaced under itc,
+		// leave everything else for the wrapped version
+		var customMsg bindingstypes.ItcCustomMsg
+		if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+			return nil, nil, [][]*codectypes.Any{}, errorsmod.Wrap(err, "itc msg")
+		}
+
+		if contractMsg := customMsg.Itc; contractMsg != nil {
+			var (
+				data []byte
+				err  error
+			)
+			switch {
+			case contractMsg.CreateCampaign != nil:
+				data, err = PerformCreateCampaign(m.itc, ctx, contractAddr, contractMsg.CreateCampaign)
+			case contractMsg.Claim != nil:
+				data, err = PerformClaim(m.itc, ctx, contractAddr, contractMsg.Claim)
+			case contractMsg.DepositCampaign != nil:
+				data, err = PerformDepositCampaign(m.itc, ctx, contractAddr, contractMsg.DepositCampaign)
+			default:
+				return nil, nil, [][]*codectypes.Any{}, wasmvmtypes.UnsupportedRequest{Kind: "unknown itc msg variant"}
+			}
+			if err != nil {
+				return nil, nil, [][]*codectypes.Any{}, err
+			}
+			return nil, [][]byte{data}, [][]*codectypes.Any{}, nil
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// PerformCreateCampaign creates a campaign, the creation fee and deposit are paid by the contract.
+func PerformCreateCampaign(k *itckeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, create *bindingstypes.CreateCampaign) ([]byte, error) {
+	if create == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "create campaign null create campaign"}
+	}
+	interaction, ok := interactionTypes[create.Interaction]
+	if !ok {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid interaction " + create.Interaction}
+	}
+	claimType, ok := claimTypes[create.ClaimType]
+	if !ok {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid claim type " + create.ClaimType}
+	}
+	var tokensPerClaim, deposit sdk.Coin
+	if create.TokensPerClaim != nil {
+		coin, err := parseCoin(*create.TokensPerClaim)
+		if err != nil {
+			return nil, err
+		}
+		tokensPerClaim = coin
+	}
+	if create.Deposit != nil {
+		coin, err := parseCoin(*create.Deposit)
+		if err != nil {
+			return nil, err
+		}
+		deposit = coin
+	}
+	nftMintDetails, err := parseNFTDetails(create.NftMintDetails)
+	if err != nil {
+		return nil, err
+	}
+	distribution, err := parseDistribution(create.Distribution)
+	if err != nil {
+		return nil, err
+	}
+	creationFee, err := parseCoin(create.CreationFee)
+	if err != nil {
+		return nil, err
+	}
+
+	msgCreateCampaign := itctypes.NewMsgCreateCampaign(
+		create.Name,
+		create.Description,
+		interaction,
+		claimType,
+		create.NftDenomId,
+		create.MaxAllowedClaims,
+		tokensPerClaim,
+		deposit,
+		nftMintDetails,
+		distribution,
+		time.Unix(int64(create.StartTime), 0).UTC(),
+		time.Duration(create.Duration)*time.Second,
+		contractAddr.String(),
+		creationFee,
+	)
+	if err := msgCreateCampaign.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgCreateCampaign")
+	}
+
+	msgServer := itckeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.CreateCampaign(ctx, msgCreateCampaign)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "creating campaign")
+	}
+	return resp.Marshal()
+}
+
+// PerformClaim claims a campaign with a nft owned by the contract.
+func PerformClaim(k *itckeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, claim *bindingstypes.Claim) ([]byte, error) {
+	if claim == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "claim null claim"}
+	}
+	interaction, ok := interactionTypes[claim.Interaction]
+	if !ok {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid interaction " + claim.Interaction}
+	}
+
+	msgClaim := itctypes.NewMsgClaim(claim.CampaignId, claim.NftId, interaction, contractAddr.String())
+	if err := msgClaim.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgClaim")
+	}
+
+	msgServer := itckeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.Claim(ctx, msgClaim)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "claiming campaign")
+	}
+	return resp.Marshal()
+}
+
+// PerformDepositCampaign deposits tokens into a campaign created by the contract.
+func PerformDepositCampaign(k *itckeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, deposit *bindingstypes.DepositCampaign) ([]byte, error) {
+	if deposit == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "deposit campaign null deposit"}
+	}
+	amount, err := parseCoin(deposit.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msgDepositCampaign := itctypes.NewMsgDepositCampaign(deposit.CampaignId, amount, contractAddr.String())
+	if err := msgDepositCampaign.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgDepositCampaign")
+	}
+
+	msgServer := itckeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.DepositCampaign(ctx, msgDepositCampaign)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "depositing campaign")
+	}
+	return resp.Marshal()
+}
+
+// parseCoin converts a wasm vm type coin to a sdk type coin
+func parseCoin(coin wasmvmtypes.Coin) (sdk.Coin, error) {
+	amount, ok := sdkmath.NewIntFromString(coin.Amount)
+	if !ok {
+		return sdk.Coin{}, wasmvmtypes.InvalidRequest{Err: "invalid coin amount " + coin.Amount}
+	}
+	return sdk.Coin{Denom: coin.Denom, Amount: amount}, nil
+}
+
+func parseNFTDetails(details *bindingstypes.NFTDetails) (*itctypes.NFTDetails, error) {
+	if details == nil {
+		return nil, nil
+	}
+	royaltyShare := sdkmath.LegacyZeroDec()
+	if details.RoyaltyShare != "" {
+		share, err := sdkmath.LegacyNewDecFromStr(details.RoyaltyShare)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid royalty share " + details.RoyaltyShare}
+		}
+		royaltyShare = share
+	}
+	nftDetails := &itctypes.NFTDetails{
+		DenomId:       details.DenomId,
+		Name:          details.Name,
+		Description:   details.Description,
+		MediaUri:      details.MediaURI,
+		PreviewUri:    details.PreviewURI,
+		RoyaltyShare:  royaltyShare,
+		Transferable:  details.Transferable,
+		Extensible:    details.Extensible,
+		Nsfw:          details.Nsfw,
+		Data:          details.Data,
+		UriHash:       details.URIHash,
+		StartIndex:    details.StartIndex,
+		NameDelimiter: details.NameDelimiter,
+	}
+	if details.TransferLockedUntil > 0 {
+		lockedUntil := time.Unix(details.TransferLockedUntil, 0).UTC()
+		nftDetails.TransferLockedUntil = &lockedUntil
+	}
+	return nftDetails, nil
+}
+
+func parseDistribution(distribution *bindingstypes.Distribution) (*itctypes.Distribution, error) {
+	if distribution == nil {
+		return nil, nil
+	}
+	distributionType, ok := distributionTypes[distribution.Type]
+	if !ok {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid distribution type " + distribution.Type}
+	}
+	return &itctypes.Distribution{
+		Type:           distributionType,
+		StreamDuration: time.Duration(distribution.StreamDuration) * time.Second,
+	}, nil
+}
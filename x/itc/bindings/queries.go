@@ -0,0 +1,107 @@
+package bindings
+
+import (
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/itc/bindings/types"
+	itckeeper "github.com/OmniFlix/omniflixhub/v6/x/itc/keeper"
+	itctypes "github.com/OmniFlix/omniflixhub/v6/x/itc/types"
+)
+
+type QueryPlugin struct {
+	itcKeeper *itckeeper.Keeper
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(k *itckeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		itcKeeper: k,
+	}
+}
+
+// GetCampaign is a query to get a campaign by id.
+func (qp QueryPlugin) GetCampaign(ctx sdk.Context, id uint64) (*bindingstypes.CampaignResponse, error) {
+	resp, err := qp.itcKeeper.Campaign(ctx, &itctypes.QueryCampaignRequest{CampaignId: id})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.CampaignResponse{Campaign: SdkCampaignToWasm(resp.Campaign)}, nil
+}
+
+func SdkCampaignToWasm(campaign itctypes.Campaign) bindingstypes.Campaign {
+	receivedNftIds := campaign.ReceivedNftIds
+	if receivedNftIds == nil {
+		receivedNftIds = []string{}
+	}
+	converted := bindingstypes.Campaign{
+		Id:               campaign.Id,
+		Name:             campaign.Name,
+		Description:      campaign.Description,
+		StartTime:        uint64(campaign.StartTime.Unix()),
+		EndTime:          uint64(campaign.EndTime.Unix()),
+		Creator:          campaign.Creator,
+		NftDenomId:       campaign.NftDenomId,
+		MaxAllowedClaims: campaign.MaxAllowedClaims,
+		Interaction:      enumName(interactionTypes, campaign.Interaction),
+		ClaimType:        enumName(claimTypes, campaign.ClaimType),
+		TokensPerClaim:   ConvertSdkCoinToWasmCoin(campaign.TokensPerClaim),
+		TotalTokens:      ConvertSdkCoinToWasmCoin(campaign.TotalTokens),
+		AvailableTokens:  ConvertSdkCoinToWasmCoin(campaign.AvailableTokens),
+		ReceivedNftIds:   receivedNftIds,
+		MintCount:        campaign.MintCount,
+		ClaimCount:       campaign.ClaimCount,
+	}
+	if details := campaign.NftMintDetails; details != nil {
+		converted.NftMintDetails = &bindingstypes.NFTDetails{
+			DenomId:       details.DenomId,
+			Name:          details.Name,
+			Description:   details.Description,
+			MediaURI:      details.MediaUri,
+			PreviewURI:    details.PreviewUri,
+			RoyaltyShare:  details.RoyaltyShare.String(),
+			Transferable:  details.Transferable,
+			Extensible:    details.Extensible,
+			Nsfw:          details.Nsfw,
+			Data:          details.Data,
+			URIHash:       details.UriHash,
+			StartIndex:    details.StartIndex,
+			NameDelimiter: details.NameDelimiter,
+		}
+		if details.TransferLockedUntil != nil {
+			converted.NftMintDetails.TransferLockedUntil = details.TransferLockedUntil.Unix()
+		}
+	}
+	if distribution := campaign.Distribution; distribution != nil {
+		converted.Distribution = &bindingstypes.Distribution{
+			Type:           enumName(distributionTypes, distribution.Type),
+			StreamDuration: uint64(distribution.StreamDuration / time.Second),
+		}
+	}
+	return converted
+}
+
+// ConvertSdkCoinToWasmCoin converts a sdk type coin to a wasm vm type coin,
+// coins of non-fungible campaigns are empty
+func ConvertSdkCoinToWasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
+	if coin.Amount.IsNil() {
+		return wasmvmtypes.Coin{Denom: coin.Denom, Amount: "0"}
+	}
+	return wasmvmtypes.Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.String(),
+	}
+}
+
+// enumName returns the contract name of an itc enum value
+func enumName[T comparable](names map[string]T, value T) string {
+	for name, v := range names {
+		if v == value {
+			return name
+		}
+	}
+	return ""
+}
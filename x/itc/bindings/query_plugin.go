@@ -0,0 +1,59 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/itc/bindings/types"
+)
+
+// CustomQueryDecorator returns decorator for itc custom CosmWasm bindings queries.
+// Custom queries that are not namespaced under itc are left for the wrapped handler.
+func CustomQueryDecorator(qp *QueryPlugin) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(
+			func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+				if request.Custom != nil {
+					var customQuery bindingstypes.ItcCustomQuery
+					if err := json.Unmarshal(request.Custom, &customQuery); err != nil {
+						return nil, errorsmod.Wrap(err, "itc query")
+					}
+					if customQuery.Itc != nil {
+						return CustomQuerier(qp)(ctx, *customQuery.Itc)
+					}
+				}
+				return old.HandleQuery(ctx, caller, request)
+			},
+		)
+	}
+}
+
+// CustomQuerier dispatches itc custom CosmWasm bindings queries.
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, contractQuery bindingstypes.ItcQuery) ([]byte, error) {
+	return func(ctx sdk.Context, contractQuery bindingstypes.ItcQuery) ([]byte, error) {
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case contractQuery.Campaign != nil:
+			res, err = qp.GetCampaign(ctx, contractQuery.Campaign.Id)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown itc query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal itc query response: %w", err)
+		}
+		return bz, nil
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+// ItcCustomMsg is the custom message handled by the itc bindings.
+// Messages are namespaced under the module name, ex: {"itc": {"claim": {...}}}
+type ItcCustomMsg struct {
+	Itc *ItcMsg `json:"itc,omitempty"`
+}
+
+type ItcMsg struct {
+	/// Contracts can create campaigns, the creation fee and deposit are paid by the contract.
+	CreateCampaign *CreateCampaign `json:"create_campaign,omitempty"`
+	/// Contracts can claim campaigns with the nfts they own.
+	Claim *Claim `json:"claim,omitempty"`
+	/// Contracts can deposit tokens into the campaigns they created.
+	DepositCampaign *DepositCampaign `json:"deposit_campaign,omitempty"`
+}
+
+// CreateCampaign creates a campaign with the contract as creator.
+// Enum values use the cli names, ex: "hold", "transfer" or "burn" for the interaction.
+type CreateCampaign struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Interaction is one of "burn", "transfer" or "hold"
+	Interaction string `json:"interaction"`
+	// ClaimType is one of "fungible", "non-fungible" or "fungible-and-non-fungible"
+	ClaimType        string `json:"claim_type"`
+	NftDenomId       string `json:"nft_denom_id"`
+	MaxAllowedClaims uint64 `json:"max_allowed_claims"`
+	// TokensPerClaim and Deposit are required for fungible claim types
+	TokensPerClaim *wasmvmtypes.Coin `json:"tokens_per_claim,omitempty"`
+	Deposit        *wasmvmtypes.Coin `json:"deposit,omitempty"`
+	// NftMintDetails are required for non-fungible claim types
+	NftMintDetails *NFTDetails `json:"nft_mint_details,omitempty"`
+	// Distribution is required for fungible claim types
+	Distribution *Distribution `json:"distribution,omitempty"`
+	// StartTime in unix seconds, must be in future
+	StartTime uint64 `json:"start_time"`
+	// Duration in seconds
+	Duration    uint64           `json:"duration"`
+	CreationFee wasmvmtypes.Coin `json:"creation_fee"`
+}
+
+type Claim struct {
+	CampaignId uint64 `json:"campaign_id"`
+	NftId      string `json:"nft_id"`
+	// Interaction must match the interaction of the campaign
+	Interaction string `json:"interaction"`
+}
+
+type DepositCampaign struct {
+	CampaignId uint64           `json:"campaign_id"`
+	Amount     wasmvmtypes.Coin `json:"amount"`
+}
@@ -0,0 +1,23 @@
+package types
+
+// ItcCustomQuery is the custom query handled by the itc bindings.
+// Queries are namespaced under the module name, ex: {"itc": {"campaign": {...}}}
+type ItcCustomQuery struct {
+	Itc *ItcQuery `json:"itc,omitempty"`
+}
+
+type ItcQuery struct {
+	Campaign *GetCampaign `json:"campaign,omitempty"`
+}
+
+// query types
+
+type GetCampaign struct {
+	Id uint64 `json:"id"`
+}
+
+// responses
+
+type CampaignResponse struct {
+	Campaign Campaign `json:"campaign"`
+}
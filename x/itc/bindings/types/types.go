@@ -0,0 +1,51 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+type NFTDetails struct {
+	DenomId     string `json:"denom_id"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	MediaURI    string `json:"media_uri"`
+	PreviewURI  string `json:"preview_uri,omitempty"`
+	// RoyaltyShare is a decimal string between 0 and 1, defaults to 0
+	RoyaltyShare  string `json:"royalty_share,omitempty"`
+	Transferable  bool   `json:"transferable"`
+	Extensible    bool   `json:"extensible"`
+	Nsfw          bool   `json:"nsfw"`
+	Data          string `json:"data,omitempty"`
+	URIHash       string `json:"uri_hash,omitempty"`
+	StartIndex    uint64 `json:"start_index,omitempty"`
+	NameDelimiter string `json:"name_delimiter,omitempty"`
+	// TransferLockedUntil is the unix time in seconds until which the claimed oNFTs can not be transferred
+	TransferLockedUntil int64 `json:"transfer_locked_until,omitempty"`
+}
+
+type Distribution struct {
+	// Type is one of "instant" or "stream"
+	Type string `json:"type"`
+	// StreamDuration in seconds, required for stream distributions
+	StreamDuration uint64 `json:"stream_duration,omitempty"`
+}
+
+type Campaign struct {
+	Id          uint64 `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// StartTime and EndTime in unix seconds
+	StartTime        uint64           `json:"start_time"`
+	EndTime          uint64           `json:"end_time"`
+	Creator          string           `json:"creator"`
+	NftDenomId       string           `json:"nft_denom_id"`
+	MaxAllowedClaims uint64           `json:"max_allowed_claims"`
+	Interaction      string           `json:"interaction"`
+	ClaimType        string           `json:"claim_type"`
+	TokensPerClaim   wasmvmtypes.Coin `json:"tokens_per_claim"`
+	TotalTokens      wasmvmtypes.Coin `json:"total_tokens"`
+	AvailableTokens  wasmvmtypes.Coin `json:"available_tokens"`
+	ReceivedNftIds   []string         `json:"received_nft_ids"`
+	NftMintDetails   *NFTDetails      `json:"nft_mint_details,omitempty"`
+	Distribution     *Distribution    `json:"distribution,omitempty"`
+	MintCount        uint64           `json:"mint_count"`
+	ClaimCount       uint64           `json:"claim_count"`
+}
@@ -0,0 +1,28 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	itckeeper "github.com/OmniFlix/omniflixhub/v6/x/itc/keeper"
+)
+
+// Capability is the wasm capability of contracts using the itc bindings
+const Capability = "itc"
+
+func RegisterCustomPlugins(
+	itc *itckeeper.Keeper,
+) []wasmkeeper.Option {
+	wasmQueryPlugin := NewQueryPlugin(itc)
+
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		CustomQueryDecorator(wasmQueryPlugin),
+	)
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(itc),
+	)
+
+	return []wasmkeeper.Option{
+		queryDecoratorOpt,
+		messengerDecoratorOpt,
+	}
+}
@@ -42,13 +42,13 @@ func (k Keeper) CreateCampaign(
 		if err != nil {
 			return err
 		}
-		// Authorize
-		if mintCollection.Creator != campaign.Creator {
+		// Authorize, campaign creator must be the denom creator or a minter of the denom
+		if !k.nftKeeper.HasPermissionToMint(ctx, mintCollection.Id, creator) {
 			return errorsmod.Wrapf(
 				sdkerrors.ErrUnauthorized,
-				"nft mint denom id %s isn't owned by campaign creator %s",
-				mintCollection.Id,
+				"campaign creator %s is not allowed to mint nfts under denom id %s",
 				campaign.Creator,
+				mintCollection.Id,
 			)
 		}
 	}
@@ -130,17 +130,24 @@ func (k Keeper) Claim(ctx sdk.Context, campaign types.Campaign, claimer sdk.AccA
 			)
 		}
 	}
-	if !claimer.Equals(nft.GetOwner()) {
+	isHolder := claimer.Equals(nft.GetOwner())
+	// current user of the nft can claim hold campaigns as well as the owner
+	if !isHolder && campaign.Interaction == types.INTERACTION_TYPE_HOLD {
+		user, found := k.nftKeeper.GetCurrentUser(ctx, campaign.NftDenomId, nft.GetID())
+		isHolder = found && claimer.Equals(user)
+	}
+	if !isHolder {
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
 			"nft %s isn't owned by address  %s", claim.NftId, claimer.String())
 	}
 
 	if campaign.Interaction == types.INTERACTION_TYPE_TRANSFER {
-		err := k.nftKeeper.TransferOwnership(ctx,
+		err := k.nftKeeper.TransferOwnershipWithCause(ctx,
 			campaign.NftDenomId,
 			nft.GetID(),
 			claimer,
 			k.GetModuleAccountAddress(ctx),
+			nfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_CLAIM,
 		)
 		if err != nil {
 			return err
@@ -193,6 +200,15 @@ func (k Keeper) Claim(ctx sdk.Context, campaign types.Campaign, claimer sdk.AccA
 			campaign.NftMintDetails.NameDelimiter,
 			nftIndex,
 		)
+		// minted nfts are counted against the mint quota of the campaign creator
+		if err := k.nftKeeper.UseMintQuota(
+			ctx,
+			campaign.NftMintDetails.DenomId,
+			campaign.GetCreator(),
+			1,
+		); err != nil {
+			return err
+		}
 		if err := k.nftKeeper.MintONFT(
 			ctx,
 			campaign.NftMintDetails.DenomId,
@@ -208,10 +224,12 @@ func (k Keeper) Claim(ctx sdk.Context, campaign types.Campaign, claimer sdk.AccA
 			campaign.NftMintDetails.Extensible,
 			campaign.NftMintDetails.Nsfw,
 			campaign.NftMintDetails.RoyaltyShare,
+			nil,
+			campaign.NftMintDetails.TransferLockedUntil,
 			claimer,
 		); err != nil {
 			return errorsmod.Wrapf(types.ErrClaimingNFT,
-				"unable to mint nft denomId %s", campaign.NftMintDetails.DenomId)
+				"unable to mint nft denomId %s: %s", campaign.NftMintDetails.DenomId, err.Error())
 		}
 		// set campaign mint count
 		campaign.MintCount += 1
@@ -150,6 +150,9 @@ func (suite *KeeperTestSuite) createDefaultNftDenom() {
 			},
 		},
 		false,
+		0,
+		false,
+		false,
 	)
 	createDenomMsg.Id = defaultNftDenomId
 
@@ -173,6 +176,9 @@ func (suite *KeeperTestSuite) createSecondaryNftDenom() {
 		onfttypes.DefaultDenomCreationFee,
 		nil,
 		false,
+		0,
+		false,
+		false,
 	)
 	createDenomMsg.Id = secondaryNftDenomId
 
@@ -201,6 +207,9 @@ func (suite *KeeperTestSuite) createDefaultMintNftDenom() {
 			},
 		},
 		false,
+		0,
+		false,
+		false,
 	)
 	createDenomMsg.Id = defaultNftMintDenomId
 
@@ -226,6 +235,8 @@ func (suite *KeeperTestSuite) mintNFT(denomId, nftId string) {
 		true,
 		false,
 		sdkmath.LegacyNewDecWithPrec(1, 2),
+		nil,
+		nil,
 	)
 	mintNftMsg.Id = nftId
 	_, _ = suite.nftMsgServer.MintONFT(
@@ -570,3 +570,53 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestClaimHoldCampaignAsNftUser() {
+	suite.createDefaultNftDenom()
+	suite.mintNFT(defaultNftDenomId, "onfttest1")
+	suite.mintNFT(defaultNftDenomId, "onfttest2")
+	owner := suite.TestAccs[1]
+	user := suite.TestAccs[2]
+
+	_, err := suite.msgServer.CreateCampaign(
+		suite.Ctx,
+		types.NewMsgCreateCampaign(
+			defaultCampaignName,
+			defaultCampaignDescription,
+			types.INTERACTION_TYPE_HOLD,
+			defaultClaimType,
+			defaultNftDenomId,
+			defaultMaxClaims,
+			defaultTokensPerClaim,
+			sdk.NewInt64Coin(
+				defaultTokensPerClaim.Denom,
+				defaultTokensPerClaim.Amount.MulRaw(int64(defaultMaxClaims)).Int64(), //nolint:all
+			),
+			nil,
+			&defaultDistribution,
+			suite.Ctx.BlockTime(),
+			defaultDuration,
+			suite.TestAccs[0].String(),
+			types.DefaultCampaignCreationFee,
+		),
+	)
+	suite.Require().NoError(err)
+
+	// user can't claim before the owner sets the user role
+	_, err = suite.msgServer.Claim(suite.Ctx,
+		types.NewMsgClaim(1, "onfttest1", types.INTERACTION_TYPE_HOLD, user.String()))
+	suite.Require().Error(err)
+
+	_, err = suite.nftMsgServer.SetONFTUser(suite.Ctx, onfttypes.NewMsgSetONFTUser(
+		defaultNftDenomId, "onfttest1", user.String(), suite.Ctx.BlockTime().Add(time.Hour), owner.String()))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Claim(suite.Ctx,
+		types.NewMsgClaim(1, "onfttest1", types.INTERACTION_TYPE_HOLD, user.String()))
+	suite.Require().NoError(err)
+
+	// owner still can claim with other nfts
+	_, err = suite.msgServer.Claim(suite.Ctx,
+		types.NewMsgClaim(1, "onfttest2", types.INTERACTION_TYPE_HOLD, owner.String()))
+	suite.Require().NoError(err)
+}
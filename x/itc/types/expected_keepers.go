@@ -32,6 +32,9 @@ type BankKeeper interface {
 type NftKeeper interface {
 	GetONFT(ctx sdk.Context, denomId, onftId string) (nft nft.ONFTI, err error)
 	GetDenomInfo(ctx sdk.Context, denomId string) (*nfttypes.Denom, error)
+	HasPermissionToMint(ctx sdk.Context, denomID string, sender sdk.AccAddress) bool
+	UseMintQuota(ctx sdk.Context, denomID string, sender sdk.AccAddress, count uint64) error
+	GetCurrentUser(ctx sdk.Context, denomID, onftID string) (sdk.AccAddress, bool)
 	MintONFT(
 		ctx sdk.Context,
 		denomID,
@@ -47,9 +50,19 @@ type NftKeeper interface {
 		extensible,
 		nsfw bool,
 		royaltyShare sdkmath.LegacyDec,
+		royaltyReceivers []*nfttypes.WeightedAddress,
+		transferLockedUntil *time.Time,
 		receiver sdk.AccAddress,
 	) error
 	TransferOwnership(ctx sdk.Context, denomId, nftId string, srcOwner, dstOwner sdk.AccAddress) error
+	TransferOwnershipWithCause(
+		ctx sdk.Context,
+		denomId,
+		nftId string,
+		srcOwner,
+		dstOwner sdk.AccAddress,
+		cause nfttypes.OwnershipChangeCause,
+	) error
 	BurnONFT(ctx sdk.Context, denomId, nftId string, owner sdk.AccAddress) error
 }
 
@@ -9,7 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
-	github_com_gogo_protobuf_types "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
@@ -245,6 +245,8 @@ type NFTDetails struct {
 	UriHash       string                      `protobuf:"bytes,11,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	StartIndex    uint64                      `protobuf:"varint,12,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
 	NameDelimiter string                      `protobuf:"bytes,13,opt,name=name_delimiter,json=nameDelimiter,proto3" json:"name_delimiter,omitempty"`
+	// transfer_locked_until blocks transfers of the claimed oNFTs by their owners until the time when set
+	TransferLockedUntil *time.Time `protobuf:"bytes,14,opt,name=transfer_locked_until,json=transferLockedUntil,proto3,stdtime" json:"transfer_locked_until,omitempty" yaml:"transfer_locked_until"`
 }
 
 func (m *NFTDetails) Reset()         { *m = NFTDetails{} }
@@ -334,86 +336,89 @@ func init() {
 func init() { proto.RegisterFile("OmniFlix/itc/v1/itc.proto", fileDescriptor_bab9913014645745) }
 
 var fileDescriptor_bab9913014645745 = []byte{
-	// 1263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x1d, 0x3b, 0x96, 0x46, 0xb6, 0xac, 0x6c, 0xfe, 0x68, 0xbb, 0x96, 0x54, 0x02, 0x05,
-	0x0c, 0x1f, 0x28, 0x38, 0x6d, 0x50, 0xa4, 0x3d, 0xe9, 0xcf, 0x0d, 0x51, 0x5b, 0x36, 0x56, 0x74,
-	0xd1, 0xe4, 0xc2, 0xae, 0xc8, 0x95, 0xb4, 0x08, 0x7f, 0x04, 0x72, 0xa5, 0xd8, 0x6f, 0x11, 0xa0,
-	0x97, 0x5e, 0xfa, 0x28, 0xbd, 0xe7, 0xd0, 0x43, 0x8e, 0x45, 0x0f, 0x6a, 0x93, 0xbc, 0x81, 0x9f,
-	0xa0, 0xd8, 0x5d, 0xd2, 0x52, 0xe4, 0xa2, 0x41, 0x73, 0xd2, 0xee, 0x37, 0x33, 0xdf, 0x0c, 0x67,
-	0x67, 0xbf, 0x15, 0x6c, 0x9f, 0x06, 0x21, 0x3b, 0xf2, 0xd9, 0x45, 0x9d, 0x71, 0xb7, 0x3e, 0x3d,
-	0x14, 0x3f, 0xe6, 0x38, 0x8e, 0x78, 0x84, 0xb6, 0x32, 0x93, 0x29, 0xb0, 0xe9, 0xe1, 0x4e, 0xc5,
-	0x8d, 0x92, 0x20, 0x4a, 0xea, 0x7d, 0x92, 0xd0, 0xfa, 0xf4, 0xb0, 0x4f, 0x39, 0x39, 0xac, 0xbb,
-	0x11, 0x0b, 0x55, 0xc0, 0xce, 0xbd, 0x61, 0x34, 0x8c, 0xe4, 0xb2, 0x2e, 0x56, 0x29, 0x5a, 0x1d,
-	0x46, 0xd1, 0xd0, 0xa7, 0x75, 0xb9, 0xeb, 0x4f, 0x06, 0x75, 0xce, 0x02, 0x9a, 0x70, 0x12, 0x8c,
-	0x53, 0x87, 0xca, 0xb2, 0x83, 0x37, 0x89, 0x09, 0x67, 0x51, 0x4a, 0x6b, 0xfc, 0x56, 0x80, 0x7c,
-	0x8b, 0x04, 0x63, 0xc2, 0x86, 0x21, 0x2a, 0xc1, 0x0a, 0xf3, 0x74, 0xad, 0xa6, 0xed, 0xaf, 0xe2,
-	0x15, 0xe6, 0x21, 0x04, 0xab, 0x21, 0x09, 0xa8, 0xbe, 0x52, 0xd3, 0xf6, 0x0b, 0x58, 0xae, 0x51,
-	0x0d, 0x8a, 0x1e, 0x4d, 0xdc, 0x98, 0x8d, 0x05, 0x8b, 0x7e, 0x4b, 0x9a, 0x16, 0x21, 0xf4, 0x23,
-	0x40, 0xc2, 0x49, 0xcc, 0x1d, 0x51, 0x8b, 0xbe, 0x5a, 0xd3, 0xf6, 0x8b, 0x8f, 0x76, 0x4c, 0x55,
-	0x87, 0x99, 0xd5, 0x61, 0xda, 0x59, 0xa1, 0xcd, 0xbd, 0xd7, 0xb3, 0x6a, 0xee, 0x6a, 0x56, 0xbd,
-	0x73, 0x49, 0x02, 0xff, 0x1b, 0x63, 0x1e, 0x6b, 0xbc, 0xfa, 0xab, 0xaa, 0xe1, 0x82, 0x04, 0x84,
-	0x3b, 0xc2, 0x90, 0xa7, 0xa1, 0xa7, 0x78, 0xd7, 0x3e, 0xca, 0xbb, 0x9b, 0xf2, 0x6e, 0x29, 0xde,
-	0x2c, 0x52, 0xb1, 0xae, 0xd3, 0xd0, 0x93, 0x9c, 0x3a, 0xac, 0xbb, 0x31, 0x25, 0x3c, 0x8a, 0xf5,
-	0xdb, 0xf2, 0x5b, 0xb2, 0x2d, 0x7a, 0x02, 0x1b, 0xe1, 0x80, 0x3b, 0x1e, 0x0d, 0xa3, 0xc0, 0x61,
-	0x9e, 0xbe, 0x2e, 0xcc, 0xcd, 0x87, 0x57, 0xb3, 0xea, 0x5d, 0xc5, 0xb8, 0x68, 0x35, 0x30, 0x84,
-	0x03, 0xde, 0x16, 0x3b, 0xcb, 0x43, 0xdf, 0x03, 0x0a, 0xc8, 0x85, 0x43, 0x7c, 0x3f, 0x7a, 0x49,
-	0x3d, 0xc7, 0xf5, 0x09, 0x0b, 0x12, 0x3d, 0x2f, 0x1a, 0xdb, 0xdc, 0xbb, 0x9a, 0x55, 0xb7, 0x15,
-	0xc1, 0x4d, 0x1f, 0x03, 0x97, 0x03, 0x72, 0xd1, 0x50, 0x58, 0x4b, 0x42, 0xa8, 0x09, 0x45, 0x16,
-	0x72, 0x1a, 0x13, 0x57, 0x76, 0xbc, 0x50, 0xd3, 0xf6, 0x4b, 0x8f, 0x6a, 0xe6, 0xd2, 0x00, 0x99,
-	0xd6, 0xdc, 0xc7, 0xbe, 0x1c, 0x53, 0xbc, 0x18, 0x84, 0x9e, 0x00, 0xc8, 0x04, 0x0e, 0xbf, 0x1c,
-	0x53, 0x1d, 0x24, 0xc5, 0xce, 0x0d, 0x0a, 0x99, 0x50, 0x06, 0x17, 0xdc, 0x6c, 0x89, 0x3c, 0x28,
-	0xf3, 0xe8, 0x05, 0x0d, 0x13, 0x67, 0x4c, 0x63, 0x55, 0xa6, 0x5e, 0x94, 0xcd, 0xdf, 0x36, 0xd5,
-	0xcc, 0x9a, 0x62, 0x66, 0xcd, 0x74, 0x66, 0xcd, 0x56, 0xc4, 0xc2, 0x66, 0x35, 0xed, 0xfd, 0x43,
-	0xf5, 0xa1, 0xcb, 0x04, 0x06, 0x2e, 0x29, 0xe8, 0x8c, 0xc6, 0x32, 0x29, 0x7a, 0x06, 0x1b, 0x3c,
-	0xe2, 0xc4, 0x77, 0x14, 0xae, 0x6f, 0x7c, 0x2c, 0x43, 0x76, 0xba, 0x77, 0xb3, 0x0c, 0xf3, 0x60,
-	0x03, 0x17, 0xe5, 0xd6, 0x96, 0x3b, 0x44, 0xa1, 0x4c, 0xa6, 0x84, 0xf9, 0xa4, 0xef, 0xd3, 0x8c,
-	0x7e, 0xf3, 0x7f, 0x7e, 0xc0, 0x32, 0x81, 0x81, 0xb7, 0xae, 0xa1, 0x34, 0x4d, 0x07, 0xca, 0x31,
-	0x75, 0x29, 0x9b, 0x52, 0xcf, 0x11, 0x93, 0xc1, 0xbc, 0x44, 0x2f, 0xd5, 0x6e, 0xed, 0x17, 0x9a,
-	0xbb, 0x73, 0x9e, 0x65, 0x0f, 0x03, 0x97, 0x32, 0xa8, 0x3b, 0xe0, 0x96, 0x97, 0xa0, 0x3e, 0x94,
-	0x85, 0x2d, 0x60, 0xa1, 0x18, 0x2e, 0x4e, 0x98, 0x9f, 0xe8, 0x5b, 0xb2, 0xda, 0xdd, 0x1b, 0xe7,
-	0xd5, 0x3d, 0xb2, 0xdb, 0xca, 0x65, 0x31, 0xc7, 0x72, 0xb8, 0x81, 0x4b, 0xe1, 0x80, 0x9f, 0xb0,
-	0x90, 0xa7, 0xce, 0xe8, 0x39, 0x6c, 0x78, 0x2c, 0xe1, 0x31, 0xeb, 0x4f, 0xe4, 0x48, 0x95, 0x25,
-	0xff, 0xde, 0x0d, 0xfe, 0xf6, 0x82, 0xd3, 0xe2, 0xe0, 0x2f, 0x06, 0x1b, 0xf8, 0x03, 0x2e, 0xf4,
-	0x15, 0x80, 0x4c, 0xee, 0x46, 0x93, 0x90, 0xeb, 0x77, 0xe4, 0xc8, 0xdf, 0x9f, 0xdf, 0xee, 0xb9,
-	0xcd, 0xc0, 0x05, 0xb1, 0x69, 0x89, 0x35, 0xfa, 0x1a, 0x8a, 0x6a, 0x3e, 0x55, 0x18, 0x92, 0x61,
-	0x0f, 0xae, 0x66, 0x55, 0xa4, 0xc2, 0x16, 0x8c, 0x06, 0x56, 0xa3, 0x2c, 0x03, 0x8d, 0x9f, 0x35,
-	0xd8, 0x58, 0x2c, 0x13, 0x3d, 0x86, 0x55, 0x39, 0xe3, 0x9a, 0x9c, 0xf1, 0xcf, 0xff, 0xf3, 0x9b,
-	0xe4, 0xa8, 0x4b, 0x77, 0x74, 0x0c, 0x5b, 0x09, 0x8f, 0x29, 0x09, 0x9c, 0x4c, 0x20, 0xa5, 0xea,
-	0x89, 0x19, 0x59, 0x56, 0x98, 0x76, 0xea, 0xd0, 0xcc, 0x8b, 0x19, 0xf9, 0x45, 0xa8, 0x49, 0x49,
-	0xc5, 0x66, 0x16, 0xe3, 0xf7, 0x5b, 0x00, 0xf3, 0xc3, 0x41, 0xdb, 0x90, 0xbf, 0x56, 0x11, 0x4d,
-	0x89, 0x8c, 0x97, 0x2a, 0xc5, 0xa7, 0x49, 0xec, 0x2e, 0x14, 0x02, 0xea, 0x31, 0xe2, 0x4c, 0x62,
-	0x26, 0x15, 0xb6, 0x80, 0xf3, 0x12, 0x38, 0x8f, 0x19, 0xaa, 0x42, 0x71, 0x1c, 0xd3, 0x29, 0xa3,
-	0x2f, 0xa5, 0x79, 0x4d, 0x9a, 0x21, 0x85, 0x84, 0xc3, 0x4f, 0xb0, 0x19, 0x47, 0x97, 0xc4, 0xe7,
-	0x97, 0x4e, 0x32, 0x22, 0x31, 0x55, 0xc2, 0xd7, 0xfc, 0x56, 0x7c, 0xce, 0x9f, 0xb3, 0xea, 0xae,
-	0xba, 0x14, 0x89, 0xf7, 0xc2, 0x64, 0x51, 0x3d, 0x20, 0x7c, 0x64, 0x1e, 0xd3, 0x21, 0x71, 0x2f,
-	0xdb, 0xd4, 0xbd, 0x9a, 0x55, 0xef, 0xa5, 0x93, 0xbc, 0xc8, 0x60, 0xe0, 0x8d, 0x74, 0xdf, 0x13,
-	0x5b, 0x64, 0xc0, 0x06, 0x8f, 0x49, 0x98, 0x0c, 0x68, 0x2c, 0x6e, 0x88, 0x94, 0xce, 0x3c, 0xfe,
-	0x00, 0x43, 0x15, 0x00, 0x7a, 0xc1, 0x69, 0x98, 0x30, 0xe1, 0x91, 0x97, 0x1e, 0x0b, 0x88, 0xec,
-	0x4c, 0x32, 0x78, 0x29, 0xf5, 0x2e, 0x8f, 0xe5, 0x5a, 0x60, 0x1e, 0xe1, 0x44, 0x0a, 0x58, 0x01,
-	0xcb, 0xb5, 0x68, 0xee, 0x24, 0x66, 0xce, 0x88, 0x24, 0x23, 0xa9, 0x4b, 0x05, 0xbc, 0x3e, 0x89,
-	0xd9, 0x53, 0x92, 0x8c, 0x44, 0x27, 0xd4, 0x6b, 0xc2, 0x42, 0x8f, 0x5e, 0x48, 0x4d, 0x59, 0xc5,
-	0xea, 0x71, 0xb2, 0x04, 0x82, 0xbe, 0x80, 0x92, 0xe8, 0xb8, 0xe3, 0x51, 0x9f, 0x05, 0x8c, 0xd3,
-	0x58, 0x0a, 0x43, 0x01, 0x6f, 0x0a, 0xb4, 0x9d, 0x81, 0xc6, 0xaf, 0x1a, 0xac, 0x29, 0x99, 0xaa,
-	0x42, 0xd1, 0x4d, 0x5f, 0x4b, 0xe7, 0xfa, 0xa9, 0x84, 0x0c, 0xb2, 0x3c, 0xf1, 0x9c, 0x10, 0xcf,
-	0x8b, 0x69, 0x92, 0xa4, 0x47, 0x9a, 0x6d, 0xd1, 0x7d, 0xb8, 0xad, 0x2e, 0x7d, 0x7a, 0xa0, 0x6b,
-	0xa1, 0xb8, 0xf0, 0xcb, 0xea, 0xbe, 0xfa, 0x09, 0xea, 0x7e, 0x70, 0x0a, 0xe5, 0xe5, 0xb1, 0x46,
-	0x7b, 0xb0, 0xdd, 0xb6, 0x7a, 0x36, 0xb6, 0x9a, 0xe7, 0xb6, 0x75, 0xda, 0x75, 0xec, 0x67, 0x67,
-	0x1d, 0xc7, 0xea, 0xf6, 0xec, 0x46, 0xd7, 0x2e, 0xe7, 0xd0, 0x67, 0xa0, 0xdf, 0x34, 0xf7, 0x6c,
-	0xdc, 0x69, 0x9c, 0x94, 0xb5, 0x83, 0x01, 0x6c, 0x2d, 0x25, 0x44, 0xdb, 0x70, 0xdf, 0xea, 0xda,
-	0x1d, 0xdc, 0x68, 0xcd, 0xfd, 0x9b, 0xe7, 0xb8, 0x5b, 0xce, 0x89, 0x54, 0x37, 0x4c, 0x36, 0x6e,
-	0x74, 0x7b, 0x47, 0x1d, 0x5c, 0xd6, 0xfe, 0x35, 0xf2, 0xe9, 0xe9, 0x71, 0xbb, 0xbc, 0x72, 0xf0,
-	0x02, 0x4a, 0xd9, 0x9f, 0x8f, 0x1e, 0x27, 0x7c, 0x92, 0xa0, 0x2a, 0xec, 0xb6, 0x1a, 0x27, 0x67,
-	0x0d, 0xeb, 0xbb, 0xae, 0xd3, 0xb3, 0x1b, 0xf6, 0x79, 0xcf, 0x39, 0xef, 0xf6, 0xce, 0x3a, 0x2d,
-	0xeb, 0xc8, 0xea, 0xb4, 0x55, 0xe1, 0xcb, 0x0e, 0x56, 0x57, 0x50, 0xff, 0xd0, 0x29, 0x6b, 0x68,
-	0x07, 0x1e, 0x2c, 0x5b, 0x53, 0xdb, 0xca, 0xc1, 0x09, 0x14, 0xae, 0x1f, 0x38, 0x74, 0x07, 0x36,
-	0x5b, 0xc7, 0x0d, 0xeb, 0x44, 0x95, 0x73, 0x24, 0x5a, 0x82, 0xa0, 0xb4, 0x00, 0x75, 0x8f, 0x6c,
-	0x55, 0xfb, 0x07, 0x6e, 0x4e, 0xa3, 0xdb, 0x96, 0xa6, 0x95, 0xe6, 0xd9, 0xeb, 0xb7, 0x95, 0xdc,
-	0x9b, 0xb7, 0x95, 0xdc, 0xeb, 0x77, 0x15, 0xed, 0xcd, 0xbb, 0x8a, 0xf6, 0xf7, 0xbb, 0x8a, 0xf6,
-	0xea, 0x7d, 0x25, 0xf7, 0xe6, 0x7d, 0x25, 0xf7, 0xc7, 0xfb, 0x4a, 0xee, 0xb9, 0x39, 0x64, 0x7c,
-	0x34, 0xe9, 0x9b, 0x6e, 0x14, 0xd4, 0xaf, 0xff, 0x09, 0x46, 0x41, 0xc8, 0x06, 0x3e, 0xbb, 0x18,
-	0x4d, 0xfa, 0xf5, 0xe9, 0xe3, 0xba, 0xfa, 0x6b, 0x28, 0x24, 0x28, 0xe9, 0xdf, 0x96, 0x12, 0xf3,
-	0xe5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x08, 0xed, 0x73, 0x37, 0x0a, 0x00, 0x00,
+	// 1301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0x16, 0x1d, 0x3b, 0x96, 0x8e, 0x6c, 0x59, 0x99, 0xc4, 0x09, 0x6d, 0xc7, 0xa2, 0x2f, 0x71,
+	0x2f, 0x60, 0x78, 0x41, 0xc1, 0xb9, 0xf7, 0xb6, 0x48, 0xbb, 0xd2, 0x9f, 0x1b, 0xa2, 0xb6, 0x6c,
+	0x8c, 0xe8, 0xa2, 0xc9, 0x86, 0x1d, 0x91, 0x23, 0x7b, 0x60, 0xfe, 0x08, 0xe4, 0x48, 0xb1, 0xdf,
+	0x22, 0x40, 0x37, 0xdd, 0xf4, 0x51, 0xba, 0xe9, 0x2a, 0xcb, 0x2c, 0x8b, 0x2e, 0xd4, 0x26, 0x79,
+	0x03, 0x3f, 0x41, 0x31, 0x33, 0xa4, 0xa5, 0xc8, 0x41, 0x83, 0x66, 0xa5, 0x99, 0xef, 0x9c, 0xf3,
+	0xcd, 0xe1, 0xe8, 0xe3, 0x77, 0x08, 0x1b, 0xc7, 0x61, 0xc4, 0x0e, 0x02, 0x76, 0x59, 0x67, 0xdc,
+	0xab, 0x8f, 0xf7, 0xc5, 0x8f, 0x35, 0x4c, 0x62, 0x1e, 0xa3, 0xb5, 0x3c, 0x64, 0x09, 0x6c, 0xbc,
+	0xbf, 0x59, 0xf3, 0xe2, 0x34, 0x8c, 0xd3, 0x7a, 0x9f, 0xa4, 0xb4, 0x3e, 0xde, 0xef, 0x53, 0x4e,
+	0xf6, 0xeb, 0x5e, 0xcc, 0x22, 0x55, 0xb0, 0xf9, 0xe0, 0x2c, 0x3e, 0x8b, 0xe5, 0xb2, 0x2e, 0x56,
+	0x19, 0x6a, 0x9c, 0xc5, 0xf1, 0x59, 0x40, 0xeb, 0x72, 0xd7, 0x1f, 0x0d, 0xea, 0x9c, 0x85, 0x34,
+	0xe5, 0x24, 0x1c, 0x66, 0x09, 0xb5, 0xf9, 0x04, 0x7f, 0x94, 0x10, 0xce, 0xe2, 0x8c, 0xd6, 0xfc,
+	0xa5, 0x04, 0xc5, 0x16, 0x09, 0x87, 0x84, 0x9d, 0x45, 0xa8, 0x02, 0x0b, 0xcc, 0xd7, 0xb5, 0x1d,
+	0x6d, 0x77, 0x11, 0x2f, 0x30, 0x1f, 0x21, 0x58, 0x8c, 0x48, 0x48, 0xf5, 0x85, 0x1d, 0x6d, 0xb7,
+	0x84, 0xe5, 0x1a, 0xed, 0x40, 0xd9, 0xa7, 0xa9, 0x97, 0xb0, 0xa1, 0x60, 0xd1, 0xef, 0xc8, 0xd0,
+	0x2c, 0x84, 0xbe, 0x07, 0x48, 0x39, 0x49, 0xb8, 0x2b, 0x7a, 0xd1, 0x17, 0x77, 0xb4, 0xdd, 0xf2,
+	0x93, 0x4d, 0x4b, 0xf5, 0x61, 0xe5, 0x7d, 0x58, 0x4e, 0xde, 0x68, 0x73, 0xfb, 0xf5, 0xc4, 0x28,
+	0x5c, 0x4f, 0x8c, 0x7b, 0x57, 0x24, 0x0c, 0xbe, 0x32, 0xa7, 0xb5, 0xe6, 0xab, 0x3f, 0x0c, 0x0d,
+	0x97, 0x24, 0x20, 0xd2, 0x11, 0x86, 0x22, 0x8d, 0x7c, 0xc5, 0xbb, 0xf4, 0x49, 0xde, 0xad, 0x8c,
+	0x77, 0x4d, 0xf1, 0xe6, 0x95, 0x8a, 0x75, 0x99, 0x46, 0xbe, 0xe4, 0xd4, 0x61, 0xd9, 0x4b, 0x28,
+	0xe1, 0x71, 0xa2, 0xdf, 0x95, 0xcf, 0x92, 0x6f, 0xd1, 0x53, 0x58, 0x89, 0x06, 0xdc, 0xf5, 0x69,
+	0x14, 0x87, 0x2e, 0xf3, 0xf5, 0x65, 0x11, 0x6e, 0x3e, 0xba, 0x9e, 0x18, 0xf7, 0x15, 0xe3, 0x6c,
+	0xd4, 0xc4, 0x10, 0x0d, 0x78, 0x5b, 0xec, 0x6c, 0x1f, 0x7d, 0x0b, 0x28, 0x24, 0x97, 0x2e, 0x09,
+	0x82, 0xf8, 0x25, 0xf5, 0x5d, 0x2f, 0x20, 0x2c, 0x4c, 0xf5, 0xa2, 0xb8, 0xd8, 0xe6, 0xf6, 0xf5,
+	0xc4, 0xd8, 0x50, 0x04, 0xb7, 0x73, 0x4c, 0x5c, 0x0d, 0xc9, 0x65, 0x43, 0x61, 0x2d, 0x09, 0xa1,
+	0x26, 0x94, 0x59, 0xc4, 0x69, 0x42, 0x3c, 0x79, 0xe3, 0xa5, 0x1d, 0x6d, 0xb7, 0xf2, 0x64, 0xc7,
+	0x9a, 0x13, 0x90, 0x65, 0x4f, 0x73, 0x9c, 0xab, 0x21, 0xc5, 0xb3, 0x45, 0xe8, 0x29, 0x80, 0x3c,
+	0xc0, 0xe5, 0x57, 0x43, 0xaa, 0x83, 0xa4, 0xd8, 0xbc, 0x45, 0x21, 0x0f, 0x94, 0xc5, 0x25, 0x2f,
+	0x5f, 0x22, 0x1f, 0xaa, 0x3c, 0xbe, 0xa0, 0x51, 0xea, 0x0e, 0x69, 0xa2, 0xda, 0xd4, 0xcb, 0xf2,
+	0xf2, 0x37, 0x2c, 0xa5, 0x59, 0x4b, 0x68, 0xd6, 0xca, 0x34, 0x6b, 0xb5, 0x62, 0x16, 0x35, 0x8d,
+	0xec, 0xee, 0x1f, 0xa9, 0x07, 0x9d, 0x27, 0x30, 0x71, 0x45, 0x41, 0x27, 0x34, 0x91, 0x87, 0xa2,
+	0xe7, 0xb0, 0xc2, 0x63, 0x4e, 0x02, 0x57, 0xe1, 0xfa, 0xca, 0xa7, 0x4e, 0xc8, 0xff, 0xdd, 0xfb,
+	0xf9, 0x09, 0xd3, 0x62, 0x13, 0x97, 0xe5, 0xd6, 0x91, 0x3b, 0x44, 0xa1, 0x4a, 0xc6, 0x84, 0x05,
+	0xa4, 0x1f, 0xd0, 0x9c, 0x7e, 0xf5, 0x1f, 0x3e, 0xc0, 0x3c, 0x81, 0x89, 0xd7, 0x6e, 0xa0, 0xec,
+	0x98, 0x0e, 0x54, 0x13, 0xea, 0x51, 0x36, 0xa6, 0xbe, 0x2b, 0x94, 0xc1, 0xfc, 0x54, 0xaf, 0xec,
+	0xdc, 0xd9, 0x2d, 0x35, 0xb7, 0xa6, 0x3c, 0xf3, 0x19, 0x26, 0xae, 0xe4, 0x50, 0x77, 0xc0, 0x6d,
+	0x3f, 0x45, 0x7d, 0xa8, 0x8a, 0x58, 0xc8, 0x22, 0x21, 0x2e, 0x4e, 0x58, 0x90, 0xea, 0x6b, 0xb2,
+	0xdb, 0xad, 0x5b, 0xff, 0x57, 0xf7, 0xc0, 0x69, 0xab, 0x94, 0xd9, 0x33, 0xe6, 0xcb, 0x4d, 0x5c,
+	0x89, 0x06, 0xfc, 0x88, 0x45, 0x3c, 0x4b, 0x46, 0x2f, 0x60, 0xc5, 0x67, 0x29, 0x4f, 0x58, 0x7f,
+	0x24, 0x25, 0x55, 0x95, 0xfc, 0xdb, 0xb7, 0xf8, 0xdb, 0x33, 0x49, 0xb3, 0xc2, 0x9f, 0x2d, 0x36,
+	0xf1, 0x07, 0x5c, 0xe8, 0x7f, 0x00, 0xf2, 0x70, 0x2f, 0x1e, 0x45, 0x5c, 0xbf, 0x27, 0x25, 0xbf,
+	0x3e, 0x7d, 0xbb, 0xa7, 0x31, 0x13, 0x97, 0xc4, 0xa6, 0x25, 0xd6, 0xe8, 0x4b, 0x28, 0x2b, 0x7d,
+	0xaa, 0x32, 0x24, 0xcb, 0x1e, 0x5e, 0x4f, 0x0c, 0xa4, 0xca, 0x66, 0x82, 0x26, 0x56, 0x52, 0x96,
+	0x85, 0xe6, 0x8f, 0x1a, 0xac, 0xcc, 0xb6, 0x89, 0xfe, 0x0f, 0x8b, 0x52, 0xe3, 0x9a, 0xd4, 0xf8,
+	0xbf, 0xfe, 0xf6, 0x99, 0xa4, 0xd4, 0x65, 0x3a, 0x3a, 0x84, 0xb5, 0x94, 0x27, 0x94, 0x84, 0x6e,
+	0x6e, 0x90, 0xfa, 0x42, 0xa6, 0x91, 0x79, 0x87, 0x69, 0x67, 0x09, 0xcd, 0xa2, 0xd0, 0xc8, 0x4f,
+	0xc2, 0x4d, 0x2a, 0xaa, 0x36, 0x8f, 0x98, 0xbf, 0x2e, 0x02, 0x4c, 0xff, 0x1c, 0xb4, 0x01, 0xc5,
+	0x1b, 0x17, 0xd1, 0x94, 0xc9, 0xf8, 0x99, 0x53, 0x7c, 0x9e, 0xc5, 0x6e, 0x41, 0x29, 0xa4, 0x3e,
+	0x23, 0xee, 0x28, 0x61, 0xd2, 0x61, 0x4b, 0xb8, 0x28, 0x81, 0xd3, 0x84, 0x21, 0x03, 0xca, 0xc3,
+	0x84, 0x8e, 0x19, 0x7d, 0x29, 0xc3, 0x4b, 0x32, 0x0c, 0x19, 0x24, 0x12, 0x7e, 0x80, 0xd5, 0x24,
+	0xbe, 0x22, 0x01, 0xbf, 0x72, 0xd3, 0x73, 0x92, 0x50, 0x65, 0x7c, 0xcd, 0xaf, 0xc5, 0xe3, 0xfc,
+	0x3e, 0x31, 0xb6, 0xd4, 0x4b, 0x91, 0xfa, 0x17, 0x16, 0x8b, 0xeb, 0x21, 0xe1, 0xe7, 0xd6, 0x21,
+	0x3d, 0x23, 0xde, 0x55, 0x9b, 0x7a, 0xd7, 0x13, 0xe3, 0x41, 0xa6, 0xe4, 0x59, 0x06, 0x13, 0xaf,
+	0x64, 0xfb, 0x9e, 0xd8, 0x22, 0x13, 0x56, 0x78, 0x42, 0xa2, 0x74, 0x40, 0x13, 0xf1, 0x86, 0x48,
+	0xeb, 0x2c, 0xe2, 0x0f, 0x30, 0x54, 0x03, 0xa0, 0x97, 0x9c, 0x46, 0x29, 0x13, 0x19, 0x45, 0x99,
+	0x31, 0x83, 0xc8, 0x9b, 0x49, 0x07, 0x2f, 0xa5, 0xdf, 0x15, 0xb1, 0x5c, 0x0b, 0xcc, 0x27, 0x9c,
+	0x48, 0x03, 0x2b, 0x61, 0xb9, 0x16, 0x97, 0x3b, 0x4a, 0x98, 0x7b, 0x4e, 0xd2, 0x73, 0xe9, 0x4b,
+	0x25, 0xbc, 0x3c, 0x4a, 0xd8, 0x33, 0x92, 0x9e, 0x8b, 0x9b, 0x50, 0xd3, 0x84, 0x45, 0x3e, 0xbd,
+	0x94, 0x9e, 0xb2, 0x88, 0xd5, 0x70, 0xb2, 0x05, 0x82, 0xfe, 0x03, 0x15, 0x71, 0xe3, 0xae, 0x4f,
+	0x03, 0x16, 0x32, 0x4e, 0x13, 0x69, 0x0c, 0x25, 0xbc, 0x2a, 0xd0, 0x76, 0x0e, 0x22, 0x0e, 0xeb,
+	0x79, 0xeb, 0x6e, 0x10, 0x7b, 0x17, 0xd4, 0x77, 0x47, 0x11, 0x67, 0x81, 0x5e, 0xf9, 0xe4, 0x10,
+	0xfa, 0xf7, 0xf5, 0xc4, 0x78, 0x9c, 0x59, 0xd4, 0xc7, 0x28, 0xd4, 0x34, 0xba, 0x9f, 0xc7, 0x0e,
+	0x65, 0xe8, 0x54, 0x46, 0x7e, 0xd6, 0x60, 0x49, 0x99, 0xa3, 0x01, 0x65, 0x2f, 0x9b, 0xd1, 0xee,
+	0xcd, 0x80, 0x86, 0x1c, 0xb2, 0x7d, 0x31, 0xc4, 0x88, 0xef, 0x27, 0x34, 0x4d, 0x33, 0x21, 0xe5,
+	0x5b, 0xb4, 0x0e, 0x77, 0x95, 0xd5, 0x64, 0x32, 0x5a, 0x8a, 0x84, 0xcd, 0xcc, 0xcf, 0x94, 0xc5,
+	0xcf, 0x98, 0x29, 0x7b, 0xc7, 0x50, 0x9d, 0x7f, 0x99, 0xd0, 0x36, 0x6c, 0xb4, 0xed, 0x9e, 0x83,
+	0xed, 0xe6, 0xa9, 0x63, 0x1f, 0x77, 0x5d, 0xe7, 0xf9, 0x49, 0xc7, 0xb5, 0xbb, 0x3d, 0xa7, 0xd1,
+	0x75, 0xaa, 0x05, 0xf4, 0x18, 0xf4, 0xdb, 0xe1, 0x9e, 0x83, 0x3b, 0x8d, 0xa3, 0xaa, 0xb6, 0x37,
+	0x80, 0xb5, 0xb9, 0x03, 0xd1, 0x06, 0xac, 0xdb, 0x5d, 0xa7, 0x83, 0x1b, 0xad, 0x69, 0x7e, 0xf3,
+	0x14, 0x77, 0xab, 0x05, 0x71, 0xd4, 0xad, 0x90, 0x83, 0x1b, 0xdd, 0xde, 0x41, 0x07, 0x57, 0xb5,
+	0x8f, 0x56, 0x3e, 0x3b, 0x3e, 0x6c, 0x57, 0x17, 0xf6, 0x2e, 0xa0, 0x92, 0x7f, 0xf2, 0xf4, 0x38,
+	0xe1, 0xa3, 0x14, 0x19, 0xb0, 0xd5, 0x6a, 0x1c, 0x9d, 0x34, 0xec, 0x6f, 0xba, 0x6e, 0xcf, 0x69,
+	0x38, 0xa7, 0x3d, 0xf7, 0xb4, 0xdb, 0x3b, 0xe9, 0xb4, 0xec, 0x03, 0xbb, 0xd3, 0x56, 0x8d, 0xcf,
+	0x27, 0xd8, 0x5d, 0x41, 0xfd, 0x5d, 0xa7, 0xaa, 0xa1, 0x4d, 0x78, 0x38, 0x1f, 0xcd, 0x62, 0x0b,
+	0x7b, 0x47, 0x50, 0xba, 0x19, 0xab, 0xe8, 0x1e, 0xac, 0xb6, 0x0e, 0x1b, 0xf6, 0x91, 0x6a, 0xe7,
+	0x40, 0x5c, 0x09, 0x82, 0xca, 0x0c, 0xd4, 0x3d, 0x70, 0x54, 0xef, 0x1f, 0xa4, 0xb9, 0x8d, 0x6e,
+	0x5b, 0x86, 0x16, 0x9a, 0x27, 0xaf, 0xdf, 0xd6, 0x0a, 0x6f, 0xde, 0xd6, 0x0a, 0xaf, 0xdf, 0xd5,
+	0xb4, 0x37, 0xef, 0x6a, 0xda, 0x9f, 0xef, 0x6a, 0xda, 0xab, 0xf7, 0xb5, 0xc2, 0x9b, 0xf7, 0xb5,
+	0xc2, 0x6f, 0xef, 0x6b, 0x85, 0x17, 0xd6, 0x19, 0xe3, 0xe7, 0xa3, 0xbe, 0xe5, 0xc5, 0x61, 0xfd,
+	0xe6, 0xfb, 0x33, 0x0e, 0x23, 0x36, 0x08, 0xd8, 0xe5, 0xf9, 0xa8, 0x5f, 0x1f, 0x7f, 0x51, 0x57,
+	0x1f, 0xa4, 0xc2, 0xf8, 0xd2, 0xfe, 0x5d, 0xa9, 0xda, 0xff, 0xfe, 0x35, 0x00, 0xc3, 0xc3, 0x19,
+	0x4c, 0xad, 0x0a, 0x00, 0x00,
 }
 
 func (m *Campaign) Marshal() (dAtA []byte, err error) {
@@ -544,7 +549,7 @@ func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
 	if err6 != nil {
 		return 0, err6
 	}
@@ -552,7 +557,7 @@ func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i = encodeVarintItc(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
 	if err7 != nil {
 		return 0, err7
 	}
@@ -602,7 +607,7 @@ func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.StreamDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.StreamDuration):])
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StreamDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StreamDuration):])
 	if err8 != nil {
 		return 0, err8
 	}
@@ -638,6 +643,16 @@ func (m *NFTDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferLockedUntil != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TransferLockedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TransferLockedUntil):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintItc(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.NameDelimiter) > 0 {
 		i -= len(m.NameDelimiter)
 		copy(dAtA[i:], m.NameDelimiter)
@@ -817,9 +832,9 @@ func (m *Campaign) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovItc(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovItc(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
 	n += 1 + l + sovItc(uint64(l))
 	l = len(m.Creator)
 	if l > 0 {
@@ -876,7 +891,7 @@ func (m *Distribution) Size() (n int) {
 	if m.Type != 0 {
 		n += 1 + sovItc(uint64(m.Type))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.StreamDuration)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StreamDuration)
 	n += 1 + l + sovItc(uint64(l))
 	return n
 }
@@ -933,6 +948,10 @@ func (m *NFTDetails) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovItc(uint64(l))
 	}
+	if m.TransferLockedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TransferLockedUntil)
+		n += 1 + l + sovItc(uint64(l))
+	}
 	return n
 }
 
@@ -1106,7 +1125,7 @@ func (m *Campaign) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1139,7 +1158,7 @@ func (m *Campaign) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1603,7 +1622,7 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.StreamDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StreamDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2026,6 +2045,42 @@ func (m *NFTDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.NameDelimiter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLockedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowItc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthItc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthItc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLockedUntil == nil {
+				m.TransferLockedUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TransferLockedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipItc(dAtA[iNdEx:])
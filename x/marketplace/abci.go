@@ -13,5 +13,13 @@ func EndBlock(ctx sdk.Context, k keeper.Keeper) ([]abcitypes.ValidatorUpdate, er
 		return []abcitypes.ValidatorUpdate{}, err
 	}
 	log.Info("Updated Auctions and Processed bids.. ")
+	err = k.RefundExpiredOffers(ctx)
+	if err != nil {
+		return []abcitypes.ValidatorUpdate{}, err
+	}
+	err = k.RefundExpiredCollectionOffers(ctx)
+	if err != nil {
+		return []abcitypes.ValidatorUpdate{}, err
+	}
 	return []abcitypes.ValidatorUpdate{}, nil
 }
@@ -0,0 +1,146 @@
+package bindings_test
+
+import (
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/marketplace/bindings/types"
+	marketplacekeeper "github.com/OmniFlix/omniflixhub/v6/x/marketplace/keeper"
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+)
+
+const (
+	testDenomId   = "onftdenommarketplace"
+	testONFTId    = "onftmarketplace1"
+	testListingId = "listreflect1"
+)
+
+var testPrice = wasmvmtypes.Coin{Denom: "uflix", Amount: "1000000"}
+
+func TestListNFTMsg(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	require.NotEmpty(t, reflect)
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, reflect, testDenomId, testONFTId)
+
+	// the listing id is set by the contract
+	msg := bindings.MarketplaceMsg{ListNFT: &bindings.ListNFT{
+		Id:      testListingId,
+		DenomId: testDenomId,
+		NftId:   testONFTId,
+		Price:   testPrice,
+	}}
+	err := executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+
+	listing, found := customApp.MarketplaceKeeper.GetListing(ctx, testListingId)
+	require.True(t, found)
+	require.Equal(t, reflect.String(), listing.Owner)
+	require.Equal(t, testPrice.Amount, listing.Price.Amount.String())
+
+	// oNFTs not owned by the contract can't be listed
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, lucky, testDenomId, "onftmarketplace2")
+	msg = bindings.MarketplaceMsg{ListNFT: &bindings.ListNFT{
+		Id:      "listreflect2",
+		DenomId: testDenomId,
+		NftId:   "onftmarketplace2",
+		Price:   testPrice,
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.Error(t, err)
+}
+
+func TestBuyNFTMsg(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	seller := wasmtesting.RandomAccountAddress()
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, seller, testDenomId, testONFTId)
+
+	price, err := sdk.ParseCoinNormalized(testPrice.Amount + testPrice.Denom)
+	require.NoError(t, err)
+	msgListNFT := types.NewMsgListNFT(testDenomId, testONFTId, price, seller, nil)
+	msgListNFT.Id = testListingId
+	_, err = marketplacekeeper.NewMsgServerImpl(customApp.MarketplaceKeeper).ListNFT(ctx, msgListNFT)
+	require.NoError(t, err)
+
+	// the price is paid by the contract
+	msg := bindings.MarketplaceMsg{BuyNFT: &bindings.BuyNFT{
+		Id:    testListingId,
+		Price: testPrice,
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.Error(t, err)
+
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(price))
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+
+	onft, err := customApp.ONFTKeeper.GetONFT(ctx, testDenomId, testONFTId)
+	require.NoError(t, err)
+	require.Equal(t, reflect.String(), onft.GetOwner().String())
+	_, found := customApp.MarketplaceKeeper.GetListing(ctx, testListingId)
+	require.False(t, found)
+}
+
+func TestAuctionMsgs(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	bidder := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, reflect, testDenomId, testONFTId)
+
+	// auctions start at the current block time by default
+	msg := bindings.MarketplaceMsg{CreateAuction: &bindings.CreateAuction{
+		DenomId:             testDenomId,
+		NftId:               testONFTId,
+		StartPrice:          testPrice,
+		Duration:            3600,
+		IncrementPercentage: "0.1",
+	}}
+	err := executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+
+	auction, found := customApp.MarketplaceKeeper.GetAuctionListing(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, reflect.String(), auction.Owner)
+	require.Equal(t, ctx.BlockTime().Unix(), auction.StartTime.Unix())
+	require.Equal(t, ctx.BlockTime().Add(time.Hour).Unix(), auction.EndTime.Unix())
+
+	// the bid amount is paid by the contract
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	bidAmount, err := sdk.ParseCoinNormalized(testPrice.Amount + testPrice.Denom)
+	require.NoError(t, err)
+	wasmtesting.FundAccount(t, ctx, customApp, bidder, sdk.NewCoins(bidAmount))
+	msg = bindings.MarketplaceMsg{PlaceBid: &bindings.PlaceBid{
+		AuctionId: 1,
+		Amount:    testPrice,
+	}}
+	err = executeCustom(t, ctx, customApp, bidder, lucky, msg)
+	require.NoError(t, err)
+
+	bid, found := customApp.MarketplaceKeeper.GetBid(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, bidder.String(), bid.Bidder)
+	require.True(t, customApp.BankKeeper.GetBalance(ctx, bidder, bidAmount.Denom).IsZero())
+}
+
+func executeCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, sender sdk.AccAddress, msg bindings.MarketplaceMsg) error {
+	t.Helper()
+
+	return wasmtesting.ExecuteCustom(t, ctx, customApp, contract, sender, bindings.MarketplaceCustomMsg{Marketplace: &msg})
+}
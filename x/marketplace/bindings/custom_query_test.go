@@ -0,0 +1,92 @@
+package bindings_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/marketplace/bindings/types"
+)
+
+func TestQueryMarketplace(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	bidder := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, reflect, testDenomId, testONFTId)
+	wasmtesting.CreateTestONFT(t, ctx, customApp, creator, reflect, testDenomId, "onftmarketplace2")
+
+	err := executeCustom(t, ctx, customApp, reflect, lucky, bindings.MarketplaceMsg{ListNFT: &bindings.ListNFT{
+		Id:      testListingId,
+		DenomId: testDenomId,
+		NftId:   testONFTId,
+		Price:   testPrice,
+		SplitShares: []bindings.WeightedAddress{
+			{Address: lucky.String(), Weight: "1"},
+		},
+	}})
+	require.NoError(t, err)
+
+	listingResp := bindings.ListingResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.MarketplaceQuery{
+		Listing: &bindings.GetListing{Id: testListingId},
+	}, &listingResp)
+	require.Equal(t, testListingId, listingResp.Listing.Id)
+	require.Equal(t, reflect.String(), listingResp.Listing.Owner)
+	require.Equal(t, testPrice, listingResp.Listing.Price)
+	require.Equal(t, []bindings.WeightedAddress{
+		{Address: lucky.String(), Weight: "1.000000000000000000"},
+	}, listingResp.Listing.SplitShares)
+
+	err = executeCustom(t, ctx, customApp, reflect, lucky, bindings.MarketplaceMsg{CreateAuction: &bindings.CreateAuction{
+		DenomId:             testDenomId,
+		NftId:               "onftmarketplace2",
+		StartPrice:          testPrice,
+		IncrementPercentage: "0.1",
+		WhitelistAccounts:   []string{bidder.String()},
+	}})
+	require.NoError(t, err)
+
+	auctionResp := bindings.AuctionResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.MarketplaceQuery{
+		Auction: &bindings.GetAuction{Id: 1},
+	}, &auctionResp)
+	require.Equal(t, uint64(1), auctionResp.Auction.Id)
+	require.Equal(t, "onftmarketplace2", auctionResp.Auction.NftId)
+	require.Equal(t, uint64(ctx.BlockTime().Unix()), auctionResp.Auction.StartTime)
+	require.Nil(t, auctionResp.Auction.EndTime)
+	require.Equal(t, "0.100000000000000000", auctionResp.Auction.IncrementPercentage)
+	require.Equal(t, []string{bidder.String()}, auctionResp.Auction.WhitelistAccounts)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	bidAmount, err := sdk.ParseCoinNormalized(testPrice.Amount + testPrice.Denom)
+	require.NoError(t, err)
+	wasmtesting.FundAccount(t, ctx, customApp, bidder, sdk.NewCoins(bidAmount))
+	err = executeCustom(t, ctx, customApp, bidder, lucky, bindings.MarketplaceMsg{PlaceBid: &bindings.PlaceBid{
+		AuctionId: 1,
+		Amount:    testPrice,
+	}})
+	require.NoError(t, err)
+
+	bidResp := bindings.BidResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.MarketplaceQuery{
+		Bid: &bindings.GetBid{AuctionId: 1},
+	}, &bidResp)
+	require.Equal(t, uint64(1), bidResp.Bid.AuctionId)
+	require.Equal(t, bidder.String(), bidResp.Bid.Bidder)
+	require.Equal(t, testPrice, bidResp.Bid.Amount)
+	require.Equal(t, uint64(ctx.BlockTime().Unix()), bidResp.Bid.Time)
+}
+
+func queryCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, request bindings.MarketplaceQuery, response interface{}) {
+	t.Helper()
+
+	wasmtesting.QueryCustom(t, ctx, customApp, contract, bindings.MarketplaceCustomQuery{Marketplace: &request}, response)
+}
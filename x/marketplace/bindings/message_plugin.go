@@ -0,0 +1,226 @@
+package bindings
+
+import (
+	"encoding/json"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/marketplace/bindings/types"
+	marketplacekeeper "github.com/OmniFlix/omniflixhub/v6/x/marketplace/keeper"
+	marketplacetypes "github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+)
+
+// CustomMessageDecorator returns decorator for marketplace custom CosmWasm bindings messages
+func CustomMessageDecorator(marketplace *marketplacekeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:     old,
+			marketplace: marketplace,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped     wasmkeeper.Messenger
+	marketplace *marketplacekeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom != nil {
+		// only handle messages namespaced under marketplace,
+		// leave everything else for the wrapped version
+		var customMsg bindingstypes.MarketplaceCustomMsg
+		if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+			return nil, nil, [][]*codectypes.Any{}, errorsmod.Wrap(err, "marketplace msg")
+		}
+
+		if contractMsg := customMsg.Marketplace; contractMsg != nil {
+			var (
+				data []byte
+				err  error
+			)
+			switch {
+			case contractMsg.ListNFT != nil:
+				data, err = PerformListNFT(m.marketplace, ctx, contractAddr, contractMsg.ListNFT)
+			case contractMsg.BuyNFT != nil:
+				data, err = PerformBuyNFT(m.marketplace, ctx, contractAddr, contractMsg.BuyNFT)
+			case contractMsg.CreateAuction != nil:
+				data, err = PerformCreateAuction(m.marketplace, ctx, contractAddr, contractMsg.CreateAuction)
+			case contractMsg.PlaceBid != nil:
+				data, err = PerformPlaceBid(m.marketplace, ctx, contractAddr, contractMsg.PlaceBid)
+			default:
+				return nil, nil, [][]*codectypes.Any{}, wasmvmtypes.UnsupportedRequest{Kind: "unknown marketplace msg variant"}
+			}
+			if err != nil {
+				return nil, nil, [][]*codectypes.Any{}, err
+			}
+			return nil, [][]byte{data}, [][]*codectypes.Any{}, nil
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// PerformListNFT lists an oNFT owned by the contract with a fixed price.
+func PerformListNFT(k *marketplacekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, list *bindingstypes.ListNFT) ([]byte, error) {
+	if list == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "list nft null list"}
+	}
+	price, err := parseCoin(list.Price)
+	if err != nil {
+		return nil, err
+	}
+	splitShares, err := parseWeightedAddresses(list.SplitShares)
+	if err != nil {
+		return nil, err
+	}
+
+	msgListNFT := marketplacetypes.NewMsgListNFT(list.DenomId, list.NftId, price, contractAddr, splitShares)
+	msgListNFT.Id = list.Id
+	if err := msgListNFT.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgListNFT")
+	}
+
+	msgServer := marketplacekeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.ListNFT(ctx, msgListNFT)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "listing nft")
+	}
+	return resp.Marshal()
+}
+
+// PerformBuyNFT buys a listed oNFT, the price is paid by the contract.
+func PerformBuyNFT(k *marketplacekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, buy *bindingstypes.BuyNFT) ([]byte, error) {
+	if buy == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "buy nft null buy"}
+	}
+	price, err := parseCoin(buy.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	msgBuyNFT := marketplacetypes.NewMsgBuyNFT(buy.Id, price, contractAddr)
+	if err := msgBuyNFT.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgBuyNFT")
+	}
+
+	msgServer := marketplacekeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.BuyNFT(ctx, msgBuyNFT)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "buying nft")
+	}
+	return resp.Marshal()
+}
+
+// PerformCreateAuction creates an auction of an oNFT owned by the contract.
+func PerformCreateAuction(k *marketplacekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, auction *bindingstypes.CreateAuction) ([]byte, error) {
+	if auction == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "create auction null auction"}
+	}
+	startPrice, err := parseCoin(auction.StartPrice)
+	if err != nil {
+		return nil, err
+	}
+	incrementPercentage, err := sdkmath.LegacyNewDecFromStr(auction.IncrementPercentage)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid increment percentage " + auction.IncrementPercentage}
+	}
+	splitShares, err := parseWeightedAddresses(auction.SplitShares)
+	if err != nil {
+		return nil, err
+	}
+	startTime := ctx.BlockTime()
+	if auction.StartTime != 0 {
+		startTime = time.Unix(int64(auction.StartTime), 0).UTC()
+	}
+	var duration *time.Duration
+	if auction.Duration != 0 {
+		d := time.Duration(auction.Duration) * time.Second
+		duration = &d
+	}
+
+	msgCreateAuction := marketplacetypes.NewMsgCreateAuction(
+		auction.DenomId,
+		auction.NftId,
+		startTime,
+		duration,
+		startPrice,
+		contractAddr,
+		incrementPercentage,
+		auction.WhitelistAccounts,
+		splitShares,
+	)
+	if err := msgCreateAuction.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgCreateAuction")
+	}
+
+	msgServer := marketplacekeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.CreateAuction(ctx, msgCreateAuction)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "creating auction")
+	}
+	return resp.Marshal()
+}
+
+// PerformPlaceBid places a bid on an auction, the bid amount is paid by the contract.
+func PerformPlaceBid(k *marketplacekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, bid *bindingstypes.PlaceBid) ([]byte, error) {
+	if bid == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "place bid null bid"}
+	}
+	amount, err := parseCoin(bid.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msgPlaceBid := marketplacetypes.NewMsgPlaceBid(bid.AuctionId, amount, contractAddr)
+	if err := msgPlaceBid.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgPlaceBid")
+	}
+
+	msgServer := marketplacekeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.PlaceBid(ctx, msgPlaceBid)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "placing bid")
+	}
+	return resp.Marshal()
+}
+
+// parseCoin converts a wasm vm type coin to a sdk type coin
+func parseCoin(coin wasmvmtypes.Coin) (sdk.Coin, error) {
+	amount, ok := sdkmath.NewIntFromString(coin.Amount)
+	if !ok {
+		return sdk.Coin{}, wasmvmtypes.InvalidRequest{Err: "invalid coin amount " + coin.Amount}
+	}
+	return sdk.Coin{Denom: coin.Denom, Amount: amount}, nil
+}
+
+func parseWeightedAddresses(weightedAddrs []bindingstypes.WeightedAddress) ([]marketplacetypes.WeightedAddress, error) {
+	var parsed []marketplacetypes.WeightedAddress
+	for _, weightedAddr := range weightedAddrs {
+		weight, err := sdkmath.LegacyNewDecFromStr(weightedAddr.Weight)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid weight " + weightedAddr.Weight}
+		}
+		parsed = append(parsed, marketplacetypes.WeightedAddress{
+			Address: weightedAddr.Address,
+			Weight:  weight,
+		})
+	}
+	return parsed, nil
+}
@@ -0,0 +1,112 @@
+package bindings
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/marketplace/bindings/types"
+	marketplacekeeper "github.com/OmniFlix/omniflixhub/v6/x/marketplace/keeper"
+	marketplacetypes "github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+)
+
+type QueryPlugin struct {
+	marketplaceKeeper *marketplacekeeper.Keeper
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(k *marketplacekeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		marketplaceKeeper: k,
+	}
+}
+
+// GetListing is a query to get a listing by id.
+func (qp QueryPlugin) GetListing(ctx sdk.Context, id string) (*bindingstypes.ListingResponse, error) {
+	resp, err := qp.marketplaceKeeper.Listing(ctx, &marketplacetypes.QueryListingRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.ListingResponse{Listing: SdkListingToWasm(*resp.Listing)}, nil
+}
+
+// GetAuction is a query to get an auction by id.
+func (qp QueryPlugin) GetAuction(ctx sdk.Context, id uint64) (*bindingstypes.AuctionResponse, error) {
+	resp, err := qp.marketplaceKeeper.Auction(ctx, &marketplacetypes.QueryAuctionRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.AuctionResponse{Auction: SdkAuctionToWasm(*resp.Auction)}, nil
+}
+
+// GetBid is a query to get the current bid of an auction.
+func (qp QueryPlugin) GetBid(ctx sdk.Context, auctionID uint64) (*bindingstypes.BidResponse, error) {
+	resp, err := qp.marketplaceKeeper.Bid(ctx, &marketplacetypes.QueryBidRequest{Id: auctionID})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.BidResponse{Bid: SdkBidToWasm(*resp.Bid)}, nil
+}
+
+func SdkListingToWasm(listing marketplacetypes.Listing) bindingstypes.Listing {
+	return bindingstypes.Listing{
+		Id:          listing.Id,
+		DenomId:     listing.DenomId,
+		NftId:       listing.NftId,
+		Price:       ConvertSdkCoinToWasmCoin(listing.Price),
+		Owner:       listing.Owner,
+		SplitShares: SdkWeightedAddressesToWasm(listing.SplitShares),
+	}
+}
+
+func SdkAuctionToWasm(auction marketplacetypes.AuctionListing) bindingstypes.Auction {
+	var endTime *uint64
+	if auction.EndTime != nil {
+		end := uint64(auction.EndTime.Unix())
+		endTime = &end
+	}
+	whitelistAccounts := auction.WhitelistAccounts
+	if whitelistAccounts == nil {
+		whitelistAccounts = []string{}
+	}
+	return bindingstypes.Auction{
+		Id:                  auction.Id,
+		DenomId:             auction.DenomId,
+		NftId:               auction.NftId,
+		StartPrice:          ConvertSdkCoinToWasmCoin(auction.StartPrice),
+		StartTime:           uint64(auction.StartTime.Unix()),
+		EndTime:             endTime,
+		Owner:               auction.Owner,
+		IncrementPercentage: auction.IncrementPercentage.String(),
+		WhitelistAccounts:   whitelistAccounts,
+		SplitShares:         SdkWeightedAddressesToWasm(auction.SplitShares),
+	}
+}
+
+func SdkBidToWasm(bid marketplacetypes.Bid) bindingstypes.Bid {
+	return bindingstypes.Bid{
+		AuctionId: bid.AuctionId,
+		Bidder:    bid.Bidder,
+		Amount:    ConvertSdkCoinToWasmCoin(bid.Amount),
+		Time:      uint64(bid.Time.Unix()),
+	}
+}
+
+func SdkWeightedAddressesToWasm(weightedAddrs []marketplacetypes.WeightedAddress) []bindingstypes.WeightedAddress {
+	converted := []bindingstypes.WeightedAddress{}
+	for _, weightedAddr := range weightedAddrs {
+		converted = append(converted, bindingstypes.WeightedAddress{
+			Address: weightedAddr.Address,
+			Weight:  weightedAddr.Weight.String(),
+		})
+	}
+	return converted
+}
+
+// ConvertSdkCoinToWasmCoin converts a sdk type coin to a wasm vm type coin
+func ConvertSdkCoinToWasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
+	return wasmvmtypes.Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.String(),
+	}
+}
@@ -0,0 +1,63 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/marketplace/bindings/types"
+)
+
+// CustomQueryDecorator returns decorator for marketplace custom CosmWasm bindings queries.
+// Custom queries that are not namespaced under marketplace are left for the wrapped handler.
+func CustomQueryDecorator(qp *QueryPlugin) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(
+			func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+				if request.Custom != nil {
+					var customQuery bindingstypes.MarketplaceCustomQuery
+					if err := json.Unmarshal(request.Custom, &customQuery); err != nil {
+						return nil, errorsmod.Wrap(err, "marketplace query")
+					}
+					if customQuery.Marketplace != nil {
+						return CustomQuerier(qp)(ctx, *customQuery.Marketplace)
+					}
+				}
+				return old.HandleQuery(ctx, caller, request)
+			},
+		)
+	}
+}
+
+// CustomQuerier dispatches marketplace custom CosmWasm bindings queries.
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, contractQuery bindingstypes.MarketplaceQuery) ([]byte, error) {
+	return func(ctx sdk.Context, contractQuery bindingstypes.MarketplaceQuery) ([]byte, error) {
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case contractQuery.Listing != nil:
+			res, err = qp.GetListing(ctx, contractQuery.Listing.Id)
+		case contractQuery.Auction != nil:
+			res, err = qp.GetAuction(ctx, contractQuery.Auction.Id)
+		case contractQuery.Bid != nil:
+			res, err = qp.GetBid(ctx, contractQuery.Bid.AuctionId)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown marketplace query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal marketplace query response: %w", err)
+		}
+		return bz, nil
+	}
+}
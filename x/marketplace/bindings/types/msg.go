@@ -0,0 +1,55 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+// MarketplaceCustomMsg is the custom message handled by the marketplace bindings.
+// Messages are namespaced under the module name, ex: {"marketplace": {"list_nft": {...}}}
+type MarketplaceCustomMsg struct {
+	Marketplace *MarketplaceMsg `json:"marketplace,omitempty"`
+}
+
+type MarketplaceMsg struct {
+	/// Contracts can list the oNFTs they own with a fixed price.
+	ListNFT *ListNFT `json:"list_nft,omitempty"`
+	/// Contracts can buy listed oNFTs, the price is paid by the contract.
+	BuyNFT *BuyNFT `json:"buy_nft,omitempty"`
+	/// Contracts can auction the oNFTs they own.
+	CreateAuction *CreateAuction `json:"create_auction,omitempty"`
+	/// Contracts can bid on auctions, the bid amount is paid by the contract.
+	PlaceBid *PlaceBid `json:"place_bid,omitempty"`
+}
+
+// ListNFT lists an oNFT owned by the contract.
+// Unlike the cli, listing ids are not generated and must be set by the contract.
+type ListNFT struct {
+	Id          string            `json:"id"`
+	DenomId     string            `json:"denom_id"`
+	NftId       string            `json:"nft_id"`
+	Price       wasmvmtypes.Coin  `json:"price"`
+	SplitShares []WeightedAddress `json:"split_shares,omitempty"`
+}
+
+type BuyNFT struct {
+	Id    string           `json:"id"`
+	Price wasmvmtypes.Coin `json:"price"`
+}
+
+// CreateAuction creates an auction of an oNFT owned by the contract.
+type CreateAuction struct {
+	DenomId    string           `json:"denom_id"`
+	NftId      string           `json:"nft_id"`
+	StartPrice wasmvmtypes.Coin `json:"start_price"`
+	// StartTime in unix seconds, defaults to the current block time
+	StartTime uint64 `json:"start_time,omitempty"`
+	// Duration in seconds, auctions without duration never end
+	Duration uint64 `json:"duration,omitempty"`
+	// IncrementPercentage is a decimal string between 0 and 1
+	IncrementPercentage string            `json:"increment_percentage"`
+	WhitelistAccounts   []string          `json:"whitelist_accounts,omitempty"`
+	SplitShares         []WeightedAddress `json:"split_shares,omitempty"`
+}
+
+type PlaceBid struct {
+	AuctionId uint64           `json:"auction_id"`
+	Amount    wasmvmtypes.Coin `json:"amount"`
+}
@@ -0,0 +1,41 @@
+package types
+
+// MarketplaceCustomQuery is the custom query handled by the marketplace bindings.
+// Queries are namespaced under the module name, ex: {"marketplace": {"listing": {...}}}
+type MarketplaceCustomQuery struct {
+	Marketplace *MarketplaceQuery `json:"marketplace,omitempty"`
+}
+
+type MarketplaceQuery struct {
+	Listing *GetListing `json:"listing,omitempty"`
+	Auction *GetAuction `json:"auction,omitempty"`
+	Bid     *GetBid     `json:"bid,omitempty"`
+}
+
+// query types
+
+type GetListing struct {
+	Id string `json:"id"`
+}
+
+type GetAuction struct {
+	Id uint64 `json:"id"`
+}
+
+type GetBid struct {
+	AuctionId uint64 `json:"auction_id"`
+}
+
+// responses
+
+type ListingResponse struct {
+	Listing Listing `json:"listing"`
+}
+
+type AuctionResponse struct {
+	Auction Auction `json:"auction"`
+}
+
+type BidResponse struct {
+	Bid Bid `json:"bid"`
+}
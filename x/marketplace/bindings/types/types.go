@@ -0,0 +1,41 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+type WeightedAddress struct {
+	Address string `json:"address"`
+	// Weight is a decimal string between 0 and 1
+	Weight string `json:"weight"`
+}
+
+type Listing struct {
+	Id          string            `json:"id"`
+	DenomId     string            `json:"denom_id"`
+	NftId       string            `json:"nft_id"`
+	Price       wasmvmtypes.Coin  `json:"price"`
+	Owner       string            `json:"owner"`
+	SplitShares []WeightedAddress `json:"split_shares"`
+}
+
+type Auction struct {
+	Id         uint64           `json:"id"`
+	DenomId    string           `json:"denom_id"`
+	NftId      string           `json:"nft_id"`
+	StartPrice wasmvmtypes.Coin `json:"start_price"`
+	// StartTime in unix seconds
+	StartTime uint64 `json:"start_time"`
+	// EndTime in unix seconds, not set for auctions without duration
+	EndTime             *uint64           `json:"end_time,omitempty"`
+	Owner               string            `json:"owner"`
+	IncrementPercentage string            `json:"increment_percentage"`
+	WhitelistAccounts   []string          `json:"whitelist_accounts"`
+	SplitShares         []WeightedAddress `json:"split_shares"`
+}
+
+type Bid struct {
+	AuctionId uint64           `json:"auction_id"`
+	Bidder    string           `json:"bidder"`
+	Amount    wasmvmtypes.Coin `json:"amount"`
+	// Time in unix seconds
+	Time uint64 `json:"time"`
+}
@@ -0,0 +1,28 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	marketplacekeeper "github.com/OmniFlix/omniflixhub/v6/x/marketplace/keeper"
+)
+
+// Capability is the wasm capability of contracts using the marketplace bindings
+const Capability = "marketplace"
+
+func RegisterCustomPlugins(
+	marketplace *marketplacekeeper.Keeper,
+) []wasmkeeper.Option {
+	wasmQueryPlugin := NewQueryPlugin(marketplace)
+
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		CustomQueryDecorator(wasmQueryPlugin),
+	)
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(marketplace),
+	)
+
+	return []wasmkeeper.Option{
+		queryDecoratorOpt,
+		messengerDecoratorOpt,
+	}
+}
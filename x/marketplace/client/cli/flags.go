@@ -18,6 +18,21 @@ const (
 	FlagIncrementPercentage = "increment-percentage"
 	FlagDuration            = "duration"
 	FlagAmount              = "amount"
+	FlagQuantity            = "quantity"
+	FlagFloorPrice          = "floor-price"
+	FlagDecayType           = "decay-type"
+	FlagStepInterval        = "step-interval"
+	FlagMaxPrice            = "max-price"
+	FlagMinPrice            = "min-price"
+	FlagRevealDuration      = "reveal-duration"
+	FlagSettlementType      = "settlement-type"
+	FlagForfeitPercentage   = "forfeit-percentage"
+	FlagDeposit             = "deposit"
+	FlagSalt                = "salt"
+	FlagSoftCloseWindow     = "soft-close-window"
+	FlagSoftCloseExtension  = "soft-close-extension"
+	FlagSoftCloseMaxExt     = "soft-close-max-extension"
+	FlagReservePrice        = "reserve-price"
 )
 
 var (
@@ -27,6 +42,21 @@ var (
 
 	FsCreateAuction = flag.NewFlagSet("", flag.ContinueOnError)
 	FsPlaceBid      = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsCreateDutchAuction = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBuyDutchAuction    = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsCreateSealedBidAuction = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCommitSealedBid        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRevealSealedBid        = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsRevealReservePrice = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsMakeOffer   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAcceptOffer = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsMakeCollectionOffer   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSellToCollectionOffer = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -47,6 +77,60 @@ func init() {
 	FsCreateAuction.String(FlagIncrementPercentage, "0.01", "bid increment percentage")
 	FsCreateAuction.String(FlagWhiteListAccounts, "", "whitelist accounts for private auction")
 	FsCreateAuction.String(FlagSplitShares, "", "split shares for listing")
+	FsCreateAuction.String(FlagSoftCloseWindow, "", "bids placed within this window before the end extend the auction")
+	FsCreateAuction.String(FlagSoftCloseExtension, "", "time added to the end of the auction for each soft close bid")
+	FsCreateAuction.String(FlagSoftCloseMaxExt, "", "max total extension of the auction, defaults to the extension")
+	FsCreateAuction.String(FlagReservePrice, "", "hidden reserve price, only its hash is sent")
+	FsCreateAuction.String(FlagSalt, "", "secret salt of the reserve price, required to reveal it")
 
 	FsPlaceBid.String(FlagAmount, "", "auction bid amount")
+
+	FsCreateDutchAuction.String(FlagDenomId, "", "nft denom id")
+	FsCreateDutchAuction.String(FlagNftId, "", "nft id")
+	FsCreateDutchAuction.String(FlagStartPrice, "", "auction start price of nft")
+	FsCreateDutchAuction.String(FlagFloorPrice, "", "auction floor price of nft")
+	FsCreateDutchAuction.String(FlagStartTime, "", "auction start time")
+	FsCreateDutchAuction.String(FlagDuration, "", "auction duration, price reaches the floor price at the end")
+	FsCreateDutchAuction.String(FlagDecayType, "linear", "price decay type (linear|stepped)")
+	FsCreateDutchAuction.String(FlagStepInterval, "", "interval between price drops of stepped decay")
+	FsCreateDutchAuction.String(FlagWhiteListAccounts, "", "whitelist accounts for private auction")
+	FsCreateDutchAuction.String(FlagSplitShares, "", "split shares for listing")
+
+	FsBuyDutchAuction.String(FlagMaxPrice, "", "max price to pay, auction settles at the current price")
+
+	FsCreateSealedBidAuction.String(FlagDenomId, "", "nft denom id")
+	FsCreateSealedBidAuction.String(FlagNftId, "", "nft id")
+	FsCreateSealedBidAuction.String(FlagMinPrice, "", "lowest bid amount that can be revealed")
+	FsCreateSealedBidAuction.String(FlagStartTime, "", "auction start time")
+	FsCreateSealedBidAuction.String(FlagDuration, "", "bid phase duration")
+	FsCreateSealedBidAuction.String(FlagRevealDuration, "", "reveal phase duration")
+	FsCreateSealedBidAuction.String(FlagSettlementType, "first-price", "settlement type (first-price|second-price)")
+	FsCreateSealedBidAuction.String(FlagForfeitPercentage, "0.1", "percentage of the deposit forfeited by unrevealed bids")
+	FsCreateSealedBidAuction.String(FlagWhiteListAccounts, "", "whitelist accounts for private auction")
+	FsCreateSealedBidAuction.String(FlagSplitShares, "", "split shares for listing")
+
+	FsCommitSealedBid.String(FlagAmount, "", "bid amount, only its hash is sent")
+	FsCommitSealedBid.String(FlagSalt, "", "secret salt of the bid, required to reveal it")
+	FsCommitSealedBid.String(FlagDeposit, "", "escrowed deposit, defaults to the bid amount")
+
+	FsRevealSealedBid.String(FlagAmount, "", "committed bid amount")
+	FsRevealSealedBid.String(FlagSalt, "", "committed salt")
+
+	FsRevealReservePrice.String(FlagReservePrice, "", "committed reserve price")
+	FsRevealReservePrice.String(FlagSalt, "", "committed salt")
+
+	FsMakeOffer.String(FlagDenomId, "", "nft denom id")
+	FsMakeOffer.String(FlagNftId, "", "nft id")
+	FsMakeOffer.String(FlagAmount, "", "offer amount")
+	FsMakeOffer.String(FlagDuration, "168h", "offer duration")
+
+	FsAcceptOffer.String(FlagSplitShares, "", "split shares for sale")
+
+	FsMakeCollectionOffer.String(FlagDenomId, "", "nft denom id")
+	FsMakeCollectionOffer.String(FlagPrice, "", "offer price per nft")
+	FsMakeCollectionOffer.Uint64(FlagQuantity, 1, "number of nfts to buy")
+	FsMakeCollectionOffer.String(FlagDuration, "168h", "offer duration")
+
+	FsSellToCollectionOffer.String(FlagNftId, "", "nft id")
+	FsSellToCollectionOffer.String(FlagSplitShares, "", "split shares for sale")
 }
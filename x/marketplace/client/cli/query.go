@@ -30,10 +30,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAllListings(),
 		GetCmdQueryListingsByOwner(),
 		GetCmdQueryAuction(),
+		GetCmdQueryDutchAuctionPrice(),
 		GetCmdQueryAllAuctions(),
 		GetCmdQueryAuctionsByOwner(),
 		GetCmdQueryAuctionBid(),
+		GetCmdQuerySealedBids(),
 		GetCmdQueryAllBids(),
+		GetCmdQueryOffer(),
+		GetCmdQueryOffersByNft(),
+		GetCmdQueryOffersByBidder(),
+		GetCmdQueryOffersByOwner(),
+		GetCmdQueryCollectionOffer(),
+		GetCmdQueryBestCollectionOffers(),
 	)
 
 	return cmd
@@ -229,6 +237,42 @@ func GetCmdQueryAuction() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDutchAuctionPrice implements the query dutch auction price command.
+func GetCmdQueryDutchAuctionPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dutch-auction-price [id]",
+		Long:    "Query the current price of a dutch auction.",
+		Example: fmt.Sprintf("$ %s query marketplace dutch-auction-price <id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DutchAuctionPrice(context.Background(), &types.QueryDutchAuctionPriceRequest{
+				Id: auctionId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryAllAuctions implements the query all auctions command.
 func GetCmdQueryAllAuctions() *cobra.Command {
 	cmd := &cobra.Command{
@@ -402,3 +446,290 @@ func GetCmdQueryAllBids() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryOffer implements the query offer command.
+func GetCmdQueryOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offer [id]",
+		Long:    "Query an offer by its id.",
+		Example: fmt.Sprintf("$ %s query marketplace offer <id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Offer(context.Background(), &types.QueryOfferRequest{
+				Id: offerId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Offer)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOffersByNft implements the query offers by nft command.
+func GetCmdQueryOffersByNft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offers-by-nft [denom-id] [nft-id]",
+		Long:    "Query offers made for an nft.",
+		Example: fmt.Sprintf("$ %s query marketplace offers-by-nft <denom-id> <nft-id>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			resp, err := queryClient.OffersByNft(
+				context.Background(),
+				&types.QueryOffersByNftRequest{
+					DenomId:    args[0],
+					NftId:      args[1],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nft offers")
+
+	return cmd
+}
+
+// GetCmdQueryOffersByBidder implements the query offers by bidder command.
+func GetCmdQueryOffersByBidder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offers-by-bidder [bidder]",
+		Long:    "Query offers made by the bidder.",
+		Example: fmt.Sprintf("$ %s query marketplace offers-by-bidder <bidder>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bidder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			resp, err := queryClient.OffersByBidder(
+				context.Background(),
+				&types.QueryOffersByBidderRequest{
+					Bidder:     bidder.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bidder offers")
+
+	return cmd
+}
+
+// GetCmdQueryOffersByOwner implements the query offers by owner command.
+func GetCmdQueryOffersByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offers-by-owner [owner]",
+		Long:    "Query offers on the nfts currently owned by the owner.",
+		Example: fmt.Sprintf("$ %s query marketplace offers-by-owner <owner>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			resp, err := queryClient.OffersByOwner(
+				context.Background(),
+				&types.QueryOffersByOwnerRequest{
+					Owner:      owner.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner offers")
+
+	return cmd
+}
+
+// GetCmdQueryCollectionOffer implements the query collection offer command.
+func GetCmdQueryCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "collection-offer [id]",
+		Long:    "Query a collection offer by its id.",
+		Example: fmt.Sprintf("$ %s query marketplace collection-offer <id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CollectionOffer(context.Background(), &types.QueryCollectionOfferRequest{
+				Id: offerId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.CollectionOffer)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBestCollectionOffers implements the query best collection offers command.
+func GetCmdQueryBestCollectionOffers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "best-collection-offers [denom-id]",
+		Long:    "Query collection offers of a denom ordered by price from highest to lowest within each price denom.",
+		Example: fmt.Sprintf("$ %s query marketplace best-collection-offers <denom-id> --price-denom=uflix", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			priceDenom, err := cmd.Flags().GetString(FlagPriceDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			resp, err := queryClient.BestCollectionOffers(
+				context.Background(),
+				&types.QueryBestCollectionOffersRequest{
+					DenomId:    args[0],
+					PriceDenom: priceDenom,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagPriceDenom, "", "filter by price denom")
+	flags.AddPaginationFlagsToCmd(cmd, "collection offers")
+
+	return cmd
+}
+
+// GetCmdQuerySealedBids implements the query sealed bids command.
+func GetCmdQuerySealedBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sealed-bids [auction-id]",
+		Long:    "Query sealed bids of an auction, amounts are shown once revealed.",
+		Example: fmt.Sprintf("$ %s query marketplace sealed-bids <auction-id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SealedBids(context.Background(), &types.QuerySealedBidsRequest{
+				AuctionId:  auctionId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sealed bids")
+
+	return cmd
+}
@@ -35,6 +35,19 @@ func GetTxCmd() *cobra.Command {
 		GetCmdCreateAuction(),
 		GetCmdCancelAuction(),
 		GetCmdPlaceBid(),
+		GetCmdMakeOffer(),
+		GetCmdCancelOffer(),
+		GetCmdAcceptOffer(),
+		GetCmdRejectOffer(),
+		GetCmdMakeCollectionOffer(),
+		GetCmdCancelCollectionOffer(),
+		GetCmdSellToCollectionOffer(),
+		GetCmdCreateDutchAuction(),
+		GetCmdBuyDutchAuction(),
+		GetCmdCreateSealedBidAuction(),
+		GetCmdCommitSealedBid(),
+		GetCmdRevealSealedBid(),
+		GetCmdRevealReservePrice(),
 	)
 
 	return marketplaceTxCmd
@@ -282,7 +295,13 @@ func GetCmdCreateAuction() *cobra.Command {
 				"--denom-id=<nft-id> "+
 				"--start-price=\"1000000uflix\" "+
 				"--start-time=\"2022-06-13T13:02:49.389Z\" "+
-				"--increment-percentage=\"0.01\""+
+				"--increment-percentage=\"0.01\" "+
+				"--duration=\"24h\" "+
+				"--soft-close-window=\"10m\" "+
+				"--soft-close-extension=\"5m\" "+
+				"--soft-close-max-extension=\"1h\" "+
+				"--reserve-price=\"5000000uflix\" "+
+				"--salt=<secret> "+
 				"--from=<key-name> "+
 				"--chain-id=<chain-id> "+
 				"--fees=<fee>",
@@ -371,6 +390,29 @@ func GetCmdCreateAuction() *cobra.Command {
 			}
 			msg := types.NewMsgCreateAuction(denomId, nftId, startTime, duration, startPrice, owner, increment, whitelist, splitShares)
 
+			msg.SoftClose, err = parseSoftClose(cmd)
+			if err != nil {
+				return err
+			}
+			reservePriceStr, err := cmd.Flags().GetString(FlagReservePrice)
+			if err != nil {
+				return err
+			}
+			if len(reservePriceStr) > 0 {
+				reservePrice, err := sdk.ParseCoinNormalized(reservePriceStr)
+				if err != nil {
+					return fmt.Errorf("failed to parse reserve price: %s", reservePriceStr)
+				}
+				salt, err := cmd.Flags().GetString(FlagSalt)
+				if err != nil {
+					return err
+				}
+				if len(salt) == 0 {
+					return fmt.Errorf("salt is required to hide the reserve price")
+				}
+				msg.ReservePriceCommitment = types.ReservePriceCommitment(reservePrice, salt)
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -479,3 +521,925 @@ func GetCmdPlaceBid() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdMakeOffer implements the make-offer command
+func GetCmdMakeOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-offer",
+		Short: "Make an offer for an nft that is not listed on marketplace",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace make-offer "+
+				"--denom-id=<denom-id> "+
+				"--nft-id=<nft-id> "+
+				"--amount=\"1000000uflix\" "+
+				"--duration=\"72h\" "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+
+			denomId, err := cmd.Flags().GetString(FlagDenomId)
+			if err != nil {
+				return err
+			}
+			nftId, err := cmd.Flags().GetString(FlagNftId)
+			if err != nil {
+				return err
+			}
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(amountStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %s", amountStr)
+			}
+			durationStr, err := cmd.Flags().GetString(FlagDuration)
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMakeOffer(denomId, nftId, amount, duration, bidder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsMakeOffer)
+	_ = cmd.MarkFlagRequired(FlagDenomId)
+	_ = cmd.MarkFlagRequired(FlagNftId)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelOffer implements the cancel-offer command
+func GetCmdCancelOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "cancel-offer",
+		Long: "cancel an offer and refund the escrowed amount",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace cancel-offer [offer-id] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOffer(offerId, bidder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAcceptOffer implements the accept-offer command
+func GetCmdAcceptOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "accept-offer",
+		Long: "accept an offer and sell the nft to the bidder",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace accept-offer [offer-id] "+
+				"--split-shares=\"[address]:[weight],[address]:[weight]\" "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			splitSharesStr, err := cmd.Flags().GetString(FlagSplitShares)
+			if err != nil {
+				return err
+			}
+			var splitShares []types.WeightedAddress
+			if len(splitSharesStr) > 0 {
+				splitShares, err = parseSplitShares(splitSharesStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgAcceptOffer(offerId, owner, splitShares)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsAcceptOffer)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRejectOffer implements the reject-offer command
+func GetCmdRejectOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "reject-offer",
+		Long: "reject an offer made for an owned nft and refund the bidder",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace reject-offer [offer-id] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRejectOffer(offerId, owner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMakeCollectionOffer implements the make-collection-offer command
+func GetCmdMakeCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-collection-offer",
+		Short: "Make an offer for a quantity of nfts from a denom",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace make-collection-offer "+
+				"--denom-id=<denom-id> "+
+				"--price=\"1000000uflix\" "+
+				"--quantity=5 "+
+				"--duration=\"72h\" "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+
+			denomId, err := cmd.Flags().GetString(FlagDenomId)
+			if err != nil {
+				return err
+			}
+			priceStr, err := cmd.Flags().GetString(FlagPrice)
+			if err != nil {
+				return err
+			}
+			price, err := sdk.ParseCoinNormalized(priceStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse price: %s", priceStr)
+			}
+			quantity, err := cmd.Flags().GetUint64(FlagQuantity)
+			if err != nil {
+				return err
+			}
+			durationStr, err := cmd.Flags().GetString(FlagDuration)
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMakeCollectionOffer(denomId, price, quantity, duration, bidder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsMakeCollectionOffer)
+	_ = cmd.MarkFlagRequired(FlagDenomId)
+	_ = cmd.MarkFlagRequired(FlagPrice)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelCollectionOffer implements the cancel-collection-offer command
+func GetCmdCancelCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "cancel-collection-offer",
+		Long: "cancel a collection offer and refund the escrowed amount of the unfilled quantity",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace cancel-collection-offer [offer-id] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelCollectionOffer(offerId, bidder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSellToCollectionOffer implements the sell-to-collection-offer command
+func GetCmdSellToCollectionOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "sell-to-collection-offer",
+		Long: "sell an owned nft into a collection offer of its denom",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace sell-to-collection-offer [offer-id] "+
+				"--nft-id=<nft-id> "+
+				"--split-shares=\"[address]:[weight],[address]:[weight]\" "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seller := clientCtx.GetFromAddress()
+
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			nftId, err := cmd.Flags().GetString(FlagNftId)
+			if err != nil {
+				return err
+			}
+			splitSharesStr, err := cmd.Flags().GetString(FlagSplitShares)
+			if err != nil {
+				return err
+			}
+			var splitShares []types.WeightedAddress
+			if len(splitSharesStr) > 0 {
+				splitShares, err = parseSplitShares(splitSharesStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSellToCollectionOffer(offerId, nftId, seller, splitShares)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSellToCollectionOffer)
+	_ = cmd.MarkFlagRequired(FlagNftId)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCreateDutchAuction implements the create-dutch-auction command
+func GetCmdCreateDutchAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "create-dutch-auction",
+		Long: "creates a dutch auction on marketplace, price declines from start price to floor price",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace create-dutch-auction "+
+				"--nft-id=<nft-id> "+
+				"--denom-id=<denom-id> "+
+				"--start-price=\"1000000uflix\" "+
+				"--floor-price=\"100000uflix\" "+
+				"--start-time=\"2022-06-13T13:02:49.389Z\" "+
+				"--duration=\"24h\" "+
+				"--decay-type=\"stepped\" "+
+				"--step-interval=\"1h\" "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+			denomId, err := cmd.Flags().GetString(FlagDenomId)
+			if err != nil {
+				return err
+			}
+			nftId, err := cmd.Flags().GetString(FlagNftId)
+			if err != nil {
+				return err
+			}
+			startPriceStr, err := cmd.Flags().GetString(FlagStartPrice)
+			if err != nil {
+				return err
+			}
+			startPrice, err := sdk.ParseCoinNormalized(startPriceStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse start price: %s", startPriceStr)
+			}
+			floorPriceStr, err := cmd.Flags().GetString(FlagFloorPrice)
+			if err != nil {
+				return err
+			}
+			floorPrice, err := sdk.ParseCoinNormalized(floorPriceStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse floor price: %s", floorPriceStr)
+			}
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			startTime, err := time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse start time: %s", startTimeStr)
+			}
+			durationStr, err := cmd.Flags().GetString(FlagDuration)
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+			decayTypeStr, err := cmd.Flags().GetString(FlagDecayType)
+			if err != nil {
+				return err
+			}
+			decayType, err := parseDecayType(decayTypeStr)
+			if err != nil {
+				return err
+			}
+			stepIntervalStr, err := cmd.Flags().GetString(FlagStepInterval)
+			if err != nil {
+				return err
+			}
+			var stepInterval time.Duration
+			if len(stepIntervalStr) > 0 {
+				stepInterval, err = time.ParseDuration(stepIntervalStr)
+				if err != nil {
+					return err
+				}
+			}
+			splitSharesStr, err := cmd.Flags().GetString(FlagSplitShares)
+			if err != nil {
+				return err
+			}
+			var splitShares []types.WeightedAddress
+			if len(splitSharesStr) > 0 {
+				splitShares, err = parseSplitShares(splitSharesStr)
+				if err != nil {
+					return err
+				}
+			}
+			whitelistAccountsStr, err := cmd.Flags().GetString(FlagWhiteListAccounts)
+			if err != nil {
+				return err
+			}
+			var whitelist []string
+			if len(whitelistAccountsStr) > 0 {
+				whitelist, err = parseWhitelistAccounts(whitelistAccountsStr)
+				if err != nil {
+					return err
+				}
+			}
+			msg := types.NewMsgCreateDutchAuction(denomId, nftId, startTime, duration, startPrice, floorPrice,
+				decayType, stepInterval, owner, whitelist, splitShares)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsCreateDutchAuction)
+	_ = cmd.MarkFlagRequired(FlagDenomId)
+	_ = cmd.MarkFlagRequired(FlagNftId)
+	_ = cmd.MarkFlagRequired(FlagStartPrice)
+	_ = cmd.MarkFlagRequired(FlagFloorPrice)
+	_ = cmd.MarkFlagRequired(FlagStartTime)
+	_ = cmd.MarkFlagRequired(FlagDuration)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBuyDutchAuction implements the buy-dutch-auction command
+func GetCmdBuyDutchAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-dutch-auction",
+		Short: "Buy an nft from a dutch auction at its current price",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace buy-dutch-auction [auction-id] "+
+				"--max-price=<amount> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			buyer := clientCtx.GetFromAddress()
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxPriceStr, err := cmd.Flags().GetString(FlagMaxPrice)
+			if err != nil {
+				return err
+			}
+			maxPrice, err := sdk.ParseCoinNormalized(maxPriceStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse max price: %s", maxPriceStr)
+			}
+
+			msg := types.NewMsgBuyDutchAuction(auctionId, maxPrice, buyer)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsBuyDutchAuction)
+	_ = cmd.MarkFlagRequired(FlagMaxPrice)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCreateSealedBidAuction implements the create-sealed-bid-auction command
+func GetCmdCreateSealedBidAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "create-sealed-bid-auction",
+		Long: "creates a sealed bid auction on marketplace, bids are committed as hashes and revealed after the bid phase",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace create-sealed-bid-auction "+
+				"--nft-id=<nft-id> "+
+				"--denom-id=<denom-id> "+
+				"--min-price=\"1000000uflix\" "+
+				"--start-time=\"2022-06-13T13:02:49.389Z\" "+
+				"--duration=\"24h\" "+
+				"--reveal-duration=\"12h\" "+
+				"--settlement-type=\"second-price\" "+
+				"--forfeit-percentage=\"0.1\" "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+			denomId, err := cmd.Flags().GetString(FlagDenomId)
+			if err != nil {
+				return err
+			}
+			nftId, err := cmd.Flags().GetString(FlagNftId)
+			if err != nil {
+				return err
+			}
+			minPriceStr, err := cmd.Flags().GetString(FlagMinPrice)
+			if err != nil {
+				return err
+			}
+			minPrice, err := sdk.ParseCoinNormalized(minPriceStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse min price: %s", minPriceStr)
+			}
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			startTime, err := time.Parse(time.RFC3339, startTimeStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse start time: %s", startTimeStr)
+			}
+			durationStr, err := cmd.Flags().GetString(FlagDuration)
+			if err != nil {
+				return err
+			}
+			bidDuration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+			revealDurationStr, err := cmd.Flags().GetString(FlagRevealDuration)
+			if err != nil {
+				return err
+			}
+			revealDuration, err := time.ParseDuration(revealDurationStr)
+			if err != nil {
+				return err
+			}
+			settlementTypeStr, err := cmd.Flags().GetString(FlagSettlementType)
+			if err != nil {
+				return err
+			}
+			settlementType, err := parseSettlementType(settlementTypeStr)
+			if err != nil {
+				return err
+			}
+			forfeitStr, err := cmd.Flags().GetString(FlagForfeitPercentage)
+			if err != nil {
+				return err
+			}
+			forfeit, err := sdkmath.LegacyNewDecFromStr(forfeitStr)
+			if err != nil {
+				return err
+			}
+			splitSharesStr, err := cmd.Flags().GetString(FlagSplitShares)
+			if err != nil {
+				return err
+			}
+			var splitShares []types.WeightedAddress
+			if len(splitSharesStr) > 0 {
+				splitShares, err = parseSplitShares(splitSharesStr)
+				if err != nil {
+					return err
+				}
+			}
+			whitelistAccountsStr, err := cmd.Flags().GetString(FlagWhiteListAccounts)
+			if err != nil {
+				return err
+			}
+			var whitelist []string
+			if len(whitelistAccountsStr) > 0 {
+				whitelist, err = parseWhitelistAccounts(whitelistAccountsStr)
+				if err != nil {
+					return err
+				}
+			}
+			msg := types.NewMsgCreateSealedBidAuction(denomId, nftId, startTime, minPrice, bidDuration, revealDuration,
+				settlementType, forfeit, owner, whitelist, splitShares)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsCreateSealedBidAuction)
+	_ = cmd.MarkFlagRequired(FlagDenomId)
+	_ = cmd.MarkFlagRequired(FlagNftId)
+	_ = cmd.MarkFlagRequired(FlagMinPrice)
+	_ = cmd.MarkFlagRequired(FlagStartTime)
+	_ = cmd.MarkFlagRequired(FlagDuration)
+	_ = cmd.MarkFlagRequired(FlagRevealDuration)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCommitSealedBid implements the commit-sealed-bid command
+func GetCmdCommitSealedBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-sealed-bid",
+		Short: "Commit a hashed bid with an escrowed deposit on a sealed bid auction",
+		Long: "Commit a hashed bid with an escrowed deposit on a sealed bid auction.\n" +
+			"Only the hash of the amount and salt is sent, the deposit is public and is an upper bound on the amount. " +
+			"Deposit more than the amount to hide it, the rest of the deposit is refunded at settlement.",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace commit-sealed-bid [auction-id] "+
+				"--amount=<amount> "+
+				"--salt=<secret> "+
+				"--deposit=<deposit> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(amountStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %s", amountStr)
+			}
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit := amount
+			if len(depositStr) > 0 {
+				deposit, err = sdk.ParseCoinNormalized(depositStr)
+				if err != nil {
+					return fmt.Errorf("failed to parse deposit: %s", depositStr)
+				}
+			}
+
+			commitment := types.SealedBidCommitment(auctionId, bidder, amount, salt)
+			msg := types.NewMsgCommitSealedBid(auctionId, commitment, deposit, bidder)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsCommitSealedBid)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	_ = cmd.MarkFlagRequired(FlagSalt)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevealSealedBid implements the reveal-sealed-bid command
+func GetCmdRevealSealedBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-sealed-bid",
+		Short: "Reveal the amount of a committed sealed bid",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace reveal-sealed-bid [auction-id] "+
+				"--amount=<amount> "+
+				"--salt=<secret> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(amountStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %s", amountStr)
+			}
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealSealedBid(auctionId, amount, salt, bidder)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsRevealSealedBid)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	_ = cmd.MarkFlagRequired(FlagSalt)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseSettlementType(settlementType string) (types.SettlementType, error) {
+	switch strings.ToLower(settlementType) {
+	case "first-price":
+		return types.SETTLEMENT_TYPE_FIRST_PRICE, nil
+	case "second-price", "vickrey":
+		return types.SETTLEMENT_TYPE_SECOND_PRICE, nil
+	default:
+		return types.SETTLEMENT_TYPE_FIRST_PRICE,
+			fmt.Errorf("invalid settlement type %s, expected first-price or second-price", settlementType)
+	}
+}
+
+func parseDecayType(decayType string) (types.DecayType, error) {
+	switch strings.ToLower(decayType) {
+	case "linear":
+		return types.DECAY_TYPE_LINEAR, nil
+	case "stepped":
+		return types.DECAY_TYPE_STEPPED, nil
+	default:
+		return types.DECAY_TYPE_LINEAR, fmt.Errorf("invalid decay type %s, expected linear or stepped", decayType)
+	}
+}
+
+// parseSoftClose reads the soft close flags, returns nil when no window is given
+func parseSoftClose(cmd *cobra.Command) (*types.SoftClose, error) {
+	windowStr, err := cmd.Flags().GetString(FlagSoftCloseWindow)
+	if err != nil {
+		return nil, err
+	}
+	if len(windowStr) == 0 {
+		return nil, nil
+	}
+	window, err := time.ParseDuration(windowStr)
+	if err != nil {
+		return nil, err
+	}
+	extensionStr, err := cmd.Flags().GetString(FlagSoftCloseExtension)
+	if err != nil {
+		return nil, err
+	}
+	extension, err := time.ParseDuration(extensionStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse soft close extension: %s", extensionStr)
+	}
+	maxExtension := extension
+	maxExtensionStr, err := cmd.Flags().GetString(FlagSoftCloseMaxExt)
+	if err != nil {
+		return nil, err
+	}
+	if len(maxExtensionStr) > 0 {
+		maxExtension, err = time.ParseDuration(maxExtensionStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	softClose := types.NewSoftClose(window, extension, maxExtension)
+	return &softClose, nil
+}
+
+// GetCmdRevealReservePrice implements the reveal-reserve-price command
+func GetCmdRevealReservePrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-reserve-price",
+		Short: "Reveal the hidden reserve price of an auction",
+		Example: fmt.Sprintf(
+			"$ %s tx marketplace reveal-reserve-price [auction-id] "+
+				"--reserve-price=<reserve-price> "+
+				"--salt=<secret> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			reservePriceStr, err := cmd.Flags().GetString(FlagReservePrice)
+			if err != nil {
+				return err
+			}
+			reservePrice, err := sdk.ParseCoinNormalized(reservePriceStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse reserve price: %s", reservePriceStr)
+			}
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealReservePrice(auctionId, reservePrice, salt, owner)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsRevealReservePrice)
+	_ = cmd.MarkFlagRequired(FlagReservePrice)
+	_ = cmd.MarkFlagRequired(FlagSalt)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -36,6 +36,16 @@ type BidI interface {
 	GetBidder() sdk.AccAddress
 }
 
+type OfferI interface {
+	GetId() uint64
+	GetDenomId() string
+	GetNftId() string
+	GetAmount() sdk.Coin
+	GetBidder() sdk.AccAddress
+	GetOwner() sdk.AccAddress
+	GetExpiration() time.Time
+}
+
 type (
 	ParamSet = paramtypes.ParamSet
 
@@ -37,6 +37,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 	k.SetNextAuctionNumber(ctx, genState.NextAuctionNumber)
 
+	for _, o := range genState.Offers {
+		k.SetOffer(ctx, o)
+		k.SetOfferIndexes(ctx, o)
+	}
+	if genState.NextOfferNumber > 0 {
+		k.SetNextOfferNumber(ctx, genState.NextOfferNumber)
+	}
+
+	for _, co := range genState.CollectionOffers {
+		k.SetCollectionOffer(ctx, co)
+		k.SetCollectionOfferIndexes(ctx, co)
+	}
+	if genState.NextCollectionOfferNumber > 0 {
+		k.SetNextCollectionOfferNumber(ctx, genState.NextCollectionOfferNumber)
+	}
+
+	for _, sb := range genState.SealedBids {
+		k.SetSealedBid(ctx, sb)
+	}
+
+	for _, rp := range genState.AuctionReservePrices {
+		k.SetAuctionReservePrice(ctx, rp)
+	}
+
 	// check if the module account exists
 	moduleAcc := k.GetMarketplaceAccount(ctx)
 	if moduleAcc == nil {
@@ -52,9 +76,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		k.GetAllAuctionListings(ctx),
 		k.GetAllBids(ctx),
 		k.GetNextAuctionNumber(ctx),
+		k.GetAllOffers(ctx),
+		k.GetNextOfferNumber(ctx),
+		k.GetAllCollectionOffers(ctx),
+		k.GetNextCollectionOfferNumber(ctx),
+		k.GetAllSealedBids(ctx),
+		k.GetAllAuctionReservePrices(ctx),
 	)
 }
 
 func DefaultGenesisState() *types.GenesisState {
-	return types.NewGenesisState([]types.Listing{}, 0, types.DefaultParams(), []types.AuctionListing{}, []types.Bid{}, 1)
+	return types.NewGenesisState([]types.Listing{}, 0, types.DefaultParams(), []types.AuctionListing{}, []types.Bid{}, 1,
+		[]types.Offer{}, 1, []types.CollectionOffer{}, 1, []types.SealedBid{},
+		[]types.AuctionReservePrice{})
 }
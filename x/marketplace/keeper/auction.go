@@ -3,11 +3,11 @@ package keeper
 import (
 	"fmt"
 
-	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"cosmossdk.io/store/prefix"
 	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 )
@@ -74,6 +74,47 @@ func (k Keeper) GetAuctionListingIdByNftId(ctx sdk.Context, nftId string) (val u
 func (k Keeper) RemoveAuctionListing(ctx sdk.Context, id uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixAuctionId)
 	store.Delete(types.KeyAuctionIdPrefix(id))
+	k.RemoveAuctionReservePrice(ctx, id)
+}
+
+// SetAuctionReservePrice stores the reserve price of an auction
+func (k Keeper) SetAuctionReservePrice(ctx sdk.Context, reservePrice types.AuctionReservePrice) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&reservePrice)
+	store.Set(types.KeyAuctionReservePricePrefix(reservePrice.AuctionId), bz)
+}
+
+// GetAuctionReservePrice returns the reserve price of an auction
+func (k Keeper) GetAuctionReservePrice(ctx sdk.Context, auctionId uint64) (val types.AuctionReservePrice, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAuctionReservePricePrefix(auctionId))
+	if bz == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// RemoveAuctionReservePrice removes the reserve price of an auction from the store
+func (k Keeper) RemoveAuctionReservePrice(ctx sdk.Context, auctionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAuctionReservePricePrefix(auctionId))
+}
+
+// GetAllAuctionReservePrices returns all auction reserve prices
+func (k Keeper) GetAllAuctionReservePrices(ctx sdk.Context) (list []types.AuctionReservePrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixAuctionReservePrice)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuctionReservePrice
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
 }
 
 // GetAllAuctionListings returns all auction listings
@@ -221,6 +262,19 @@ func (k Keeper) UpdateAuctionStatusesAndProcessBids(ctx sdk.Context) error {
 		var auction types.AuctionListing
 		k.cdc.MustUnmarshal(iterator.Value(), &auction)
 
+		// sealed bid auctions are settled once the reveal phase has ended
+		if auction.IsSealedBid() {
+			if auction.SealedBidAuction.RevealEndTime.Before(ctx.BlockTime()) {
+				if k.HasRevealedSealedBid(ctx, auction.Id) && !k.canSettleSale(ctx, auction) {
+					continue
+				}
+				if err := k.settleSealedBidAuction(ctx, auction); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
 		// if auction is active
 		if auction.StartTime.Before(ctx.BlockTime()) {
 
@@ -232,8 +286,25 @@ func (k Keeper) UpdateAuctionStatusesAndProcessBids(ctx sdk.Context) error {
 			if auction.EndTime != nil && auction.EndTime.Before(ctx.BlockTime()) ||
 				auction.EndTime == nil && durationFromStartTime > bidCloseDuration {
 
+				reserve, hasReserve := k.GetAuctionReservePrice(ctx, auction.GetId())
+				// refund the bid and return NFT to owner if the reserve price is not revealed or not met
+				if found && hasReserve && !reserve.IsMet(bid.Amount) {
+					err := k.reserveNotMet(ctx, auction, bid)
+					if err != nil {
+						return err
+					}
+					// emit events
+					k.reserveNotMetEvent(ctx, auction, bid)
+					k.RemoveAuctionListing(ctx, auction.GetId())
+					k.RemoveBid(ctx, auction.GetId())
+					continue
+				}
+
 				// process bid if found else return NFT to owner
 				if found {
+					if !k.canSettleSale(ctx, auction) {
+						continue
+					}
 					err := k.processBid(ctx, auction, bid)
 					if err != nil {
 						return err
@@ -264,81 +335,40 @@ func (k Keeper) UpdateAuctionStatusesAndProcessBids(ctx sdk.Context) error {
 	return nil
 }
 
+// canSettleSale returns false if the nft of an ended auction can't be sold now, ex: its
+// denom is frozen, the sale is settled in a later block once the restriction is lifted
+func (k Keeper) canSettleSale(ctx sdk.Context, auction types.AuctionListing) bool {
+	return k.nftKeeper.ValidateTransferRestrictions(ctx, auction.DenomId, auction.NftId) == nil
+}
+
 func (k Keeper) processBid(ctx sdk.Context, auction types.AuctionListing, bid types.Bid) error {
 	owner, err := sdk.AccAddressFromBech32(auction.Owner)
 	if err != nil {
 		return err
 	}
-	denom, err := k.nftKeeper.GetDenomInfo(ctx, auction.DenomId)
-	if err != nil {
-		return err
-	}
-	nft, err := k.nftKeeper.GetONFT(ctx, auction.DenomId, auction.NftId)
+	moduleAccAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	err = k.nftKeeper.TransferOwnershipWithCause(
+		ctx,
+		auction.GetDenomId(),
+		auction.GetNftId(),
+		moduleAccAddr,
+		bid.GetBidder(),
+		onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_AUCTION,
+	)
 	if err != nil {
 		return err
 	}
-	BidAmountCoin := bid.Amount
-	auctionSaleAmountCoin := BidAmountCoin
+	return k.settleSale(ctx, auction.DenomId, auction.NftId, bid.Amount, owner, auction.SplitShares)
+}
+
+// reserveNotMet refunds the top bid and returns the nft to the auction owner
+func (k Keeper) reserveNotMet(ctx sdk.Context, auction types.AuctionListing, bid types.Bid) error {
 	moduleAccAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	err = k.nftKeeper.TransferOwnership(ctx, auction.GetDenomId(), auction.GetNftId(), moduleAccAddr, bid.GetBidder())
+	err := k.bankKeeper.SendCoins(ctx, moduleAccAddr, bid.GetBidder(), sdk.NewCoins(bid.Amount))
 	if err != nil {
 		return err
 	}
-	saleCommission := k.GetSaleCommission(ctx)
-	marketplaceCoin := k.GetProportions(bid.Amount, saleCommission)
-	if marketplaceCoin.Amount.GTE(sdkmath.OneInt()) {
-		err = k.DistributeCommission(ctx, marketplaceCoin)
-		if err != nil {
-			return err
-		}
-		auctionSaleAmountCoin = BidAmountCoin.Sub(marketplaceCoin)
-	}
-	if nft.GetRoyaltyShare().GT(sdkmath.LegacyZeroDec()) {
-		nftRoyaltyShareCoin := k.GetProportions(auctionSaleAmountCoin, nft.GetRoyaltyShare())
-		creator, err := sdk.AccAddressFromBech32(denom.Creator)
-		if err != nil {
-			return err
-		}
-		if err := k.TransferRoyalty(ctx, nftRoyaltyShareCoin, denom.RoyaltyReceivers, creator); err != nil {
-			return err
-		}
-		auctionSaleAmountCoin = auctionSaleAmountCoin.Sub(nftRoyaltyShareCoin)
-	}
-	remaining := auctionSaleAmountCoin
-
-	if len(auction.SplitShares) > 0 {
-		for _, share := range auction.SplitShares {
-			sharePortionCoin := k.GetProportions(auctionSaleAmountCoin, share.Weight)
-			sharePortionCoins := sdk.NewCoins(sharePortionCoin)
-			if share.Address == "" {
-				err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sharePortionCoins)
-				if err != nil {
-					return err
-				}
-			} else {
-				saleSplitAddr, err := sdk.AccAddressFromBech32(share.Address)
-				if err != nil {
-					return err
-				}
-				err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, saleSplitAddr, sharePortionCoins)
-				if err != nil {
-					return err
-				}
-				k.createSplitShareTransferEvent(ctx, moduleAccAddr, saleSplitAddr, sharePortionCoin)
-			}
-			remaining = remaining.Sub(sharePortionCoin)
-		}
-		err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sdk.NewCoins(remaining))
-		if err != nil {
-			return err
-		}
-	} else {
-		err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sdk.NewCoins(remaining))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return k.returnNftToOwner(ctx, auction.GetDenomId(), auction.GetNftId(), moduleAccAddr, auction.GetOwner())
 }
 
 func (k Keeper) returnNftToOwner(ctx sdk.Context, denomId, nftId string, moduleAddress, owner sdk.AccAddress) error {
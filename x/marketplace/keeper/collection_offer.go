@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// GetNextCollectionOfferNumber get the next collection offer number
+func (k Keeper) GetNextCollectionOfferNumber(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PrefixNextCollectionOfferNumber)
+	// collection offers were added after genesis on existing chains, start from 1
+	if bz == nil {
+		return 1
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetNextCollectionOfferNumber set the next collection offer number
+func (k Keeper) SetNextCollectionOfferNumber(ctx sdk.Context, number uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: number})
+	store.Set(types.PrefixNextCollectionOfferNumber, bz)
+}
+
+// SetCollectionOffer set a specific collection offer in the store
+func (k Keeper) SetCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&offer)
+	store.Set(types.KeyCollectionOfferIdPrefix(offer.Id), bz)
+}
+
+// GetCollectionOffer returns a collection offer by its id
+func (k Keeper) GetCollectionOffer(ctx sdk.Context, id uint64) (val types.CollectionOffer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyCollectionOfferIdPrefix(id))
+	if bz == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+func (k Keeper) HasCollectionOffer(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyCollectionOfferIdPrefix(id))
+}
+
+// GetAllCollectionOffers returns all collection offers
+func (k Keeper) GetAllCollectionOffers(ctx sdk.Context) (list []types.CollectionOffer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixCollectionOfferId)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CollectionOffer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetCollectionOfferIndexes sets the denom price and expiration indexes of a collection offer
+func (k Keeper) SetCollectionOfferIndexes(ctx sdk.Context, offer types.CollectionOffer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: offer.Id})
+
+	store.Set(types.KeyCollectionOfferPricePrefix(offer.DenomId, offer.Price, offer.Id), bz)
+	store.Set(types.KeyCollectionOfferExpirationPrefix(offer.Expiration, offer.Id), bz)
+}
+
+// RemoveCollectionOffer removes a collection offer and its indexes from the store
+func (k Keeper) RemoveCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyCollectionOfferIdPrefix(offer.Id))
+	store.Delete(types.KeyCollectionOfferPricePrefix(offer.DenomId, offer.Price, offer.Id))
+	store.Delete(types.KeyCollectionOfferExpirationPrefix(offer.Expiration, offer.Id))
+}
+
+// AddCollectionOffer escrows price times quantity from the bidder in the module
+// account and stores the collection offer
+func (k Keeper) AddCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) error {
+	if k.HasCollectionOffer(ctx, offer.Id) {
+		return errorsmod.Wrapf(types.ErrInvalidOfferId, "collection offer already exists: %d", offer.Id)
+	}
+	err := k.bankKeeper.SendCoins(ctx, offer.GetBidder(),
+		k.accountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(offer.Escrowed()))
+	if err != nil {
+		return err
+	}
+	k.SetCollectionOffer(ctx, offer)
+	k.SetCollectionOfferIndexes(ctx, offer)
+	k.SetNextCollectionOfferNumber(ctx, offer.Id+1)
+	return nil
+}
+
+// RefundCollectionOffer returns the escrowed amount of the unfilled quantity to
+// the bidder and removes the collection offer
+func (k Keeper) RefundCollectionOffer(ctx sdk.Context, offer types.CollectionOffer) error {
+	refund := offer.Escrowed()
+	if refund.IsPositive() {
+		err := k.bankKeeper.SendCoins(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName),
+			offer.GetBidder(), sdk.NewCoins(refund))
+		if err != nil {
+			return err
+		}
+	}
+	k.RemoveCollectionOffer(ctx, offer)
+	return nil
+}
+
+// SellToCollectionOffer transfers the nft from the seller to the bidder and settles
+// one price of the escrowed amount the same way as a listing sale, the offer is
+// removed once its quantity is filled
+func (k Keeper) SellToCollectionOffer(ctx sdk.Context, offer types.CollectionOffer, nftId string,
+	seller sdk.AccAddress, splitShares []types.WeightedAddress,
+) (types.CollectionOffer, error) {
+	err := k.nftKeeper.TransferOwnershipWithCause(
+		ctx,
+		offer.DenomId,
+		nftId,
+		seller,
+		offer.GetBidder(),
+		onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_SALE,
+	)
+	if err != nil {
+		return offer, err
+	}
+	err = k.settleSale(ctx, offer.DenomId, nftId, offer.Price, seller, splitShares)
+	if err != nil {
+		return offer, err
+	}
+	offer.Filled++
+	if offer.Remaining() == 0 {
+		k.RemoveCollectionOffer(ctx, offer)
+	} else {
+		k.SetCollectionOffer(ctx, offer)
+	}
+	return offer, nil
+}
+
+// IterateExpiredCollectionOffers iterates over collection offers expired at given block time
+func (k Keeper) IterateExpiredCollectionOffers(ctx sdk.Context,
+	fn func(index int, item types.CollectionOffer) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(append(types.PrefixCollectionOfferExpiration, sdk.FormatTimeBytes(ctx.BlockTime())...))
+	iter := store.Iterator(types.PrefixCollectionOfferExpiration, end)
+	defer iter.Close()
+
+	for i := 0; iter.Valid(); iter.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &id)
+		offer, found := k.GetCollectionOffer(ctx, id.Value)
+		if !found {
+			continue
+		}
+		if stop := fn(i, offer); stop {
+			break
+		}
+		i++
+	}
+}
+
+// RefundExpiredCollectionOffers returns the escrowed amount of the unfilled quantity
+// of all expired collection offers to their bidders
+func (k Keeper) RefundExpiredCollectionOffers(ctx sdk.Context) error {
+	var expired []types.CollectionOffer
+	k.IterateExpiredCollectionOffers(ctx, func(_ int, offer types.CollectionOffer) bool {
+		expired = append(expired, offer)
+		return false
+	})
+	for _, offer := range expired {
+		refund := offer.Escrowed()
+		if err := k.RefundCollectionOffer(ctx, offer); err != nil {
+			return err
+		}
+		k.expireCollectionOfferEvent(ctx, offer, refund)
+	}
+	return nil
+}
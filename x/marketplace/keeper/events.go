@@ -159,3 +159,260 @@ func (k *Keeper) processBidEvent(ctx sdk.Context, auction types.AuctionListing,
 		),
 	})
 }
+
+func (k *Keeper) extendAuctionEvent(ctx sdk.Context, auction types.AuctionListing) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExtendAuction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(auction.GetId())),
+			sdk.NewAttribute(types.AttributeKeyEndTime, auction.EndTime.String()),
+		),
+	})
+}
+
+func (k *Keeper) reserveNotMetEvent(ctx sdk.Context, auction types.AuctionListing, bid types.Bid) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReserveNotMet,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(auction.GetId())),
+			sdk.NewAttribute(types.AttributeKeyDenomId, auction.GetDenomId()),
+			sdk.NewAttribute(types.AttributeKeyNftId, auction.GetNftId()),
+			sdk.NewAttribute(types.AttributeKeyBidder, bid.GetBidder().String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, bid.GetAmount().String()),
+		),
+	})
+}
+
+func (k *Keeper) revealReservePriceEvent(ctx sdk.Context, reserve types.AuctionReservePrice) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealReservePrice,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(reserve.AuctionId)),
+			sdk.NewAttribute(types.AttributeKeyReservePrice, reserve.ReservePrice.String()),
+		),
+	})
+}
+
+func (k *Keeper) createDutchAuctionEvent(ctx sdk.Context, auction types.AuctionListing) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDutchAuction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOwner, auction.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(auction.GetId())),
+			sdk.NewAttribute(types.AttributeKeyDenomId, auction.GetDenomId()),
+			sdk.NewAttribute(types.AttributeKeyNftId, auction.GetNftId()),
+			sdk.NewAttribute(types.AttributeKeyStartPrice, auction.GetStartPrice().String()),
+			sdk.NewAttribute(types.AttributeKeyFloorPrice, auction.DutchAuction.FloorPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayType, auction.DutchAuction.DecayType.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, auction.EndTime.String()),
+		),
+	})
+}
+
+func (k *Keeper) buyDutchAuctionEvent(ctx sdk.Context, auction types.AuctionListing, buyer sdk.AccAddress, price sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBuyDutchAuction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(auction.GetId())),
+			sdk.NewAttribute(types.AttributeKeyDenomId, auction.GetDenomId()),
+			sdk.NewAttribute(types.AttributeKeyNftId, auction.GetNftId()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, price.String()),
+		),
+	})
+}
+
+func (k *Keeper) createSealedBidAuctionEvent(ctx sdk.Context, auction types.AuctionListing) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateSealedBidAuction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOwner, auction.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(auction.GetId())),
+			sdk.NewAttribute(types.AttributeKeyDenomId, auction.GetDenomId()),
+			sdk.NewAttribute(types.AttributeKeyNftId, auction.GetNftId()),
+			sdk.NewAttribute(types.AttributeKeyStartPrice, auction.GetStartPrice().String()),
+			sdk.NewAttribute(types.AttributeKeySettlement, auction.SealedBidAuction.SettlementType.String()),
+		),
+	})
+}
+
+func (k *Keeper) commitSealedBidEvent(ctx sdk.Context, bid types.SealedBid) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCommitSealedBid,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(bid.AuctionId)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bid.Bidder),
+			sdk.NewAttribute(types.AttributeKeyDeposit, bid.Deposit.String()),
+		),
+	})
+}
+
+func (k *Keeper) revealSealedBidEvent(ctx sdk.Context, bid types.SealedBid) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealSealedBid,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(bid.AuctionId)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bid.Bidder),
+			sdk.NewAttribute(types.AttributeKeyAmount, bid.Amount.String()),
+		),
+	})
+}
+
+func (k *Keeper) settleSealedBidAuctionEvent(ctx sdk.Context, auction types.AuctionListing, winner sdk.AccAddress,
+	price sdk.Coin,
+) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSettleSealedBidAuction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(auction.GetId())),
+			sdk.NewAttribute(types.AttributeKeyDenomId, auction.GetDenomId()),
+			sdk.NewAttribute(types.AttributeKeyNftId, auction.GetNftId()),
+			sdk.NewAttribute(types.AttributeKeyWinner, winner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, price.String()),
+		),
+	})
+}
+
+func (k *Keeper) forfeitSealedBidEvent(ctx sdk.Context, bid types.SealedBid, recipient sdk.AccAddress,
+	forfeit sdk.Coin,
+) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeForfeitSealedBid,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, fmt.Sprint(bid.AuctionId)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bid.Bidder),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, forfeit.String()),
+		),
+	})
+}
+
+func (k *Keeper) makeOfferEvent(ctx sdk.Context, offer types.Offer) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMakeOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.GetId())),
+			sdk.NewAttribute(types.AttributeKeyDenomId, offer.GetDenomId()),
+			sdk.NewAttribute(types.AttributeKeyNftId, offer.GetNftId()),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.GetBidder().String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, offer.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.GetAmount().String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, offer.GetExpiration().String()),
+		),
+	})
+}
+
+func (k *Keeper) cancelOfferEvent(ctx sdk.Context, offer types.Offer) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.GetId())),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.GetBidder().String()),
+		),
+	})
+}
+
+func (k *Keeper) acceptOfferEvent(ctx sdk.Context, offer types.Offer, owner sdk.AccAddress) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.GetId())),
+			sdk.NewAttribute(types.AttributeKeyDenomId, offer.GetDenomId()),
+			sdk.NewAttribute(types.AttributeKeyNftId, offer.GetNftId()),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.GetBidder().String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.GetAmount().String()),
+		),
+	})
+}
+
+func (k *Keeper) rejectOfferEvent(ctx sdk.Context, offer types.Offer, owner sdk.AccAddress) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRejectOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.GetId())),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		),
+	})
+}
+
+func (k *Keeper) expireOfferEvent(ctx sdk.Context, offer types.Offer) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExpireOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.GetId())),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.GetBidder().String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.GetAmount().String()),
+		),
+	})
+}
+
+func (k *Keeper) makeCollectionOfferEvent(ctx sdk.Context, offer types.CollectionOffer) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMakeCollectionOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.Id)),
+			sdk.NewAttribute(types.AttributeKeyDenomId, offer.DenomId),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.Bidder),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyQuantity, fmt.Sprint(offer.Quantity)),
+			sdk.NewAttribute(types.AttributeKeyExpiration, offer.Expiration.String()),
+		),
+	})
+}
+
+func (k *Keeper) cancelCollectionOfferEvent(ctx sdk.Context, offer types.CollectionOffer, refund sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelCollectionOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.Id)),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.Bidder),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	})
+}
+
+func (k *Keeper) sellToCollectionOfferEvent(ctx sdk.Context, offer types.CollectionOffer, nftId string, seller sdk.AccAddress) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSellToCollectionOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.Id)),
+			sdk.NewAttribute(types.AttributeKeyDenomId, offer.DenomId),
+			sdk.NewAttribute(types.AttributeKeyNftId, nftId),
+			sdk.NewAttribute(types.AttributeKeySeller, seller.String()),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.Bidder),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprint(offer.Remaining())),
+		),
+	})
+}
+
+func (k *Keeper) expireCollectionOfferEvent(ctx sdk.Context, offer types.CollectionOffer, refund sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExpireCollectionOffer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOfferId, fmt.Sprint(offer.Id)),
+			sdk.NewAttribute(types.AttributeKeyBidder, offer.Bidder),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/store/prefix"
 	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -254,6 +255,28 @@ func (k Keeper) Auction(goCtx context.Context, req *types.QueryAuctionRequest) (
 	return &types.QueryAuctionResponse{Auction: &auction}, nil
 }
 
+// DutchAuctionPrice returns the price a dutch auction can be bought at in the current block
+func (k Keeper) DutchAuctionPrice(goCtx context.Context,
+	req *types.QueryDutchAuctionPriceRequest,
+) (*types.QueryDutchAuctionPriceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	auction, found := k.GetAuctionListing(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auction %d not found", req.Id)
+	}
+	if !auction.IsDutch() {
+		return nil, status.Errorf(codes.InvalidArgument, "auction %d is not a dutch auction", req.Id)
+	}
+	return &types.QueryDutchAuctionPriceResponse{
+		Price:       auction.DutchPrice(ctx.BlockTime()),
+		NextPriceAt: auction.NextDutchPriceAt(ctx.BlockTime()),
+	}, nil
+}
+
 func (k Keeper) AuctionsByOwner(goCtx context.Context, req *types.QueryAuctionsByOwnerRequest) (*types.QueryAuctionsResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -354,6 +377,29 @@ func (k Keeper) Bid(goCtx context.Context, req *types.QueryBidRequest) (*types.Q
 	return &types.QueryBidResponse{Bid: &bid}, nil
 }
 
+// SealedBids returns the sealed bids of an auction
+func (k Keeper) SealedBids(goCtx context.Context,
+	req *types.QuerySealedBidsRequest,
+) (*types.QuerySealedBidsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var bids []types.SealedBid
+	bidStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeySealedBidPrefix(req.AuctionId, nil))
+	pageRes, err := query.Paginate(bidStore, req.Pagination, func(key []byte, value []byte) error {
+		var bid types.SealedBid
+		k.cdc.MustUnmarshal(value, &bid)
+		bids = append(bids, bid)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	return &types.QuerySealedBidsResponse{SealedBids: bids, Pagination: pageRes}, nil
+}
+
 func (k Keeper) Bids(goCtx context.Context, req *types.QueryBidsRequest) (*types.QueryBidsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -389,3 +435,140 @@ func (k Keeper) Bids(goCtx context.Context, req *types.QueryBidsRequest) (*types
 	}
 	return &types.QueryBidsResponse{Bids: bids, Pagination: pageRes}, nil
 }
+
+func (k Keeper) Offer(goCtx context.Context, req *types.QueryOfferRequest) (*types.QueryOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offer, found := k.GetOffer(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "offer %d not found", req.Id)
+	}
+	return &types.QueryOfferResponse{Offer: &offer}, nil
+}
+
+func (k Keeper) OffersByNft(goCtx context.Context, req *types.QueryOffersByNftRequest) (*types.QueryOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.DenomId) == 0 || len(req.NftId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "denom id and nft id are required")
+	}
+	if len(req.DenomId) > onfttypes.MaxIDLen || len(req.NftId) > onfttypes.MaxIDLen {
+		return nil, status.Errorf(codes.InvalidArgument, "denom id and nft id can't be longer than %d",
+			onfttypes.MaxIDLen)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOfferNFTPrefix(req.DenomId, req.NftId, 0))
+	return k.paginateOffers(ctx, offerStore, req.Pagination)
+}
+
+func (k Keeper) OffersByBidder(goCtx context.Context, req *types.QueryOffersByBidderRequest) (*types.QueryOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bidder address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOfferBidderPrefix(bidder, 0))
+	return k.paginateOffers(ctx, offerStore, req.Pagination)
+}
+
+// OffersByOwner returns the offers on the nfts currently owned by the owner, the owner
+// of each nft is resolved from the onft keeper at query time
+func (k Keeper) OffersByOwner(goCtx context.Context, req *types.QueryOffersByOwnerRequest) (*types.QueryOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var offers []types.Offer
+	offerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixOfferId)
+	pageRes, err := query.FilteredPaginate(offerStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var offer types.Offer
+		k.cdc.MustUnmarshal(value, &offer)
+		nft, err := k.nftKeeper.GetONFT(ctx, offer.DenomId, offer.NftId)
+		if err != nil || !nft.GetOwner().Equals(owner) {
+			return false, nil
+		}
+		if accumulate {
+			offers = append(offers, offer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	return &types.QueryOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) paginateOffers(ctx sdk.Context, offerStore prefix.Store, pagination *query.PageRequest) (*types.QueryOffersResponse, error) {
+	var offers []types.Offer
+	pageRes, err := query.Paginate(offerStore, pagination, func(key []byte, value []byte) error {
+		var offerId gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(value, &offerId)
+		offer, found := k.GetOffer(ctx, offerId.Value)
+		if found {
+			offers = append(offers, offer)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	return &types.QueryOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CollectionOffer(goCtx context.Context,
+	req *types.QueryCollectionOfferRequest,
+) (*types.QueryCollectionOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	offer, found := k.GetCollectionOffer(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "collection offer %d not found", req.Id)
+	}
+	return &types.QueryCollectionOfferResponse{CollectionOffer: &offer}, nil
+}
+
+func (k Keeper) BestCollectionOffers(goCtx context.Context,
+	req *types.QueryBestCollectionOffersRequest,
+) (*types.QueryBestCollectionOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.DenomId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "denom id is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var offers []types.CollectionOffer
+	offerStore := prefix.NewStore(ctx.KVStore(k.storeKey),
+		types.KeyCollectionOfferDenomPrefix(req.DenomId, req.PriceDenom))
+	pageRes, err := query.Paginate(offerStore, req.Pagination, func(key []byte, value []byte) error {
+		var offerId gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(value, &offerId)
+		offer, found := k.GetCollectionOffer(ctx, offerId.Value)
+		if found {
+			offers = append(offers, offer)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	return &types.QueryBestCollectionOffersResponse{CollectionOffers: offers, Pagination: pageRes}, nil
+}
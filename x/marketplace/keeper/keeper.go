@@ -7,6 +7,7 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	nftexported "github.com/OmniFlix/omniflixhub/v6/x/onft/exported"
 	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -112,84 +113,99 @@ func (k Keeper) Buy(ctx sdk.Context, listing types.Listing, buyer sdk.AccAddress
 	if err != nil {
 		return err
 	}
-	denom, err := k.nftKeeper.GetDenomInfo(ctx, listing.DenomId)
+	moduleAccAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	err = k.bankKeeper.SendCoins(ctx, buyer, moduleAccAddr, sdk.NewCoins(listing.Price))
+	if err != nil {
+		return err
+	}
+	err = k.nftKeeper.TransferOwnershipWithCause(
+		ctx,
+		listing.GetDenomId(),
+		listing.GetNftId(),
+		moduleAccAddr,
+		buyer,
+		onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_SALE,
+	)
 	if err != nil {
 		return err
 	}
-	listingPriceCoin := listing.Price
-	listingSaleAmountCoin := listingPriceCoin
-	nft, err := k.nftKeeper.GetONFT(ctx, listing.DenomId, listing.NftId)
+	err = k.settleSale(ctx, listing.DenomId, listing.NftId, listing.Price, owner, listing.SplitShares)
 	if err != nil {
 		return err
 	}
-	moduleAccAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
 
-	err = k.bankKeeper.SendCoins(ctx, buyer, moduleAccAddr, sdk.NewCoins(listingPriceCoin))
+	k.DeleteListing(ctx, listing)
+	return nil
+}
+
+// settleSale distributes the sale price held by the module account as
+// marketplace commission, nft royalty and split shares, the remaining
+// amount is sent to the seller
+func (k Keeper) settleSale(
+	ctx sdk.Context,
+	denomId, nftId string,
+	price sdk.Coin,
+	owner sdk.AccAddress,
+	splitShares []types.WeightedAddress,
+) error {
+	denom, err := k.nftKeeper.GetDenomInfo(ctx, denomId)
 	if err != nil {
 		return err
 	}
-	err = k.nftKeeper.TransferOwnership(ctx, listing.GetDenomId(), listing.GetNftId(), moduleAccAddr, buyer)
+	nft, err := k.nftKeeper.GetONFT(ctx, denomId, nftId)
 	if err != nil {
 		return err
 	}
+	saleAmountCoin := price
+	moduleAccAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
 	saleCommission := k.GetSaleCommission(ctx)
-	marketplaceCoin := k.GetProportions(listing.Price, saleCommission)
+	marketplaceCoin := k.GetProportions(price, saleCommission)
 	if marketplaceCoin.Amount.GTE(sdkmath.OneInt()) {
 		err = k.DistributeCommission(ctx, marketplaceCoin)
 		if err != nil {
 			return err
 		}
-		listingSaleAmountCoin = listingPriceCoin.Sub(marketplaceCoin)
+		saleAmountCoin = price.Sub(marketplaceCoin)
 	}
 	// check if it is a valid royalty share
 	if nft.GetRoyaltyShare().GT(sdkmath.LegacyZeroDec()) && nft.GetRoyaltyShare().LTE(sdkmath.LegacyOneDec()) {
-		nftRoyaltyShareCoin := k.GetProportions(listingSaleAmountCoin, nft.GetRoyaltyShare())
+		nftRoyaltyShareCoin := k.GetProportions(saleAmountCoin, nft.GetRoyaltyShare())
 		creator, err := sdk.AccAddressFromBech32(denom.Creator)
 		if err != nil {
 			return err
 		}
-		if err := k.TransferRoyalty(ctx, nftRoyaltyShareCoin, denom.RoyaltyReceivers, creator); err != nil {
+		royaltyReceivers := k.GetRoyaltyReceivers(denom, nft)
+		if err := k.TransferRoyalty(ctx, nftRoyaltyShareCoin, royaltyReceivers, creator); err != nil {
 			return err
 		}
-		listingSaleAmountCoin = listingSaleAmountCoin.Sub(nftRoyaltyShareCoin)
+		saleAmountCoin = saleAmountCoin.Sub(nftRoyaltyShareCoin)
 	}
-	remaining := listingSaleAmountCoin
+	remaining := saleAmountCoin
 
-	if len(listing.SplitShares) > 0 {
-		for _, share := range listing.SplitShares {
-			sharePortionCoin := k.GetProportions(listingSaleAmountCoin, share.Weight)
-			sharePortionCoins := sdk.NewCoins(sharePortionCoin)
-			if share.Address == "" {
-				err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sharePortionCoins)
-				if err != nil {
-					return err
-				}
-			} else {
-				saleSplitAddr, err := sdk.AccAddressFromBech32(share.Address)
-				if err != nil {
-					return err
-				}
-				err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, saleSplitAddr, sharePortionCoins)
-				if err != nil {
-					return err
-				}
-				k.createSplitShareTransferEvent(ctx, moduleAccAddr, saleSplitAddr, sharePortionCoin)
+	for _, share := range splitShares {
+		sharePortionCoin := k.GetProportions(saleAmountCoin, share.Weight)
+		sharePortionCoins := sdk.NewCoins(sharePortionCoin)
+		if share.Address == "" {
+			err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sharePortionCoins)
+			if err != nil {
+				return err
 			}
-			remaining = remaining.Sub(sharePortionCoin)
-		}
-		err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sdk.NewCoins(remaining))
-		if err != nil {
-			return err
-		}
-	} else {
-		err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sdk.NewCoins(remaining))
-		if err != nil {
-			return err
+		} else {
+			saleSplitAddr, err := sdk.AccAddressFromBech32(share.Address)
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.SendCoins(ctx, moduleAccAddr, saleSplitAddr, sharePortionCoins)
+			if err != nil {
+				return err
+			}
+			k.createSplitShareTransferEvent(ctx, moduleAccAddr, saleSplitAddr, sharePortionCoin)
 		}
+		remaining = remaining.Sub(sharePortionCoin)
 	}
-
-	k.DeleteListing(ctx, listing)
-	return nil
+	return k.bankKeeper.SendCoins(ctx, moduleAccAddr, owner, sdk.NewCoins(remaining))
 }
 
 func (k Keeper) GetProportions(totalCoin sdk.Coin, ratio sdkmath.LegacyDec) sdk.Coin {
@@ -268,6 +284,9 @@ func (k Keeper) CancelAuctionListing(ctx sdk.Context, auction types.AuctionListi
 	if k.HasBid(ctx, auction.Id) {
 		return errorsmod.Wrapf(types.ErrBidExists, "cannot cancel auction %d, bid exists ", auction.Id)
 	}
+	if k.HasSealedBids(ctx, auction.Id) {
+		return errorsmod.Wrapf(types.ErrBidExists, "cannot cancel auction %d, sealed bids exist", auction.Id)
+	}
 
 	// Transfer Back NFT ownership to auction owner
 	err := k.nftKeeper.TransferOwnership(ctx, auction.GetDenomId(), auction.GetNftId(),
@@ -312,6 +331,46 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auction types.AuctionListing, newBid t
 	// Set new bid
 	k.SetBid(ctx, newBid)
 
+	// Extend the auction if the bid is placed within the soft close window
+	if extension := auction.SoftCloseExtension(newBid.Time); extension > 0 {
+		endTime := auction.EndTime.Add(extension)
+		auction.EndTime = &endTime
+		auction.SoftClose.Extended += extension
+		k.SetAuctionListing(ctx, auction)
+		k.extendAuctionEvent(ctx, auction)
+	}
+
+	return nil
+}
+
+// BuyDutchAuction transfers the nft of a dutch auction to the buyer for given price,
+// settles the price the same way as a listing sale and removes the auction
+func (k Keeper) BuyDutchAuction(ctx sdk.Context, auction types.AuctionListing, buyer sdk.AccAddress, price sdk.Coin) error {
+	moduleAccAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	err := k.bankKeeper.SendCoins(ctx, buyer, moduleAccAddr, sdk.NewCoins(price))
+	if err != nil {
+		return err
+	}
+	err = k.nftKeeper.TransferOwnershipWithCause(
+		ctx,
+		auction.GetDenomId(),
+		auction.GetNftId(),
+		moduleAccAddr,
+		buyer,
+		onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_AUCTION,
+	)
+	if err != nil {
+		return err
+	}
+	err = k.settleSale(ctx, auction.DenomId, auction.NftId, price, auction.GetOwner(), auction.SplitShares)
+	if err != nil {
+		return err
+	}
+	k.RemoveAuctionListing(ctx, auction.GetId())
+	k.UnsetAuctionListingWithOwner(ctx, auction.GetOwner(), auction.GetId())
+	k.UnsetAuctionListingWithNFTID(ctx, auction.GetNftId())
+	k.UnsetAuctionListingWithPriceDenom(ctx, auction.StartPrice.Denom, auction.GetId())
+
 	return nil
 }
 
@@ -319,6 +378,15 @@ func (k Keeper) GetNewBidPrice(denom string, amount sdk.Coin, increment sdkmath.
 	return sdk.NewCoin(denom, amount.Amount.Add(sdkmath.LegacyNewDecFromInt(amount.Amount).Mul(increment).TruncateInt()))
 }
 
+// GetRoyaltyReceivers returns the royalty receivers of the nft when set,
+// otherwise the royalty receivers of the denom
+func (k Keeper) GetRoyaltyReceivers(denom *onfttypes.Denom, nft nftexported.ONFTI) []*onfttypes.WeightedAddress {
+	if onft, ok := nft.(onfttypes.ONFT); ok && len(onft.RoyaltyReceivers) > 0 {
+		return onft.RoyaltyReceivers
+	}
+	return denom.RoyaltyReceivers
+}
+
 func (k Keeper) TransferRoyalty(
 	ctx sdk.Context,
 	nftRoyaltyShareCoin sdk.Coin,
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	onftkeeper "github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting"
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/keeper"
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient  types.QueryClient
+	msgServer    types.MsgServer
+	nftMsgServer onfttypes.MsgServer
+
+	// creator owns the denom and receives the royalties, seller owns the minted nfts
+	creator       sdk.AccAddress
+	seller        sdk.AccAddress
+	buyer         sdk.AccAddress
+	splitReceiver sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+var (
+	defaultDenomId      = "onftdenomtest001"
+	defaultNftId        = "onfttest"
+	defaultPriceDenom   = onfttypes.DefaultDenomCreationFee.Denom
+	defaultRoyaltyShare = sdkmath.LegacyNewDecWithPrec(10, 2) // 10%
+	defaultDuration     = time.Hour
+)
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	// Fund every TestAcc with some tokens
+	fundAccsAmount := sdk.NewCoins(
+		sdk.NewCoin(onfttypes.DefaultDenomCreationFee.Denom, onfttypes.DefaultDenomCreationFee.Amount.MulRaw(100)),
+	)
+	for _, acc := range suite.TestAccs {
+		suite.FundAcc(acc, fundAccsAmount)
+	}
+	suite.creator, suite.seller, suite.buyer = suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	suite.splitReceiver = apptesting.CreateRandomAccounts(1)[0]
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.App.MarketplaceKeeper)
+	suite.nftMsgServer = onftkeeper.NewMsgServerImpl(suite.App.ONFTKeeper)
+
+	suite.createDefaultDenom()
+}
+
+func (suite *KeeperTestSuite) createDefaultDenom() {
+	createDenomMsg := onfttypes.NewMsgCreateDenom(
+		"test",
+		"test denom",
+		"{}",
+		"test description",
+		"ipfs://testuri",
+		"",
+		"ipfs://testpreviewuri",
+		"",
+		suite.creator.String(),
+		onfttypes.DefaultDenomCreationFee,
+		nil,
+		false,
+		0,
+		false,
+		false,
+	)
+	createDenomMsg.Id = defaultDenomId
+	_, err := suite.nftMsgServer.CreateDenom(suite.Ctx, createDenomMsg)
+	suite.Require().NoError(err)
+}
+
+// mintNFT mints an nft of the default denom with the default royalty share to the seller
+func (suite *KeeperTestSuite) mintNFT(nftId string) {
+	mintNftMsg := onfttypes.NewMsgMintONFT(
+		defaultDenomId,
+		suite.creator.String(),
+		suite.seller.String(),
+		onfttypes.Metadata{
+			Name:     nftId,
+			MediaURI: "ipfs://" + nftId,
+		},
+		"{}",
+		true,
+		true,
+		false,
+		defaultRoyaltyShare,
+		nil,
+		nil,
+	)
+	mintNftMsg.Id = nftId
+	_, err := suite.nftMsgServer.MintONFT(suite.Ctx, mintNftMsg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) freezeDenom() {
+	_, err := suite.nftMsgServer.FreezeDenom(suite.Ctx, onfttypes.NewMsgFreezeDenom(defaultDenomId, suite.creator.String()))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) unfreezeDenom() {
+	_, err := suite.nftMsgServer.UnfreezeDenom(suite.Ctx, onfttypes.NewMsgUnfreezeDenom(defaultDenomId, suite.creator.String()))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) nftOwner(nftId string) sdk.AccAddress {
+	return suite.App.ONFTKeeper.NFTkeeper().GetOwner(suite.Ctx, defaultDenomId, nftId)
+}
+
+func (suite *KeeperTestSuite) moduleAddress() sdk.AccAddress {
+	return suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdkmath.Int {
+	return suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultPriceDenom).Amount
+}
+
+// advanceTime moves the block time of the suite context forward
+func (suite *KeeperTestSuite) advanceTime(d time.Duration) {
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(d))
+}
+
+func (suite *KeeperTestSuite) endBlock() {
+	suite.Require().NoError(suite.App.MarketplaceKeeper.UpdateAuctionStatusesAndProcessBids(suite.Ctx))
+}
+
+// requireBalance compares balances by value as equal amounts can differ in their internal representation
+func (suite *KeeperTestSuite) requireBalance(expected sdkmath.Int, addr sdk.AccAddress) {
+	suite.Require().Equal(expected.String(), suite.balance(addr).String())
+}
+
+func price(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(defaultPriceDenom, amount)
+}
+
+func (suite *KeeperTestSuite) listNFT(nftId string, amount int64, splitShares []types.WeightedAddress) string {
+	msg := types.NewMsgListNFT(defaultDenomId, nftId, price(amount), suite.seller, splitShares)
+	_, err := suite.msgServer.ListNFT(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	return msg.Id
+}
+
+// createAuctionMsg returns the msg of an english auction of the seller starting at the
+// current block time with a 1% increment
+func (suite *KeeperTestSuite) createAuctionMsg(nftId string, startPrice int64) *types.MsgCreateAuction {
+	duration := defaultDuration
+	return types.NewMsgCreateAuction(
+		defaultDenomId, nftId, suite.Ctx.BlockTime(), &duration, price(startPrice), suite.seller,
+		sdkmath.LegacyNewDecWithPrec(1, 2), nil, nil,
+	)
+}
+
+func (suite *KeeperTestSuite) createAuction(nftId string, startPrice int64) types.AuctionListing {
+	resp, err := suite.msgServer.CreateAuction(suite.Ctx, suite.createAuctionMsg(nftId, startPrice))
+	suite.Require().NoError(err)
+	return *resp.Auction
+}
+
+// createDutchAuction creates a dutch auction of the seller with a linear decay over
+// the default duration starting at the current block time
+func (suite *KeeperTestSuite) createDutchAuction(nftId string, startPrice, floorPrice int64,
+	splitShares []types.WeightedAddress,
+) types.AuctionListing {
+	resp, err := suite.msgServer.CreateDutchAuction(suite.Ctx, types.NewMsgCreateDutchAuction(
+		defaultDenomId, nftId, suite.Ctx.BlockTime(), defaultDuration, price(startPrice), price(floorPrice),
+		types.DECAY_TYPE_LINEAR, 0, suite.seller, nil, splitShares,
+	))
+	suite.Require().NoError(err)
+	return *resp.Auction
+}
+
+// createSealedBidAuction creates a sealed bid auction with a bid phase and a reveal phase of
+// the default duration each and a forfeit of 10% of the deposit of unrevealed bids
+func (suite *KeeperTestSuite) createSealedBidAuction(nftId string, minPrice int64, settlementType types.SettlementType) types.AuctionListing {
+	resp, err := suite.msgServer.CreateSealedBidAuction(suite.Ctx, types.NewMsgCreateSealedBidAuction(
+		defaultDenomId, nftId, suite.Ctx.BlockTime(), price(minPrice), defaultDuration, defaultDuration,
+		settlementType, sdkmath.LegacyNewDecWithPrec(10, 2), suite.seller, nil, nil,
+	))
+	suite.Require().NoError(err)
+	return *resp.Auction
+}
+
+func (suite *KeeperTestSuite) commitSealedBid(auctionId uint64, bidder sdk.AccAddress, amount, deposit int64) {
+	commitment := types.SealedBidCommitment(auctionId, bidder, price(amount), "salt")
+	_, err := suite.msgServer.CommitSealedBid(suite.Ctx, types.NewMsgCommitSealedBid(auctionId, commitment, price(deposit), bidder))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) revealSealedBid(auctionId uint64, bidder sdk.AccAddress, amount int64) {
+	_, err := suite.msgServer.RevealSealedBid(suite.Ctx, types.NewMsgRevealSealedBid(auctionId, price(amount), "salt", bidder))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) makeOffer(nftId string, amount int64, bidder sdk.AccAddress) types.Offer {
+	resp, err := suite.msgServer.MakeOffer(suite.Ctx, types.NewMsgMakeOffer(defaultDenomId, nftId, price(amount), defaultDuration, bidder))
+	suite.Require().NoError(err)
+	return *resp.Offer
+}
+
+// splitShares returns a 20% split share of the sale to the split receiver
+func (suite *KeeperTestSuite) splitShares() []types.WeightedAddress {
+	return []types.WeightedAddress{{Address: suite.splitReceiver.String(), Weight: sdkmath.LegacyNewDecWithPrec(20, 2)}}
+}
+
+// saleBalances returns the balances of the creator, the seller and the split receiver
+func (suite *KeeperTestSuite) saleBalances() []sdkmath.Int {
+	return []sdkmath.Int{suite.balance(suite.creator), suite.balance(suite.seller), suite.balance(suite.splitReceiver)}
+}
+
+// requireSaleSettled checks the royalty paid to the creator, the amount paid to the seller
+// and the split share paid to the split receiver since the given sale balances
+func (suite *KeeperTestSuite) requireSaleSettled(before []sdkmath.Int, royalty, proceeds, split int64) {
+	after := suite.saleBalances()
+	suite.Require().Equal(before[0].AddRaw(royalty).String(), after[0].String(), "royalty")
+	suite.Require().Equal(before[1].AddRaw(proceeds).String(), after[1].String(), "proceeds")
+	suite.Require().Equal(before[2].AddRaw(split).String(), after[2].String(), "split share")
+}
+
+func (suite *KeeperTestSuite) makeCollectionOffer(offerPrice sdk.Coin, quantity uint64, bidder sdk.AccAddress) types.CollectionOffer {
+	resp, err := suite.msgServer.MakeCollectionOffer(suite.Ctx,
+		types.NewMsgMakeCollectionOffer(defaultDenomId, offerPrice, quantity, defaultDuration, bidder))
+	suite.Require().NoError(err)
+	return *resp.CollectionOffer
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -156,6 +157,9 @@ func (m msgServer) BuyNFT(goCtx context.Context, msg *types.MsgBuyNFT) (*types.M
 		return nil, errorsmod.Wrapf(types.ErrInvalidPrice,
 			"price %s not matched with listing price", msg.Price.String())
 	}
+	if err := m.nftKeeper.ValidateTransferRestrictions(ctx, listing.DenomId, listing.NftId); err != nil {
+		return nil, err
+	}
 	err = m.Keeper.Buy(ctx, listing, buyer)
 	if err != nil {
 		return nil, err
@@ -202,6 +206,11 @@ func (m msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuct
 			return nil, errorsmod.Wrapf(types.ErrInvalidDuration,
 				"duration %s exceeds max auction duration %s", msg.Duration.String(), maxAuctionDuration.String())
 		}
+		if msg.SoftClose != nil && *msg.Duration+msg.SoftClose.MaxExtension > maxAuctionDuration {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDuration,
+				"duration %s with soft close max extension %s exceeds max auction duration %s",
+				msg.Duration.String(), msg.SoftClose.MaxExtension.String(), maxAuctionDuration.String())
+		}
 		endAt := msg.StartTime.Add(*msg.Duration)
 		endTime = &endAt
 		if endTime.Before(msg.StartTime) || endTime.Equal(msg.StartTime) {
@@ -212,10 +221,21 @@ func (m msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuct
 	auction := types.NewAuctionListing(auctionNumber, msg.NftId, msg.DenomId,
 		msg.StartTime, endTime, msg.StartPrice,
 		msg.IncrementPercentage, owner, msg.WhitelistAccounts, msg.SplitShares)
+	if msg.SoftClose != nil {
+		softClose := types.NewSoftClose(msg.SoftClose.Window, msg.SoftClose.Extension, msg.SoftClose.MaxExtension)
+		auction.SoftClose = &softClose
+	}
+	auction.HasReservePrice = len(msg.ReservePriceCommitment) > 0
 	err = m.Keeper.CreateAuctionListing(ctx, auction)
 	if err != nil {
 		return nil, err
 	}
+	if auction.HasReservePrice {
+		m.Keeper.SetAuctionReservePrice(ctx, types.AuctionReservePrice{
+			AuctionId:  auction.Id,
+			Commitment: msg.ReservePriceCommitment,
+		})
+	}
 
 	m.Keeper.createAuctionEvent(ctx, auction)
 
@@ -264,9 +284,16 @@ func (m msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*typ
 	if !found {
 		return nil, errorsmod.Wrapf(types.ErrAuctionDoesNotExists, "auction id %d not exists", msg.AuctionId)
 	}
+	if auction.AuctionType != types.AUCTION_TYPE_ENGLISH {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuctionType,
+			"cannot place a bid for %s auction %d", auction.AuctionType, auction.Id)
+	}
 	if !auction.StartTime.Before(ctx.BlockTime()) {
 		return nil, errorsmod.Wrapf(types.ErrInActiveAuction, "cannot place a bid for inactive auction %d, ", auction.Id)
 	}
+	if auction.EndTime != nil && auction.EndTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrEndedAuction, "cannot place a bid for ended auction %d", auction.Id)
+	}
 	if len(auction.WhitelistAccounts) > 0 && !slices.Contains(auction.WhitelistAccounts, bidder.String()) {
 		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "cannot place a bid for this auction %d, only whitelisted accounts allowed to bid", auction.Id)
 	}
@@ -285,3 +312,577 @@ func (m msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*typ
 
 	return &types.MsgPlaceBidResponse{}, nil
 }
+
+// CreateDutchAuction creates an auction sold to the first buyer at a price declining
+// from the start price to the floor price over the auction duration
+func (m msgServer) CreateDutchAuction(goCtx context.Context,
+	msg *types.MsgCreateDutchAuction,
+) (*types.MsgCreateDutchAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.Validate(ctx.BlockTime()); err != nil {
+		return nil, err
+	}
+
+	nft, err := m.nftKeeper.GetONFT(ctx, msg.DenomId, msg.NftId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrNftNotExists,
+			"invalid nft and or denomId, nftId %s, denomId %s", msg.NftId, msg.DenomId)
+	}
+	if owner.String() != nft.GetOwner().String() {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", owner)
+	}
+	if !nft.IsTransferable() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNftNonTransferable, "non-transferable nfts not allowed to list in marketplace")
+	}
+
+	if err := m.Keeper.ValidateSplitShareAddresses(msg.SplitShares); err != nil {
+		return nil, err
+	}
+
+	maxAuctionDuration := m.Keeper.GetMaxAuctionDuration(ctx)
+	if msg.Duration > maxAuctionDuration {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDuration,
+			"duration %s exceeds max auction duration %s", msg.Duration.String(), maxAuctionDuration.String())
+	}
+
+	auctionNumber := m.Keeper.GetNextAuctionNumber(ctx)
+	dutchAuction := types.NewDutchAuction(msg.FloorPrice, msg.DecayType, msg.StepInterval)
+	auction := types.NewDutchAuctionListing(auctionNumber, msg.NftId, msg.DenomId,
+		msg.StartTime, msg.StartTime.Add(msg.Duration), msg.StartPrice,
+		dutchAuction, owner, msg.WhitelistAccounts, msg.SplitShares)
+	err = m.Keeper.CreateAuctionListing(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.createDutchAuctionEvent(ctx, auction)
+
+	return &types.MsgCreateDutchAuctionResponse{
+		Auction: &auction,
+	}, nil
+}
+
+// BuyDutchAuction buys the nft of an active dutch auction at its current price
+func (m msgServer) BuyDutchAuction(goCtx context.Context,
+	msg *types.MsgBuyDutchAuction,
+) (*types.MsgBuyDutchAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, found := m.Keeper.GetAuctionListing(ctx, msg.AuctionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAuctionDoesNotExists, "auction id %d not exists", msg.AuctionId)
+	}
+	if !auction.IsDutch() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuctionType, "auction %d is not a dutch auction", auction.Id)
+	}
+	if !auction.StartTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInActiveAuction, "cannot buy from inactive auction %d", auction.Id)
+	}
+	if auction.EndTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrEndedAuction, "auction %d ended", auction.Id)
+	}
+	if buyer.Equals(auction.GetOwner()) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "cannot buy from own auction %d", auction.Id)
+	}
+	if len(auction.WhitelistAccounts) > 0 && !slices.Contains(auction.WhitelistAccounts, buyer.String()) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized,
+			"cannot buy from this auction %d, only whitelisted accounts allowed to buy", auction.Id)
+	}
+	if msg.MaxPrice.GetDenom() != auction.StartPrice.GetDenom() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPriceDenom,
+			"given auction only accepts %s, ", auction.StartPrice.GetDenom())
+	}
+	price := auction.DutchPrice(ctx.BlockTime())
+	if msg.MaxPrice.IsLT(price) {
+		return nil, errorsmod.Wrapf(types.ErrBidAmountNotEnough,
+			"current price of auction %d is %s, max price %s", auction.Id, price, msg.MaxPrice)
+	}
+	if err := m.nftKeeper.ValidateTransferRestrictions(ctx, auction.DenomId, auction.NftId); err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.BuyDutchAuction(ctx, auction, buyer, price)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.buyDutchAuctionEvent(ctx, auction, buyer, price)
+
+	return &types.MsgBuyDutchAuctionResponse{
+		Price: price,
+	}, nil
+}
+
+// CreateSealedBidAuction creates an auction accepting hashed bids until the end time
+// that are revealed until the reveal end time
+func (m msgServer) CreateSealedBidAuction(goCtx context.Context,
+	msg *types.MsgCreateSealedBidAuction,
+) (*types.MsgCreateSealedBidAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.Validate(ctx.BlockTime()); err != nil {
+		return nil, err
+	}
+
+	nft, err := m.nftKeeper.GetONFT(ctx, msg.DenomId, msg.NftId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrNftNotExists,
+			"invalid nft and or denomId, nftId %s, denomId %s", msg.NftId, msg.DenomId)
+	}
+	if owner.String() != nft.GetOwner().String() {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", owner)
+	}
+	if !nft.IsTransferable() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNftNonTransferable, "non-transferable nfts not allowed to list in marketplace")
+	}
+
+	if err := m.Keeper.ValidateSplitShareAddresses(msg.SplitShares); err != nil {
+		return nil, err
+	}
+
+	maxAuctionDuration := m.Keeper.GetMaxAuctionDuration(ctx)
+	if msg.BidDuration+msg.RevealDuration > maxAuctionDuration {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDuration,
+			"bid and reveal duration %s exceeds max auction duration %s",
+			(msg.BidDuration + msg.RevealDuration).String(), maxAuctionDuration.String())
+	}
+
+	auctionNumber := m.Keeper.GetNextAuctionNumber(ctx)
+	endTime := msg.StartTime.Add(msg.BidDuration)
+	sealedBidAuction := types.NewSealedBidAuction(endTime.Add(msg.RevealDuration), msg.SettlementType,
+		msg.ForfeitPercentage)
+	auction := types.NewSealedBidAuctionListing(auctionNumber, msg.NftId, msg.DenomId,
+		msg.StartTime, endTime, msg.MinPrice,
+		sealedBidAuction, owner, msg.WhitelistAccounts, msg.SplitShares)
+	err = m.Keeper.CreateAuctionListing(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.createSealedBidAuctionEvent(ctx, auction)
+
+	return &types.MsgCreateSealedBidAuctionResponse{
+		Auction: &auction,
+	}, nil
+}
+
+// CommitSealedBid escrows the deposit of a hashed bid on a sealed bid auction in its bid phase
+func (m msgServer) CommitSealedBid(goCtx context.Context,
+	msg *types.MsgCommitSealedBid,
+) (*types.MsgCommitSealedBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, found := m.Keeper.GetAuctionListing(ctx, msg.AuctionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAuctionDoesNotExists, "auction id %d not exists", msg.AuctionId)
+	}
+	if !auction.IsSealedBid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuctionType, "auction %d is not a sealed bid auction", auction.Id)
+	}
+	if !auction.StartTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInActiveAuction, "cannot place a bid for inactive auction %d", auction.Id)
+	}
+	if auction.EndTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrEndedAuction, "bid phase of auction %d ended", auction.Id)
+	}
+	if bidder.Equals(auction.GetOwner()) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "cannot bid on own auction %d", auction.Id)
+	}
+	if len(auction.WhitelistAccounts) > 0 && !slices.Contains(auction.WhitelistAccounts, bidder.String()) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized,
+			"cannot place a bid for this auction %d, only whitelisted accounts allowed to bid", auction.Id)
+	}
+	if msg.Deposit.GetDenom() != auction.StartPrice.GetDenom() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPriceDenom,
+			"given auction only accepts bids in %s, ", auction.StartPrice.GetDenom())
+	}
+	if msg.Deposit.IsLT(auction.StartPrice) {
+		return nil, errorsmod.Wrapf(types.ErrBidAmountNotEnough,
+			"deposit %s is less than min price %s", msg.Deposit, auction.StartPrice)
+	}
+
+	bid := types.NewSealedBid(auction.Id, msg.Commitment, msg.Deposit, ctx.BlockTime(), bidder)
+	err = m.Keeper.CommitSealedBid(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.commitSealedBidEvent(ctx, bid)
+
+	return &types.MsgCommitSealedBidResponse{}, nil
+}
+
+// RevealSealedBid reveals the amount of a sealed bid in the reveal phase of the auction
+func (m msgServer) RevealSealedBid(goCtx context.Context,
+	msg *types.MsgRevealSealedBid,
+) (*types.MsgRevealSealedBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, found := m.Keeper.GetAuctionListing(ctx, msg.AuctionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAuctionDoesNotExists, "auction id %d not exists", msg.AuctionId)
+	}
+	if !auction.IsSealedBid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuctionType, "auction %d is not a sealed bid auction", auction.Id)
+	}
+	if !auction.EndTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInActiveAuction,
+			"reveal phase of auction %d starts after %s", auction.Id, auction.EndTime.String())
+	}
+	if auction.SealedBidAuction.RevealEndTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrEndedAuction, "reveal phase of auction %d ended", auction.Id)
+	}
+	bid, found := m.Keeper.GetSealedBid(ctx, auction.Id, bidder)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBidDoesNotExists,
+			"no sealed bid of %s on auction %d", bidder.String(), auction.Id)
+	}
+
+	bid, err = m.Keeper.RevealSealedBid(ctx, auction, bid, msg.Amount, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.revealSealedBidEvent(ctx, bid)
+
+	return &types.MsgRevealSealedBidResponse{}, nil
+}
+
+// RevealReservePrice reveals the hidden reserve price of an auction before it is settled
+func (m msgServer) RevealReservePrice(goCtx context.Context,
+	msg *types.MsgRevealReservePrice,
+) (*types.MsgRevealReservePriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, found := m.Keeper.GetAuctionListing(ctx, msg.AuctionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAuctionDoesNotExists, "auction id %d not exists", msg.AuctionId)
+	}
+	if owner.String() != auction.Owner {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", owner.String())
+	}
+	reserve, found := m.Keeper.GetAuctionReservePrice(ctx, auction.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidReservePrice, "auction %d has no reserve price", auction.Id)
+	}
+	if reserve.ReservePrice != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidReservePrice,
+			"reserve price of auction %d already revealed", auction.Id)
+	}
+	if !bytes.Equal(types.ReservePriceCommitment(msg.ReservePrice, msg.Salt), reserve.Commitment) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidReservePrice,
+			"reserve price and salt do not match the commitment")
+	}
+	if err := types.ValidateReservePrice(msg.ReservePrice, auction.StartPrice); err != nil {
+		return nil, err
+	}
+
+	reserve.ReservePrice = &msg.ReservePrice
+	m.Keeper.SetAuctionReservePrice(ctx, reserve)
+
+	m.Keeper.revealReservePriceEvent(ctx, reserve)
+
+	return &types.MsgRevealReservePriceResponse{}, nil
+}
+
+// MakeOffer escrows the offered amount for an nft that is not listed
+func (m msgServer) MakeOffer(goCtx context.Context, msg *types.MsgMakeOffer) (*types.MsgMakeOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	nft, err := m.nftKeeper.GetONFT(ctx, msg.DenomId, msg.NftId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrNftNotExists,
+			"invalid nft and or denomId, nftId %s, denomId %s", msg.NftId, msg.DenomId)
+	}
+	owner := nft.GetOwner()
+	if owner.Equals(m.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		return nil, errorsmod.Wrapf(types.ErrNftNotAvailable,
+			"nft %s is listed or in auction, offers are only allowed on unlisted nfts", msg.NftId)
+	}
+	if owner.Equals(bidder) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "cannot make an offer on owned nft %s", msg.NftId)
+	}
+	if !nft.IsTransferable() {
+		return nil, errorsmod.Wrapf(types.ErrNftNonTransferable, "cannot make an offer on non-transferable nft")
+	}
+
+	maxOfferDuration := m.Keeper.GetMaxAuctionDuration(ctx)
+	if msg.Duration > maxOfferDuration {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDuration,
+			"duration %s exceeds max duration %s", msg.Duration.String(), maxOfferDuration.String())
+	}
+
+	offerNumber := m.Keeper.GetNextOfferNumber(ctx)
+	offer := types.NewOffer(offerNumber, msg.NftId, msg.DenomId, msg.Amount, bidder, owner,
+		ctx.BlockTime(), ctx.BlockTime().Add(msg.Duration))
+	err = m.Keeper.AddOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.makeOfferEvent(ctx, offer)
+
+	return &types.MsgMakeOfferResponse{
+		Offer: &offer,
+	}, nil
+}
+
+// CancelOffer refunds the escrowed amount of an offer to its bidder
+func (m msgServer) CancelOffer(goCtx context.Context, msg *types.MsgCancelOffer) (*types.MsgCancelOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, found := m.Keeper.GetOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrOfferDoesNotExists, "offer id %d not exists", msg.OfferId)
+	}
+	if bidder.String() != offer.Bidder {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", bidder.String())
+	}
+
+	err = m.Keeper.RefundOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.cancelOfferEvent(ctx, offer)
+
+	return &types.MsgCancelOfferResponse{}, nil
+}
+
+// AcceptOffer sells the nft to the bidder of the offer, only the current owner of the nft can accept
+func (m msgServer) AcceptOffer(goCtx context.Context, msg *types.MsgAcceptOffer) (*types.MsgAcceptOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, found := m.Keeper.GetOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrOfferDoesNotExists, "offer id %d not exists", msg.OfferId)
+	}
+	if offer.IsExpired(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrOfferExpired, "offer %d expired at %s", offer.Id, offer.Expiration.String())
+	}
+	nft, err := m.nftKeeper.GetONFT(ctx, offer.DenomId, offer.NftId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrNftNotExists,
+			"invalid nft and or denomId, nftId %s, denomId %s", offer.NftId, offer.DenomId)
+	}
+	if owner.String() != nft.GetOwner().String() {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", owner)
+	}
+	if !nft.IsTransferable() {
+		return nil, errorsmod.Wrapf(types.ErrNftNonTransferable, "non-transferable nfts not allowed to sell in marketplace")
+	}
+
+	if err := m.nftKeeper.ValidateTransferRestrictions(ctx, offer.DenomId, offer.NftId); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.ValidateSplitShareAddresses(msg.SplitShares); err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.AcceptOffer(ctx, offer, owner, msg.SplitShares)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.acceptOfferEvent(ctx, offer, owner)
+
+	return &types.MsgAcceptOfferResponse{}, nil
+}
+
+// RejectOffer refunds the escrowed amount of an offer, only the current owner of the nft can reject
+func (m msgServer) RejectOffer(goCtx context.Context, msg *types.MsgRejectOffer) (*types.MsgRejectOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, found := m.Keeper.GetOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrOfferDoesNotExists, "offer id %d not exists", msg.OfferId)
+	}
+	nft, err := m.nftKeeper.GetONFT(ctx, offer.DenomId, offer.NftId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrNftNotExists,
+			"invalid nft and or denomId, nftId %s, denomId %s", offer.NftId, offer.DenomId)
+	}
+	if owner.String() != nft.GetOwner().String() {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", owner)
+	}
+
+	err = m.Keeper.RefundOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.rejectOfferEvent(ctx, offer, owner)
+
+	return &types.MsgRejectOfferResponse{}, nil
+}
+
+// MakeCollectionOffer escrows price times quantity for nfts of a denom
+func (m msgServer) MakeCollectionOffer(goCtx context.Context,
+	msg *types.MsgMakeCollectionOffer,
+) (*types.MsgMakeCollectionOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.nftKeeper.GetDenomInfo(ctx, msg.DenomId); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrNftNotExists, "invalid denomId %s", msg.DenomId)
+	}
+
+	maxOfferDuration := m.Keeper.GetMaxAuctionDuration(ctx)
+	if msg.Duration > maxOfferDuration {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDuration,
+			"duration %s exceeds max duration %s", msg.Duration.String(), maxOfferDuration.String())
+	}
+
+	offerNumber := m.Keeper.GetNextCollectionOfferNumber(ctx)
+	offer := types.NewCollectionOffer(offerNumber, msg.DenomId, msg.Price, msg.Quantity, bidder,
+		ctx.BlockTime(), ctx.BlockTime().Add(msg.Duration))
+	err = m.Keeper.AddCollectionOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.makeCollectionOfferEvent(ctx, offer)
+
+	return &types.MsgMakeCollectionOfferResponse{
+		CollectionOffer: &offer,
+	}, nil
+}
+
+// CancelCollectionOffer refunds the escrowed amount of the unfilled quantity to the bidder
+func (m msgServer) CancelCollectionOffer(goCtx context.Context,
+	msg *types.MsgCancelCollectionOffer,
+) (*types.MsgCancelCollectionOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, found := m.Keeper.GetCollectionOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrOfferDoesNotExists, "collection offer id %d not exists", msg.OfferId)
+	}
+	if bidder.String() != offer.Bidder {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", bidder.String())
+	}
+
+	refund := offer.Escrowed()
+	err = m.Keeper.RefundCollectionOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.cancelCollectionOfferEvent(ctx, offer, refund)
+
+	return &types.MsgCancelCollectionOfferResponse{}, nil
+}
+
+// SellToCollectionOffer sells an owned nft of the offer denom to the bidder of the collection offer
+func (m msgServer) SellToCollectionOffer(goCtx context.Context,
+	msg *types.MsgSellToCollectionOffer,
+) (*types.MsgSellToCollectionOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, found := m.Keeper.GetCollectionOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrOfferDoesNotExists, "collection offer id %d not exists", msg.OfferId)
+	}
+	if offer.IsExpired(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrOfferExpired,
+			"collection offer %d expired at %s", offer.Id, offer.Expiration.String())
+	}
+	if offer.Remaining() == 0 {
+		return nil, errorsmod.Wrapf(types.ErrOfferFilled, "collection offer %d is filled", offer.Id)
+	}
+	if seller.String() == offer.Bidder {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "cannot sell into own collection offer %d", offer.Id)
+	}
+	nft, err := m.nftKeeper.GetONFT(ctx, offer.DenomId, msg.NftId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrNftNotExists,
+			"invalid nft and or denomId, nftId %s, denomId %s", msg.NftId, offer.DenomId)
+	}
+	if seller.String() != nft.GetOwner().String() {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "unauthorized address %s", seller)
+	}
+	if !nft.IsTransferable() {
+		return nil, errorsmod.Wrapf(types.ErrNftNonTransferable, "non-transferable nfts not allowed to sell in marketplace")
+	}
+
+	if err := m.nftKeeper.ValidateTransferRestrictions(ctx, offer.DenomId, msg.NftId); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.ValidateSplitShareAddresses(msg.SplitShares); err != nil {
+		return nil, err
+	}
+
+	offer, err = m.Keeper.SellToCollectionOffer(ctx, offer, msg.NftId, seller, msg.SplitShares)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.sellToCollectionOfferEvent(ctx, offer, msg.NftId, seller)
+
+	return &types.MsgSellToCollectionOfferResponse{}, nil
+}
@@ -0,0 +1,667 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting"
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+)
+
+func (suite *KeeperTestSuite) TestBuyNFTDenomFrozen() {
+	suite.mintNFT(defaultNftId)
+	listingId := suite.listNFT(defaultNftId, 1000, nil)
+	suite.freezeDenom()
+
+	buyerBalance := suite.balance(suite.buyer)
+	_, err := suite.msgServer.BuyNFT(suite.Ctx, types.NewMsgBuyNFT(listingId, price(1000), suite.buyer))
+	suite.Require().ErrorIs(err, onfttypes.ErrDenomFrozen)
+	suite.requireBalance(buyerBalance, suite.buyer)
+	suite.Require().Equal(suite.moduleAddress(), suite.nftOwner(defaultNftId))
+
+	// the listing can still be cancelled, the nft goes back to the seller
+	_, err = suite.msgServer.DeListNFT(suite.Ctx, types.NewMsgDeListNFT(listingId, suite.seller))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.seller, suite.nftOwner(defaultNftId))
+}
+
+func (suite *KeeperTestSuite) TestEndedAuctionDenomFrozen() {
+	suite.mintNFT(defaultNftId)
+	auction := suite.createAuction(defaultNftId, 1000)
+	suite.advanceTime(time.Second)
+	_, err := suite.msgServer.PlaceBid(suite.Ctx, types.NewMsgPlaceBid(auction.Id, price(1000), suite.buyer))
+	suite.Require().NoError(err)
+	suite.freezeDenom()
+
+	// the sale of an ended auction waits for the denom to be unfrozen
+	suite.advanceTime(defaultDuration)
+	suite.endBlock()
+	_, found := suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.moduleAddress(), suite.nftOwner(defaultNftId))
+
+	suite.unfreezeDenom()
+	suite.endBlock()
+	_, found = suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().False(found)
+	suite.Require().Equal(suite.buyer, suite.nftOwner(defaultNftId))
+}
+
+func (suite *KeeperTestSuite) TestBuyDutchAuctionDenomFrozen() {
+	suite.mintNFT(defaultNftId)
+	auction := suite.createDutchAuction(defaultNftId, 1000, 100, nil)
+	suite.advanceTime(time.Second)
+	suite.freezeDenom()
+
+	buyerBalance := suite.balance(suite.buyer)
+	_, err := suite.msgServer.BuyDutchAuction(suite.Ctx, types.NewMsgBuyDutchAuction(auction.Id, price(1000), suite.buyer))
+	suite.Require().ErrorIs(err, onfttypes.ErrDenomFrozen)
+	suite.requireBalance(buyerBalance, suite.buyer)
+	suite.Require().Equal(suite.moduleAddress(), suite.nftOwner(defaultNftId))
+}
+
+func (suite *KeeperTestSuite) TestAcceptOfferDenomFrozen() {
+	suite.mintNFT(defaultNftId)
+	offer := suite.makeOffer(defaultNftId, 1000, suite.buyer)
+	suite.freezeDenom()
+
+	_, err := suite.msgServer.AcceptOffer(suite.Ctx, types.NewMsgAcceptOffer(offer.Id, suite.seller, nil))
+	suite.Require().ErrorIs(err, onfttypes.ErrDenomFrozen)
+	suite.Require().Equal(suite.seller, suite.nftOwner(defaultNftId))
+	_, found := suite.App.MarketplaceKeeper.GetOffer(suite.Ctx, offer.Id)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestSealedBidSettlementDenomFrozen() {
+	suite.mintNFT(defaultNftId)
+	auction := suite.createSealedBidAuction(defaultNftId, 100, types.SETTLEMENT_TYPE_FIRST_PRICE)
+	suite.advanceTime(time.Second)
+	suite.commitSealedBid(auction.Id, suite.buyer, 500, 1000)
+	suite.advanceTime(defaultDuration)
+	suite.revealSealedBid(auction.Id, suite.buyer, 500)
+	suite.freezeDenom()
+
+	// the settlement waits for the denom to be unfrozen
+	suite.advanceTime(defaultDuration)
+	suite.endBlock()
+	_, found := suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().True(found)
+	suite.Require().Len(suite.App.MarketplaceKeeper.GetSealedBids(suite.Ctx, auction.Id), 1)
+
+	suite.unfreezeDenom()
+	suite.endBlock()
+	_, found = suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().False(found)
+	suite.Require().Equal(suite.buyer, suite.nftOwner(defaultNftId))
+}
+
+func (suite *KeeperTestSuite) TestAcceptOffer() {
+	suite.mintNFT(defaultNftId)
+	offer := suite.makeOffer(defaultNftId, 1000, suite.buyer)
+	suite.Require().Equal(suite.seller.String(), offer.Owner)
+
+	// only the owner of the nft can accept
+	_, err := suite.msgServer.AcceptOffer(suite.Ctx, types.NewMsgAcceptOffer(offer.Id, suite.buyer, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// 1% commission, 10% royalty on the remaining 990 and a 20% split share of the remaining 891
+	before := suite.saleBalances()
+	moduleBalance := suite.balance(suite.moduleAddress())
+	_, err = suite.msgServer.AcceptOffer(suite.Ctx, types.NewMsgAcceptOffer(offer.Id, suite.seller, suite.splitShares()))
+	suite.Require().NoError(err)
+	suite.requireSaleSettled(before, 99, 713, 178)
+	suite.requireBalance(moduleBalance.SubRaw(1000), suite.moduleAddress())
+	suite.Require().Equal(suite.buyer, suite.nftOwner(defaultNftId))
+	_, found := suite.App.MarketplaceKeeper.GetOffer(suite.Ctx, offer.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestOfferRefunds() {
+	suite.mintNFT(defaultNftId)
+	buyerBalance := suite.balance(suite.buyer)
+
+	// rejected by the owner
+	offer := suite.makeOffer(defaultNftId, 1000, suite.buyer)
+	suite.requireBalance(buyerBalance.SubRaw(1000), suite.buyer)
+	_, err := suite.msgServer.RejectOffer(suite.Ctx, types.NewMsgRejectOffer(offer.Id, suite.buyer))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.RejectOffer(suite.Ctx, types.NewMsgRejectOffer(offer.Id, suite.seller))
+	suite.Require().NoError(err)
+	suite.requireBalance(buyerBalance, suite.buyer)
+
+	// cancelled by the bidder
+	offer = suite.makeOffer(defaultNftId, 1000, suite.buyer)
+	_, err = suite.msgServer.CancelOffer(suite.Ctx, types.NewMsgCancelOffer(offer.Id, suite.seller))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.CancelOffer(suite.Ctx, types.NewMsgCancelOffer(offer.Id, suite.buyer))
+	suite.Require().NoError(err)
+	suite.requireBalance(buyerBalance, suite.buyer)
+
+	// expired in end block
+	offer = suite.makeOffer(defaultNftId, 1000, suite.buyer)
+	suite.Require().NoError(suite.App.MarketplaceKeeper.RefundExpiredOffers(suite.Ctx))
+	suite.requireBalance(buyerBalance.SubRaw(1000), suite.buyer)
+	suite.advanceTime(defaultDuration + time.Second)
+	suite.Require().NoError(suite.App.MarketplaceKeeper.RefundExpiredOffers(suite.Ctx))
+	suite.requireBalance(buyerBalance, suite.buyer)
+	_, found := suite.App.MarketplaceKeeper.GetOffer(suite.Ctx, offer.Id)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.App.MarketplaceKeeper.GetAllOffers(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestOffersByOwnerAfterTransfer() {
+	suite.mintNFT(defaultNftId)
+	offer := suite.makeOffer(defaultNftId, 1000, suite.buyer)
+
+	resp, err := suite.queryClient.OffersByOwner(suite.Ctx, &types.QueryOffersByOwnerRequest{Owner: suite.seller.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Offers, 1)
+
+	// the offer follows the nft to its new owner
+	_, err = suite.nftMsgServer.TransferONFT(suite.Ctx,
+		onfttypes.NewMsgTransferONFT(defaultNftId, defaultDenomId, suite.seller.String(), suite.creator.String()))
+	suite.Require().NoError(err)
+	resp, err = suite.queryClient.OffersByOwner(suite.Ctx, &types.QueryOffersByOwnerRequest{Owner: suite.seller.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Offers)
+	resp, err = suite.queryClient.OffersByOwner(suite.Ctx, &types.QueryOffersByOwnerRequest{Owner: suite.creator.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Offers, 1)
+
+	_, err = suite.msgServer.AcceptOffer(suite.Ctx, types.NewMsgAcceptOffer(offer.Id, suite.seller, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.AcceptOffer(suite.Ctx, types.NewMsgAcceptOffer(offer.Id, suite.creator, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.buyer, suite.nftOwner(defaultNftId))
+}
+
+func (suite *KeeperTestSuite) TestOffersByNftAndBidder() {
+	// the id of the first nft is a prefix of the id of the second one
+	suite.mintNFT(defaultNftId)
+	suite.mintNFT(defaultNftId + "1")
+	first := suite.makeOffer(defaultNftId, 1000, suite.buyer)
+	second := suite.makeOffer(defaultNftId+"1", 1000, suite.buyer)
+	third := suite.makeOffer(defaultNftId+"1", 2000, suite.creator)
+
+	for _, tc := range []struct {
+		nftId  string
+		offers []uint64
+	}{
+		{defaultNftId, []uint64{first.Id}},
+		{defaultNftId + "1", []uint64{second.Id, third.Id}},
+	} {
+		resp, err := suite.queryClient.OffersByNft(suite.Ctx,
+			&types.QueryOffersByNftRequest{DenomId: defaultDenomId, NftId: tc.nftId})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Offers, len(tc.offers), tc.nftId)
+		for i, offer := range resp.Offers {
+			suite.Require().Equal(tc.offers[i], offer.Id)
+		}
+	}
+
+	for _, tc := range []struct {
+		bidder sdk.AccAddress
+		offers []uint64
+	}{
+		{suite.buyer, []uint64{first.Id, second.Id}},
+		{suite.creator, []uint64{third.Id}},
+		{suite.seller, nil},
+	} {
+		resp, err := suite.queryClient.OffersByBidder(suite.Ctx,
+			&types.QueryOffersByBidderRequest{Bidder: tc.bidder.String()})
+		suite.Require().NoError(err)
+		suite.Require().Len(resp.Offers, len(tc.offers))
+		for i, offer := range resp.Offers {
+			suite.Require().Equal(tc.offers[i], offer.Id)
+		}
+	}
+
+	_, err := suite.queryClient.OffersByNft(suite.Ctx,
+		&types.QueryOffersByNftRequest{DenomId: defaultDenomId, NftId: strings.Repeat("a", 256)})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestSellToCollectionOffer() {
+	suite.mintNFT("onfttest1")
+	suite.mintNFT("onfttest2")
+	buyerBalance := suite.balance(suite.buyer)
+	offer := suite.makeCollectionOffer(price(1000), 2, suite.buyer)
+	suite.requireBalance(buyerBalance.SubRaw(2000), suite.buyer)
+
+	// 1% commission, 10% royalty on the remaining 990 and a 20% split share of the remaining 891
+	before := suite.saleBalances()
+	_, err := suite.msgServer.SellToCollectionOffer(suite.Ctx,
+		types.NewMsgSellToCollectionOffer(offer.Id, "onfttest1", suite.seller, suite.splitShares()))
+	suite.Require().NoError(err)
+	suite.requireSaleSettled(before, 99, 713, 178)
+	suite.Require().Equal(suite.buyer, suite.nftOwner("onfttest1"))
+	offer, found := suite.App.MarketplaceKeeper.GetCollectionOffer(suite.Ctx, offer.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), offer.Filled)
+
+	// the offer is removed once its quantity is filled
+	_, err = suite.msgServer.SellToCollectionOffer(suite.Ctx,
+		types.NewMsgSellToCollectionOffer(offer.Id, "onfttest2", suite.seller, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.buyer, suite.nftOwner("onfttest2"))
+	_, found = suite.App.MarketplaceKeeper.GetCollectionOffer(suite.Ctx, offer.Id)
+	suite.Require().False(found)
+	suite.requireBalance(buyerBalance.SubRaw(2000), suite.buyer)
+}
+
+func (suite *KeeperTestSuite) TestCollectionOfferRefunds() {
+	suite.mintNFT(defaultNftId)
+	buyerBalance := suite.balance(suite.buyer)
+
+	// cancelled by the bidder after a partial fill, only the unfilled quantity is refunded
+	offer := suite.makeCollectionOffer(price(1000), 3, suite.buyer)
+	_, err := suite.msgServer.SellToCollectionOffer(suite.Ctx,
+		types.NewMsgSellToCollectionOffer(offer.Id, defaultNftId, suite.seller, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CancelCollectionOffer(suite.Ctx, types.NewMsgCancelCollectionOffer(offer.Id, suite.seller))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.CancelCollectionOffer(suite.Ctx, types.NewMsgCancelCollectionOffer(offer.Id, suite.buyer))
+	suite.Require().NoError(err)
+	suite.requireBalance(buyerBalance.SubRaw(1000), suite.buyer)
+
+	// expired in end block
+	buyerBalance = suite.balance(suite.buyer)
+	offer = suite.makeCollectionOffer(price(1000), 2, suite.buyer)
+	suite.Require().NoError(suite.App.MarketplaceKeeper.RefundExpiredCollectionOffers(suite.Ctx))
+	suite.requireBalance(buyerBalance.SubRaw(2000), suite.buyer)
+	suite.advanceTime(defaultDuration + time.Second)
+	suite.Require().NoError(suite.App.MarketplaceKeeper.RefundExpiredCollectionOffers(suite.Ctx))
+	suite.requireBalance(buyerBalance, suite.buyer)
+	_, found := suite.App.MarketplaceKeeper.GetCollectionOffer(suite.Ctx, offer.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestBestCollectionOffersPriceDenom() {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	suite.FundAcc(suite.buyer, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 10000)))
+	suite.makeCollectionOffer(price(1000), 1, suite.buyer)
+	suite.makeCollectionOffer(price(2000), 1, suite.buyer)
+	suite.makeCollectionOffer(sdk.NewInt64Coin(ibcDenom, 1000), 1, suite.buyer)
+
+	for _, tc := range []struct {
+		priceDenom string
+		prices     []sdk.Coin
+	}{
+		{defaultPriceDenom, []sdk.Coin{price(2000), price(1000)}},
+		{ibcDenom, []sdk.Coin{sdk.NewInt64Coin(ibcDenom, 1000)}},
+		// the price denom doesn't match as a prefix of the ibc denom
+		{"ibc", nil},
+		{"", []sdk.Coin{price(2000), price(1000), sdk.NewInt64Coin(ibcDenom, 1000)}},
+	} {
+		resp, err := suite.queryClient.BestCollectionOffers(suite.Ctx, &types.QueryBestCollectionOffersRequest{
+			DenomId:    defaultDenomId,
+			PriceDenom: tc.priceDenom,
+		})
+		suite.Require().NoError(err)
+		var prices sdk.Coins
+		for _, offer := range resp.CollectionOffers {
+			prices = append(prices, offer.Price)
+		}
+		suite.Require().Equal(sdk.Coins(tc.prices).String(), prices.String(), tc.priceDenom)
+	}
+}
+
+func (suite *KeeperTestSuite) TestBuyDutchAuction() {
+	suite.mintNFT(defaultNftId)
+	auction := suite.createDutchAuction(defaultNftId, 1500, 500, suite.splitShares())
+
+	_, err := suite.msgServer.BuyDutchAuction(suite.Ctx, types.NewMsgBuyDutchAuction(auction.Id, price(1500), suite.buyer))
+	suite.Require().ErrorIs(err, types.ErrInActiveAuction)
+
+	// the price falls linearly from the start price to the floor price, queried on the
+	// keeper as the query client doesn't see the advanced block time
+	suite.advanceTime(defaultDuration / 2)
+	resp, err := suite.App.MarketplaceKeeper.DutchAuctionPrice(suite.Ctx, &types.QueryDutchAuctionPriceRequest{Id: auction.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(price(1000).String(), resp.Price.String())
+	suite.Require().Nil(resp.NextPriceAt)
+
+	_, err = suite.msgServer.BuyDutchAuction(suite.Ctx, types.NewMsgBuyDutchAuction(auction.Id, price(1000), suite.seller))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.BuyDutchAuction(suite.Ctx,
+		types.NewMsgBuyDutchAuction(auction.Id, sdk.NewInt64Coin("uatom", 1000), suite.buyer))
+	suite.Require().ErrorIs(err, types.ErrInvalidPriceDenom)
+	_, err = suite.msgServer.BuyDutchAuction(suite.Ctx, types.NewMsgBuyDutchAuction(auction.Id, price(999), suite.buyer))
+	suite.Require().ErrorIs(err, types.ErrBidAmountNotEnough)
+
+	// the buyer pays the current price, not the max price, settled the same way as a listing sale
+	buyerBalance := suite.balance(suite.buyer)
+	before := suite.saleBalances()
+	buyResp, err := suite.msgServer.BuyDutchAuction(suite.Ctx, types.NewMsgBuyDutchAuction(auction.Id, price(1200), suite.buyer))
+	suite.Require().NoError(err)
+	suite.Require().Equal(price(1000).String(), buyResp.Price.String())
+	suite.requireBalance(buyerBalance.SubRaw(1000), suite.buyer)
+	suite.requireSaleSettled(before, 99, 713, 178)
+	suite.Require().Equal(suite.buyer, suite.nftOwner(defaultNftId))
+	_, found := suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestBuyDutchAuctionSteppedDecay() {
+	suite.mintNFT(defaultNftId)
+	startTime := suite.Ctx.BlockTime()
+	resp, err := suite.msgServer.CreateDutchAuction(suite.Ctx, types.NewMsgCreateDutchAuction(
+		defaultDenomId, defaultNftId, startTime, defaultDuration, price(1000), price(400),
+		types.DECAY_TYPE_STEPPED, 20*time.Minute, suite.seller, nil, nil,
+	))
+	suite.Require().NoError(err)
+	auction := *resp.Auction
+
+	// three steps of 200, the price holds between steps
+	for _, tc := range []struct {
+		elapsed     time.Duration
+		price       int64
+		nextPriceAt time.Duration
+	}{
+		{time.Second, 1000, 20 * time.Minute},
+		{25 * time.Minute, 800, 40 * time.Minute},
+		{45 * time.Minute, 600, defaultDuration},
+	} {
+		suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(tc.elapsed))
+		priceResp, err := suite.App.MarketplaceKeeper.DutchAuctionPrice(suite.Ctx, &types.QueryDutchAuctionPriceRequest{Id: auction.Id})
+		suite.Require().NoError(err)
+		suite.Require().Equal(price(tc.price).String(), priceResp.Price.String())
+		suite.Require().NotNil(priceResp.NextPriceAt)
+		suite.Require().Equal(startTime.Add(tc.nextPriceAt), priceResp.NextPriceAt.UTC())
+	}
+
+	// the floor price is reached at the end time
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(defaultDuration))
+	buyResp, err := suite.msgServer.BuyDutchAuction(suite.Ctx, types.NewMsgBuyDutchAuction(auction.Id, price(1000), suite.buyer))
+	suite.Require().NoError(err)
+	suite.Require().Equal(price(400).String(), buyResp.Price.String())
+	suite.Require().Equal(suite.buyer, suite.nftOwner(defaultNftId))
+}
+
+func (suite *KeeperTestSuite) TestDutchAuctionSteppedPartialInterval() {
+	suite.mintNFT(defaultNftId)
+	startTime := suite.Ctx.BlockTime()
+	resp, err := suite.msgServer.CreateDutchAuction(suite.Ctx, types.NewMsgCreateDutchAuction(
+		defaultDenomId, defaultNftId, startTime, defaultDuration, price(1000), price(400),
+		types.DECAY_TYPE_STEPPED, 25*time.Minute, suite.seller, nil, nil,
+	))
+	suite.Require().NoError(err)
+	auction := *resp.Auction
+
+	// the last interval is partial, the floor price is only reached at the end time
+	for _, tc := range []struct {
+		elapsed     time.Duration
+		price       int64
+		nextPriceAt time.Duration
+	}{
+		{time.Second, 1000, 25 * time.Minute},
+		{25 * time.Minute, 750, 50 * time.Minute},
+		{59 * time.Minute, 500, defaultDuration},
+	} {
+		suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(tc.elapsed))
+		priceResp, err := suite.App.MarketplaceKeeper.DutchAuctionPrice(suite.Ctx, &types.QueryDutchAuctionPriceRequest{Id: auction.Id})
+		suite.Require().NoError(err)
+		suite.Require().Equal(price(tc.price).String(), priceResp.Price.String())
+		suite.Require().NotNil(priceResp.NextPriceAt)
+		suite.Require().Equal(startTime.Add(tc.nextPriceAt), priceResp.NextPriceAt.UTC())
+	}
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(defaultDuration))
+	priceResp, err := suite.App.MarketplaceKeeper.DutchAuctionPrice(suite.Ctx, &types.QueryDutchAuctionPriceRequest{Id: auction.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(price(400).String(), priceResp.Price.String())
+	suite.Require().Nil(priceResp.NextPriceAt)
+}
+
+func (suite *KeeperTestSuite) TestBuyDutchAuctionEnded() {
+	suite.mintNFT(defaultNftId)
+	auction := suite.createDutchAuction(defaultNftId, 1000, 100, nil)
+	suite.advanceTime(defaultDuration + time.Second)
+
+	_, err := suite.msgServer.BuyDutchAuction(suite.Ctx, types.NewMsgBuyDutchAuction(auction.Id, price(1000), suite.buyer))
+	suite.Require().ErrorIs(err, types.ErrEndedAuction)
+}
+
+func (suite *KeeperTestSuite) TestSealedBidSettlement() {
+	for _, tc := range []struct {
+		name           string
+		settlementType types.SettlementType
+		revealed       []int64
+		price          int64
+		royalty        int64
+		proceeds       int64
+	}{
+		// 1% commission and 10% royalty on the remaining amount, the seller also gets the forfeit of 150
+		{"first price", types.SETTLEMENT_TYPE_FIRST_PRICE, []int64{800, 1000}, 1000, 99, 891 + 150},
+		{"second price", types.SETTLEMENT_TYPE_SECOND_PRICE, []int64{800, 1000}, 800, 79, 713 + 150},
+		{"second price single bid pays min price", types.SETTLEMENT_TYPE_SECOND_PRICE, []int64{1000}, 100, 9, 90 + 150},
+	} {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mintNFT(defaultNftId)
+			auction := suite.createSealedBidAuction(defaultNftId, 100, tc.settlementType)
+			suite.advanceTime(time.Second)
+
+			// every bid deposits 1500, the last bidder doesn't reveal and forfeits 10% of its deposit
+			bidders := apptesting.CreateRandomAccounts(len(tc.revealed) + 1)
+			for i, bidder := range bidders {
+				suite.FundAcc(bidder, sdk.NewCoins(price(1500)))
+				amount := int64(500)
+				if i < len(tc.revealed) {
+					amount = tc.revealed[i]
+				}
+				suite.commitSealedBid(auction.Id, bidder, amount, 1500)
+			}
+			suite.advanceTime(defaultDuration)
+			for i, amount := range tc.revealed {
+				suite.revealSealedBid(auction.Id, bidders[i], amount)
+			}
+
+			before := suite.saleBalances()
+			suite.advanceTime(defaultDuration)
+			suite.endBlock()
+			suite.requireSaleSettled(before, tc.royalty, tc.proceeds, 0)
+
+			winner := bidders[len(tc.revealed)-1]
+			suite.Require().Equal(winner, suite.nftOwner(defaultNftId))
+			suite.requireBalance(sdkmath.NewInt(1500-tc.price), winner)
+			for _, bidder := range bidders[:len(tc.revealed)-1] {
+				suite.requireBalance(sdkmath.NewInt(1500), bidder)
+			}
+			suite.requireBalance(sdkmath.NewInt(1350), bidders[len(tc.revealed)])
+			suite.Require().Empty(suite.App.MarketplaceKeeper.GetSealedBids(suite.Ctx, auction.Id))
+			_, found := suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSealedBidUnrevealedForfeit() {
+	suite.mintNFT(defaultNftId)
+	auction := suite.createSealedBidAuction(defaultNftId, 100, types.SETTLEMENT_TYPE_FIRST_PRICE)
+	suite.advanceTime(time.Second)
+	buyerBalance := suite.balance(suite.buyer)
+	suite.commitSealedBid(auction.Id, suite.buyer, 500, 1000)
+
+	// the bid is not revealed, the forfeit is paid to the owner
+	sellerBalance := suite.balance(suite.seller)
+	suite.advanceTime(2 * defaultDuration)
+	suite.endBlock()
+	suite.requireBalance(buyerBalance.SubRaw(100), suite.buyer)
+	suite.requireBalance(sellerBalance.AddRaw(100), suite.seller)
+
+	// the nft returns to the owner when no bid is revealed
+	suite.Require().Equal(suite.seller, suite.nftOwner(defaultNftId))
+	suite.Require().Empty(suite.App.MarketplaceKeeper.GetSealedBids(suite.Ctx, auction.Id))
+	_, found := suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestAuctionSoftCloseExtensionCap() {
+	suite.mintNFT(defaultNftId)
+	msg := suite.createAuctionMsg(defaultNftId, 1000)
+	softClose := types.NewSoftClose(10*time.Minute, 5*time.Minute, 8*time.Minute)
+	msg.SoftClose = &softClose
+	resp, err := suite.msgServer.CreateAuction(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	auction := *resp.Auction
+	endTime := *auction.EndTime
+
+	var bidder sdk.AccAddress
+	for _, tc := range []struct {
+		bidAt    time.Time
+		amount   int64
+		extended time.Duration
+	}{
+		// outside the soft close window
+		{endTime.Add(-20 * time.Minute), 1000, 0},
+		// a full extension, then the 3m left of the max extension
+		{endTime.Add(-5 * time.Minute), 1100, 5 * time.Minute},
+		{endTime.Add(time.Minute), 1200, 8 * time.Minute},
+		// the cap is reached, the end time stays
+		{endTime.Add(7 * time.Minute), 1300, 8 * time.Minute},
+	} {
+		bidder = apptesting.CreateRandomAccounts(1)[0]
+		suite.FundAcc(bidder, sdk.NewCoins(price(tc.amount)))
+		suite.Ctx = suite.Ctx.WithBlockTime(tc.bidAt)
+		_, err := suite.msgServer.PlaceBid(suite.Ctx, types.NewMsgPlaceBid(auction.Id, price(tc.amount), bidder))
+		suite.Require().NoError(err)
+
+		listing, found := suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+		suite.Require().True(found)
+		suite.Require().Equal(tc.extended, listing.SoftClose.Extended)
+		suite.Require().Equal(endTime.Add(tc.extended), listing.EndTime.UTC())
+	}
+
+	// settled after the capped end time to the last bidder
+	suite.Ctx = suite.Ctx.WithBlockTime(endTime.Add(8 * time.Minute))
+	suite.endBlock()
+	_, found := suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().True(found)
+	suite.advanceTime(time.Second)
+	suite.endBlock()
+	_, found = suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+	suite.Require().False(found)
+	suite.Require().Equal(bidder, suite.nftOwner(defaultNftId))
+}
+
+func (suite *KeeperTestSuite) TestAuctionReservePrice() {
+	for _, tc := range []struct {
+		name   string
+		bid    int64
+		reveal bool
+		met    bool
+	}{
+		{"reserve not revealed", 2000, false, false},
+		{"reserve not met", 1500, true, false},
+		{"reserve met", 2000, true, true},
+	} {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mintNFT(defaultNftId)
+			msg := suite.createAuctionMsg(defaultNftId, 1000)
+			msg.ReservePriceCommitment = types.ReservePriceCommitment(price(2000), "salt")
+			resp, err := suite.msgServer.CreateAuction(suite.Ctx, msg)
+			suite.Require().NoError(err)
+			auction := *resp.Auction
+			suite.Require().True(auction.HasReservePrice)
+			reserve, found := suite.App.MarketplaceKeeper.GetAuctionReservePrice(suite.Ctx, auction.Id)
+			suite.Require().True(found)
+			suite.Require().Nil(reserve.ReservePrice)
+
+			suite.advanceTime(time.Second)
+			buyerBalance := suite.balance(suite.buyer)
+			_, err = suite.msgServer.PlaceBid(suite.Ctx, types.NewMsgPlaceBid(auction.Id, price(tc.bid), suite.buyer))
+			suite.Require().NoError(err)
+			if tc.reveal {
+				_, err = suite.msgServer.RevealReservePrice(suite.Ctx,
+					types.NewMsgRevealReservePrice(auction.Id, price(2000), "salt", suite.seller))
+				suite.Require().NoError(err)
+			}
+
+			before := suite.saleBalances()
+			suite.advanceTime(defaultDuration)
+			suite.endBlock()
+			_, found = suite.App.MarketplaceKeeper.GetAuctionListing(suite.Ctx, auction.Id)
+			suite.Require().False(found)
+			_, found = suite.App.MarketplaceKeeper.GetAuctionReservePrice(suite.Ctx, auction.Id)
+			suite.Require().False(found)
+			_, found = suite.App.MarketplaceKeeper.GetBid(suite.Ctx, auction.Id)
+			suite.Require().False(found)
+
+			if tc.met {
+				// 1% commission and 10% royalty on the remaining amount
+				suite.requireSaleSettled(before, 198, 1782, 0)
+				suite.requireBalance(buyerBalance.SubRaw(tc.bid), suite.buyer)
+				suite.Require().Equal(suite.buyer, suite.nftOwner(defaultNftId))
+				return
+			}
+			// the bid is refunded and the nft returns to the owner
+			suite.requireSaleSettled(before, 0, 0, 0)
+			suite.requireBalance(buyerBalance, suite.buyer)
+			suite.Require().Equal(suite.seller, suite.nftOwner(defaultNftId))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevealReservePrice() {
+	suite.mintNFT(defaultNftId)
+	suite.mintNFT("onft2")
+	msg := suite.createAuctionMsg(defaultNftId, 1000)
+	msg.ReservePriceCommitment = types.ReservePriceCommitment(price(2000), "salt")
+	resp, err := suite.msgServer.CreateAuction(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	auction := *resp.Auction
+	noReserve := suite.createAuction("onft2", 1000)
+
+	// the commitment must be a sha256 hash
+	invalidMsg := suite.createAuctionMsg(defaultNftId, 1000)
+	invalidMsg.ReservePriceCommitment = []byte("not a hash")
+	suite.Require().ErrorIs(invalidMsg.ValidateBasic(), types.ErrInvalidReservePrice)
+
+	for _, tc := range []struct {
+		name string
+		msg  *types.MsgRevealReservePrice
+		err  error
+	}{
+		{"unknown auction", types.NewMsgRevealReservePrice(100, price(2000), "salt", suite.seller),
+			types.ErrAuctionDoesNotExists},
+		{"not the owner", types.NewMsgRevealReservePrice(auction.Id, price(2000), "salt", suite.buyer),
+			types.ErrUnauthorized},
+		{"no reserve price", types.NewMsgRevealReservePrice(noReserve.Id, price(2000), "salt", suite.seller),
+			types.ErrInvalidReservePrice},
+		{"wrong salt", types.NewMsgRevealReservePrice(auction.Id, price(2000), "other", suite.seller),
+			types.ErrInvalidReservePrice},
+		{"wrong price", types.NewMsgRevealReservePrice(auction.Id, price(1999), "salt", suite.seller),
+			types.ErrInvalidReservePrice},
+	} {
+		_, err := suite.msgServer.RevealReservePrice(suite.Ctx, tc.msg)
+		suite.Require().ErrorIs(err, tc.err, tc.name)
+	}
+
+	_, err = suite.msgServer.RevealReservePrice(suite.Ctx,
+		types.NewMsgRevealReservePrice(auction.Id, price(2000), "salt", suite.seller))
+	suite.Require().NoError(err)
+	reserve, found := suite.App.MarketplaceKeeper.GetAuctionReservePrice(suite.Ctx, auction.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(price(2000), *reserve.ReservePrice)
+
+	_, err = suite.msgServer.RevealReservePrice(suite.Ctx,
+		types.NewMsgRevealReservePrice(auction.Id, price(2000), "salt", suite.seller))
+	suite.Require().ErrorIs(err, types.ErrInvalidReservePrice)
+
+	// a committed reserve price below the start price is never met
+	suite.mintNFT("onft3")
+	msg = suite.createAuctionMsg("onft3", 1000)
+	msg.ReservePriceCommitment = types.ReservePriceCommitment(price(500), "salt")
+	resp, err = suite.msgServer.CreateAuction(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RevealReservePrice(suite.Ctx,
+		types.NewMsgRevealReservePrice(resp.Auction.Id, price(500), "salt", suite.seller))
+	suite.Require().ErrorIs(err, types.ErrInvalidReservePrice)
+}
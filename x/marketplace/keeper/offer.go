@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// GetNextOfferNumber get the next offer number
+func (k Keeper) GetNextOfferNumber(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PrefixNextOfferNumber)
+	// offers were added after genesis on existing chains, start from 1
+	if bz == nil {
+		return 1
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetNextOfferNumber set the next offer number
+func (k Keeper) SetNextOfferNumber(ctx sdk.Context, number uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: number})
+	store.Set(types.PrefixNextOfferNumber, bz)
+}
+
+// SetOffer set a specific offer in the store
+func (k Keeper) SetOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&offer)
+	store.Set(types.KeyOfferIdPrefix(offer.Id), bz)
+}
+
+// GetOffer returns an offer by its id
+func (k Keeper) GetOffer(ctx sdk.Context, id uint64) (val types.Offer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyOfferIdPrefix(id))
+	if bz == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+func (k Keeper) HasOffer(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyOfferIdPrefix(id))
+}
+
+// GetAllOffers returns all offers
+func (k Keeper) GetAllOffers(ctx sdk.Context) (list []types.Offer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixOfferId)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Offer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetOfferIndexes sets the bidder, nft and expiration indexes of an offer. Offers are not
+// indexed by owner as the owner of the nft can change while the offer is open.
+func (k Keeper) SetOfferIndexes(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: offer.Id})
+
+	store.Set(types.KeyOfferBidderPrefix(offer.GetBidder(), offer.Id), bz)
+	store.Set(types.KeyOfferNFTPrefix(offer.DenomId, offer.NftId, offer.Id), bz)
+	store.Set(types.KeyOfferExpirationPrefix(offer.Expiration, offer.Id), bz)
+}
+
+// RemoveOffer removes an offer and its indexes from the store
+func (k Keeper) RemoveOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOfferIdPrefix(offer.Id))
+	store.Delete(types.KeyOfferBidderPrefix(offer.GetBidder(), offer.Id))
+	store.Delete(types.KeyOfferNFTPrefix(offer.DenomId, offer.NftId, offer.Id))
+	store.Delete(types.KeyOfferExpirationPrefix(offer.Expiration, offer.Id))
+}
+
+// AddOffer escrows the offer amount from the bidder in the module account
+// and stores the offer
+func (k Keeper) AddOffer(ctx sdk.Context, offer types.Offer) error {
+	if k.HasOffer(ctx, offer.Id) {
+		return errorsmod.Wrapf(types.ErrInvalidOfferId, "offer already exists: %d", offer.Id)
+	}
+	err := k.bankKeeper.SendCoins(ctx, offer.GetBidder(),
+		k.accountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(offer.Amount))
+	if err != nil {
+		return err
+	}
+	k.SetOffer(ctx, offer)
+	k.SetOfferIndexes(ctx, offer)
+	k.SetNextOfferNumber(ctx, offer.Id+1)
+	return nil
+}
+
+// RefundOffer returns the escrowed amount to the bidder and removes the offer
+func (k Keeper) RefundOffer(ctx sdk.Context, offer types.Offer) error {
+	err := k.bankKeeper.SendCoins(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName),
+		offer.GetBidder(), sdk.NewCoins(offer.Amount))
+	if err != nil {
+		return err
+	}
+	k.RemoveOffer(ctx, offer)
+	return nil
+}
+
+// AcceptOffer transfers the nft from the owner to the bidder and settles the
+// escrowed amount the same way as a listing sale
+func (k Keeper) AcceptOffer(ctx sdk.Context, offer types.Offer, owner sdk.AccAddress,
+	splitShares []types.WeightedAddress,
+) error {
+	err := k.nftKeeper.TransferOwnershipWithCause(
+		ctx,
+		offer.GetDenomId(),
+		offer.GetNftId(),
+		owner,
+		offer.GetBidder(),
+		onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_SALE,
+	)
+	if err != nil {
+		return err
+	}
+	err = k.settleSale(ctx, offer.DenomId, offer.NftId, offer.Amount, owner, splitShares)
+	if err != nil {
+		return err
+	}
+	k.RemoveOffer(ctx, offer)
+	return nil
+}
+
+// IterateExpiredOffers iterates over offers expired at given block time
+func (k Keeper) IterateExpiredOffers(ctx sdk.Context, fn func(index int, item types.Offer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(append(types.PrefixOfferExpiration, sdk.FormatTimeBytes(ctx.BlockTime())...))
+	iter := store.Iterator(types.PrefixOfferExpiration, end)
+	defer iter.Close()
+
+	for i := 0; iter.Valid(); iter.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &id)
+		offer, found := k.GetOffer(ctx, id.Value)
+		if !found {
+			continue
+		}
+		if stop := fn(i, offer); stop {
+			break
+		}
+		i++
+	}
+}
+
+// RefundExpiredOffers returns the escrowed amount of all expired offers to their bidders
+func (k Keeper) RefundExpiredOffers(ctx sdk.Context) error {
+	var expired []types.Offer
+	k.IterateExpiredOffers(ctx, func(_ int, offer types.Offer) bool {
+		expired = append(expired, offer)
+		return false
+	})
+	for _, offer := range expired {
+		if err := k.RefundOffer(ctx, offer); err != nil {
+			return err
+		}
+		k.expireOfferEvent(ctx, offer)
+	}
+	return nil
+}
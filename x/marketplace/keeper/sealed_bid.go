@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/types"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSealedBid set a specific sealed bid of an auction in the store
+func (k Keeper) SetSealedBid(ctx sdk.Context, bid types.SealedBid) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bid)
+	store.Set(types.KeySealedBidPrefix(bid.AuctionId, bid.GetBidder()), bz)
+}
+
+// GetSealedBid returns the sealed bid of a bidder on an auction
+func (k Keeper) GetSealedBid(ctx sdk.Context, auctionId uint64, bidder sdk.AccAddress) (val types.SealedBid, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeySealedBidPrefix(auctionId, bidder))
+	if bz == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// RemoveSealedBid removes a sealed bid from the store
+func (k Keeper) RemoveSealedBid(ctx sdk.Context, bid types.SealedBid) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeySealedBidPrefix(bid.AuctionId, bid.GetBidder()))
+}
+
+// HasSealedBids returns true if any sealed bid is committed on the auction
+func (k Keeper) HasSealedBids(ctx sdk.Context, auctionId uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeySealedBidPrefix(auctionId, nil))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// GetSealedBids returns all sealed bids of an auction
+func (k Keeper) GetSealedBids(ctx sdk.Context, auctionId uint64) (list []types.SealedBid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeySealedBidPrefix(auctionId, nil))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SealedBid
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// HasRevealedSealedBid returns true if a sealed bid of the auction was revealed
+func (k Keeper) HasRevealedSealedBid(ctx sdk.Context, auctionId uint64) bool {
+	for _, bid := range k.GetSealedBids(ctx, auctionId) {
+		if bid.Revealed {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAllSealedBids returns all sealed bids
+func (k Keeper) GetAllSealedBids(ctx sdk.Context) (list []types.SealedBid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixSealedBid)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SealedBid
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CommitSealedBid escrows the deposit of a sealed bid in the module account and stores the bid
+func (k Keeper) CommitSealedBid(ctx sdk.Context, bid types.SealedBid) error {
+	if _, found := k.GetSealedBid(ctx, bid.AuctionId, bid.GetBidder()); found {
+		return errorsmod.Wrapf(types.ErrBidExists, "bidder %s already committed a bid on auction %d",
+			bid.Bidder, bid.AuctionId)
+	}
+	err := k.bankKeeper.SendCoins(ctx, bid.GetBidder(),
+		k.accountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(bid.Deposit))
+	if err != nil {
+		return err
+	}
+	k.SetSealedBid(ctx, bid)
+	return nil
+}
+
+// RevealSealedBid checks the revealed amount against the commitment and records it on the bid
+func (k Keeper) RevealSealedBid(ctx sdk.Context, auction types.AuctionListing, bid types.SealedBid,
+	amount sdk.Coin, salt string,
+) (types.SealedBid, error) {
+	if bid.Revealed {
+		return bid, errorsmod.Wrapf(types.ErrInvalidCommitment, "bid on auction %d already revealed", bid.AuctionId)
+	}
+	commitment := types.SealedBidCommitment(bid.AuctionId, bid.GetBidder(), amount, salt)
+	if !bytes.Equal(commitment, bid.Commitment) {
+		return bid, errorsmod.Wrapf(types.ErrInvalidCommitment, "amount and salt do not match the commitment")
+	}
+	if amount.Denom != auction.StartPrice.Denom || amount.IsLT(auction.StartPrice) {
+		return bid, errorsmod.Wrapf(types.ErrBidAmountNotEnough,
+			"revealed amount %s is less than min price %s", amount, auction.StartPrice)
+	}
+	if bid.Deposit.IsLT(amount) {
+		return bid, errorsmod.Wrapf(types.ErrBidAmountNotEnough,
+			"revealed amount %s exceeds deposit %s", amount, bid.Deposit)
+	}
+	bid.Amount = amount
+	bid.Revealed = true
+	k.SetSealedBid(ctx, bid)
+	return bid, nil
+}
+
+// settleSealedBidAuction sells the nft to the highest revealed bid at the first or
+// second price, refunds the other deposits and pays part of the unrevealed ones to
+// the auction owner. The nft is returned to the owner when no bid was revealed.
+func (k Keeper) settleSealedBidAuction(ctx sdk.Context, auction types.AuctionListing) error {
+	moduleAccAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	bids := k.GetSealedBids(ctx, auction.Id)
+
+	var revealed []types.SealedBid
+	for _, bid := range bids {
+		if bid.Revealed {
+			revealed = append(revealed, bid)
+		}
+	}
+	// highest amount wins, ties go to the earliest commit
+	sort.SliceStable(revealed, func(i, j int) bool {
+		if !revealed[i].Amount.Amount.Equal(revealed[j].Amount.Amount) {
+			return revealed[i].Amount.Amount.GT(revealed[j].Amount.Amount)
+		}
+		return revealed[i].Time.Before(revealed[j].Time)
+	})
+
+	var winner *types.SealedBid
+	var price sdk.Coin
+	if len(revealed) > 0 {
+		winner = &revealed[0]
+		price = winner.Amount
+		if auction.SealedBidAuction.SettlementType == types.SETTLEMENT_TYPE_SECOND_PRICE {
+			price = auction.StartPrice
+			if len(revealed) > 1 {
+				price = revealed[1].Amount
+			}
+		}
+	}
+
+	for _, bid := range bids {
+		refund := bid.Deposit
+		switch {
+		case winner != nil && bid.Bidder == winner.Bidder:
+			refund = refund.Sub(price)
+		case !bid.Revealed:
+			forfeit := sdk.NewCoin(bid.Deposit.Denom,
+				auction.SealedBidAuction.ForfeitPercentage.MulInt(bid.Deposit.Amount).TruncateInt())
+			// the forfeit compensates the owner for a bid that may have been the highest one
+			if forfeit.IsPositive() {
+				err := k.bankKeeper.SendCoins(ctx, moduleAccAddr, auction.GetOwner(), sdk.NewCoins(forfeit))
+				if err != nil {
+					return err
+				}
+				k.forfeitSealedBidEvent(ctx, bid, auction.GetOwner(), forfeit)
+			}
+			refund = refund.Sub(forfeit)
+		}
+		if refund.IsPositive() {
+			err := k.bankKeeper.SendCoins(ctx, moduleAccAddr, bid.GetBidder(), sdk.NewCoins(refund))
+			if err != nil {
+				return err
+			}
+		}
+		k.RemoveSealedBid(ctx, bid)
+	}
+
+	if winner != nil {
+		err := k.nftKeeper.TransferOwnershipWithCause(
+			ctx,
+			auction.GetDenomId(),
+			auction.GetNftId(),
+			moduleAccAddr,
+			winner.GetBidder(),
+			onfttypes.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_AUCTION,
+		)
+		if err != nil {
+			return err
+		}
+		err = k.settleSale(ctx, auction.DenomId, auction.NftId, price, auction.GetOwner(), auction.SplitShares)
+		if err != nil {
+			return err
+		}
+		k.settleSealedBidAuctionEvent(ctx, auction, winner.GetBidder(), price)
+	} else {
+		err := k.returnNftToOwner(ctx, auction.GetDenomId(), auction.GetNftId(), moduleAccAddr, auction.GetOwner())
+		if err != nil {
+			return err
+		}
+		k.removeAuctionEvent(ctx, auction)
+	}
+
+	k.RemoveAuctionListing(ctx, auction.GetId())
+	k.UnsetAuctionListingWithOwner(ctx, auction.GetOwner(), auction.GetId())
+	k.UnsetAuctionListingWithNFTID(ctx, auction.GetNftId())
+	k.UnsetAuctionListingWithPriceDenom(ctx, auction.StartPrice.Denom, auction.GetId())
+	return nil
+}
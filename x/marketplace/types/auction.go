@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -12,6 +14,7 @@ import (
 
 var (
 	_ proto.Message            = &AuctionListing{}
+	_ proto.Message            = &SealedBid{}
 	_ exported.AuctionListingI = &AuctionListing{}
 )
 
@@ -32,6 +35,52 @@ func NewAuctionListing(id uint64, nftId, denomId string, startTime time.Time, en
 	}
 }
 
+func NewDutchAuctionListing(id uint64, nftId, denomId string, startTime, endTime time.Time, startPrice sdk.Coin,
+	dutchAuction DutchAuction, owner sdk.AccAddress, whitelistAccounts []string, splitShares []WeightedAddress,
+) AuctionListing {
+	auction := NewAuctionListing(id, nftId, denomId, startTime, &endTime, startPrice, sdkmath.LegacyZeroDec(),
+		owner, whitelistAccounts, splitShares)
+	auction.AuctionType = AUCTION_TYPE_DUTCH
+	auction.DutchAuction = &dutchAuction
+	return auction
+}
+
+func NewDutchAuction(floorPrice sdk.Coin, decayType DecayType, stepInterval time.Duration) DutchAuction {
+	return DutchAuction{
+		FloorPrice:   floorPrice,
+		DecayType:    decayType,
+		StepInterval: stepInterval,
+	}
+}
+
+func NewSoftClose(window, extension, maxExtension time.Duration) SoftClose {
+	return SoftClose{
+		Window:       window,
+		Extension:    extension,
+		MaxExtension: maxExtension,
+	}
+}
+
+func NewSealedBidAuctionListing(id uint64, nftId, denomId string, startTime, endTime time.Time, minPrice sdk.Coin,
+	sealedBidAuction SealedBidAuction, owner sdk.AccAddress, whitelistAccounts []string, splitShares []WeightedAddress,
+) AuctionListing {
+	auction := NewAuctionListing(id, nftId, denomId, startTime, &endTime, minPrice, sdkmath.LegacyZeroDec(),
+		owner, whitelistAccounts, splitShares)
+	auction.AuctionType = AUCTION_TYPE_SEALED_BID
+	auction.SealedBidAuction = &sealedBidAuction
+	return auction
+}
+
+func NewSealedBidAuction(revealEndTime time.Time, settlementType SettlementType,
+	forfeitPercentage sdkmath.LegacyDec,
+) SealedBidAuction {
+	return SealedBidAuction{
+		RevealEndTime:     revealEndTime,
+		SettlementType:    settlementType,
+		ForfeitPercentage: forfeitPercentage,
+	}
+}
+
 func (al AuctionListing) GetId() uint64 {
 	return al.Id
 }
@@ -65,6 +114,112 @@ func (al AuctionListing) GetSplitShares() interface{} {
 	return al.SplitShares
 }
 
+// IsDutch returns true if the auction is sold at a declining price
+func (al AuctionListing) IsDutch() bool {
+	return al.AuctionType == AUCTION_TYPE_DUTCH
+}
+
+// DutchPrice returns the price of a dutch auction at given time, the price falls
+// from the start price at the start time to the floor price at the end time.
+// Amounts are rounded up so the price never drops below the schedule.
+func (al AuctionListing) DutchPrice(now time.Time) sdk.Coin {
+	if al.DutchAuction == nil || al.EndTime == nil || !now.After(al.StartTime) {
+		return al.StartPrice
+	}
+	floor := al.DutchAuction.FloorPrice
+	if !now.Before(*al.EndTime) {
+		return floor
+	}
+	total := int64(al.EndTime.Sub(al.StartTime))
+	elapsed := int64(now.Sub(al.StartTime))
+	if al.DutchAuction.DecayType == DECAY_TYPE_STEPPED {
+		interval := int64(al.DutchAuction.StepInterval)
+		if interval <= 0 {
+			return al.StartPrice
+		}
+		// the price drops at whole intervals by the linear price of the time elapsed, so
+		// a duration that isn't a multiple of the interval reaches the floor at the end time
+		elapsed -= elapsed % interval
+	}
+	drop := al.StartPrice.Amount.Sub(floor.Amount).Mul(sdkmath.NewInt(elapsed)).Quo(sdkmath.NewInt(total))
+	return sdk.NewCoin(al.StartPrice.Denom, al.StartPrice.Amount.Sub(drop))
+}
+
+// NextDutchPriceAt returns the time of the next price drop of a stepped dutch
+// auction, nil when the price falls continuously or already reached the floor.
+// The last drop to the floor price happens at the end time.
+func (al AuctionListing) NextDutchPriceAt(now time.Time) *time.Time {
+	if al.DutchAuction == nil || al.EndTime == nil || al.DutchAuction.DecayType != DECAY_TYPE_STEPPED {
+		return nil
+	}
+	interval := al.DutchAuction.StepInterval
+	if interval <= 0 || !now.Before(*al.EndTime) {
+		return nil
+	}
+	steps := int64(1)
+	if now.After(al.StartTime) {
+		steps = int64(now.Sub(al.StartTime)/interval) + 1
+	}
+	next := al.StartTime.Add(time.Duration(steps) * interval)
+	if al.EndTime.Before(next) {
+		next = *al.EndTime
+	}
+	return &next
+}
+
+// SoftCloseExtension returns how far a bid placed at given time pushes out the end
+// time, zero when the bid is outside the soft close window or the cap is reached
+func (al AuctionListing) SoftCloseExtension(now time.Time) time.Duration {
+	if al.SoftClose == nil || al.EndTime == nil || al.EndTime.Sub(now) > al.SoftClose.Window {
+		return 0
+	}
+	remaining := al.SoftClose.MaxExtension - al.SoftClose.Extended
+	if remaining < al.SoftClose.Extension {
+		return remaining
+	}
+	return al.SoftClose.Extension
+}
+
+// IsSealedBid returns true if the auction accepts hashed bids revealed after the end time
+func (al AuctionListing) IsSealedBid() bool {
+	return al.AuctionType == AUCTION_TYPE_SEALED_BID
+}
+
+func NewSealedBid(auctionId uint64, commitment []byte, deposit sdk.Coin, bidTime time.Time,
+	bidder sdk.AccAddress,
+) SealedBid {
+	return SealedBid{
+		AuctionId:  auctionId,
+		Bidder:     bidder.String(),
+		Commitment: commitment,
+		Deposit:    deposit,
+		Time:       bidTime,
+	}
+}
+
+func (b SealedBid) GetBidder() sdk.AccAddress {
+	bidder, _ := sdk.AccAddressFromBech32(b.Bidder)
+	return bidder
+}
+
+// SealedBidCommitment returns the commitment of a sealed bid, the bidder is part of
+// the hash so a commitment can't be copied by another account
+func SealedBidCommitment(auctionId uint64, bidder sdk.AccAddress, amount sdk.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s/%s", auctionId, bidder.String(), amount.String(), salt)))
+	return hash[:]
+}
+
+// ReservePriceCommitment returns the commitment of a hidden reserve price
+func ReservePriceCommitment(reservePrice sdk.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", reservePrice.String(), salt)))
+	return hash[:]
+}
+
+// IsMet returns true if the reserve price is revealed and the amount is not below it
+func (rp AuctionReservePrice) IsMet(amount sdk.Coin) bool {
+	return rp.ReservePrice != nil && !amount.IsLT(*rp.ReservePrice)
+}
+
 func ValidAuctionStatus(status AuctionStatus) bool {
 	if status == AUCTION_STATUS_INACTIVE ||
 		status == AUCTION_STATUS_ACTIVE {
@@ -9,7 +9,8 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
-	github_com_gogo_protobuf_types "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +30,66 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type AuctionType int32
+
+const (
+	// english auctions accept increasing bids until the end time
+	AUCTION_TYPE_ENGLISH AuctionType = 0
+	// dutch auctions are sold to the first buyer at a price falling from the
+	// start price to the floor price
+	AUCTION_TYPE_DUTCH AuctionType = 1
+	// sealed bid auctions accept hashed bids until the end time, bids are
+	// revealed until the reveal end time and settled in end block
+	AUCTION_TYPE_SEALED_BID AuctionType = 2
+)
+
+var AuctionType_name = map[int32]string{
+	0: "AUCTION_TYPE_ENGLISH",
+	1: "AUCTION_TYPE_DUTCH",
+	2: "AUCTION_TYPE_SEALED_BID",
+}
+
+var AuctionType_value = map[string]int32{
+	"AUCTION_TYPE_ENGLISH":    0,
+	"AUCTION_TYPE_DUTCH":      1,
+	"AUCTION_TYPE_SEALED_BID": 2,
+}
+
+func (x AuctionType) String() string {
+	return proto.EnumName(AuctionType_name, int32(x))
+}
+
+func (AuctionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{0}
+}
+
+type DecayType int32
+
+const (
+	// price falls continuously from the start time to the end time
+	DECAY_TYPE_LINEAR DecayType = 0
+	// price falls once every step interval
+	DECAY_TYPE_STEPPED DecayType = 1
+)
+
+var DecayType_name = map[int32]string{
+	0: "DECAY_TYPE_LINEAR",
+	1: "DECAY_TYPE_STEPPED",
+}
+
+var DecayType_value = map[string]int32{
+	"DECAY_TYPE_LINEAR":  0,
+	"DECAY_TYPE_STEPPED": 1,
+}
+
+func (x DecayType) String() string {
+	return proto.EnumName(DecayType_name, int32(x))
+}
+
+func (DecayType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{1}
+}
+
 type AuctionStatus int32
 
 const (
@@ -54,7 +115,34 @@ func (x AuctionStatus) String() string {
 }
 
 func (AuctionStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b7c419bfddde4ca0, []int{0}
+	return fileDescriptor_b7c419bfddde4ca0, []int{2}
+}
+
+type SettlementType int32
+
+const (
+	// highest bidder pays its own bid
+	SETTLEMENT_TYPE_FIRST_PRICE SettlementType = 0
+	// highest bidder pays the second highest bid (vickrey auction)
+	SETTLEMENT_TYPE_SECOND_PRICE SettlementType = 1
+)
+
+var SettlementType_name = map[int32]string{
+	0: "SETTLEMENT_TYPE_FIRST_PRICE",
+	1: "SETTLEMENT_TYPE_SECOND_PRICE",
+}
+
+var SettlementType_value = map[string]int32{
+	"SETTLEMENT_TYPE_FIRST_PRICE":  0,
+	"SETTLEMENT_TYPE_SECOND_PRICE": 1,
+}
+
+func (x SettlementType) String() string {
+	return proto.EnumName(SettlementType_name, int32(x))
+}
+
+func (SettlementType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{3}
 }
 
 type AuctionListing struct {
@@ -68,6 +156,15 @@ type AuctionListing struct {
 	IncrementPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=increment_percentage,json=incrementPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"increment_percentage" yaml:"increment_percentage"`
 	WhitelistAccounts   []string                    `protobuf:"bytes,9,rep,name=whitelist_accounts,json=whitelistAccounts,proto3" json:"whitelist_accounts,omitempty" yaml:"whitelist_accounts"`
 	SplitShares         []WeightedAddress           `protobuf:"bytes,10,rep,name=split_shares,json=splitShares,proto3" json:"split_shares" yaml:"split_shares"`
+	AuctionType         AuctionType                 `protobuf:"varint,11,opt,name=auction_type,json=auctionType,proto3,enum=OmniFlix.marketplace.v1beta1.AuctionType" json:"auction_type,omitempty" yaml:"auction_type"`
+	// dutch_auction is set only for dutch auctions
+	DutchAuction *DutchAuction `protobuf:"bytes,12,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction,omitempty" yaml:"dutch_auction"`
+	// sealed_bid_auction is set only for sealed bid auctions
+	SealedBidAuction *SealedBidAuction `protobuf:"bytes,13,opt,name=sealed_bid_auction,json=sealedBidAuction,proto3" json:"sealed_bid_auction,omitempty" yaml:"sealed_bid_auction"`
+	// soft_close extends the end time of an english auction on late bids
+	SoftClose *SoftClose `protobuf:"bytes,14,opt,name=soft_close,json=softClose,proto3" json:"soft_close,omitempty" yaml:"soft_close"`
+	// has_reserve_price is true when the auction has a reserve price
+	HasReservePrice bool `protobuf:"varint,15,opt,name=has_reserve_price,json=hasReservePrice,proto3" json:"has_reserve_price,omitempty" yaml:"has_reserve_price"`
 }
 
 func (m *AuctionListing) Reset()         { *m = AuctionListing{} }
@@ -103,6 +200,220 @@ func (m *AuctionListing) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuctionListing proto.InternalMessageInfo
 
+type DutchAuction struct {
+	FloorPrice   types.Coin    `protobuf:"bytes,1,opt,name=floor_price,json=floorPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"floor_price" yaml:"floor_price"`
+	DecayType    DecayType     `protobuf:"varint,2,opt,name=decay_type,json=decayType,proto3,enum=OmniFlix.marketplace.v1beta1.DecayType" json:"decay_type,omitempty" yaml:"decay_type"`
+	StepInterval time.Duration `protobuf:"bytes,3,opt,name=step_interval,json=stepInterval,proto3,stdduration" json:"step_interval" yaml:"step_interval"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{1}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
+// SoftClose pushes the end time of an auction out by extension when a bid is
+// placed within window of the end time, until max_extension is reached
+type SoftClose struct {
+	Window       time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	Extension    time.Duration `protobuf:"bytes,2,opt,name=extension,proto3,stdduration" json:"extension"`
+	MaxExtension time.Duration `protobuf:"bytes,3,opt,name=max_extension,json=maxExtension,proto3,stdduration" json:"max_extension" yaml:"max_extension"`
+	// extended is the total extension applied by bids so far
+	Extended time.Duration `protobuf:"bytes,4,opt,name=extended,proto3,stdduration" json:"extended"`
+}
+
+func (m *SoftClose) Reset()         { *m = SoftClose{} }
+func (m *SoftClose) String() string { return proto.CompactTextString(m) }
+func (*SoftClose) ProtoMessage()    {}
+func (*SoftClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{2}
+}
+func (m *SoftClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SoftClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SoftClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SoftClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftClose.Merge(m, src)
+}
+func (m *SoftClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *SoftClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftClose proto.InternalMessageInfo
+
+// AuctionReservePrice is the hidden reserve price of an auction, it is stored apart
+// from the auction listing. Only the commitment is known until the owner reveals
+// the reserve price, an unrevealed reserve price is never met
+type AuctionReservePrice struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+	// commitment is the sha256 hash of the reserve price and salt
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// reserve_price is set when the owner reveals it
+	ReservePrice *types.Coin `protobuf:"bytes,3,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty" yaml:"reserve_price"`
+}
+
+func (m *AuctionReservePrice) Reset()         { *m = AuctionReservePrice{} }
+func (m *AuctionReservePrice) String() string { return proto.CompactTextString(m) }
+func (*AuctionReservePrice) ProtoMessage()    {}
+func (*AuctionReservePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{3}
+}
+func (m *AuctionReservePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionReservePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionReservePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionReservePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionReservePrice.Merge(m, src)
+}
+func (m *AuctionReservePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionReservePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionReservePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionReservePrice proto.InternalMessageInfo
+
+type SealedBidAuction struct {
+	RevealEndTime  time.Time      `protobuf:"bytes,1,opt,name=reveal_end_time,json=revealEndTime,proto3,stdtime" json:"reveal_end_time" yaml:"reveal_end_time"`
+	SettlementType SettlementType `protobuf:"varint,2,opt,name=settlement_type,json=settlementType,proto3,enum=OmniFlix.marketplace.v1beta1.SettlementType" json:"settlement_type,omitempty" yaml:"settlement_type"`
+	// forfeit_percentage of the deposit of an unrevealed bid is paid to the auction
+	// owner, the rest is refunded
+	ForfeitPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=forfeit_percentage,json=forfeitPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"forfeit_percentage" yaml:"forfeit_percentage"`
+}
+
+func (m *SealedBidAuction) Reset()         { *m = SealedBidAuction{} }
+func (m *SealedBidAuction) String() string { return proto.CompactTextString(m) }
+func (*SealedBidAuction) ProtoMessage()    {}
+func (*SealedBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{4}
+}
+func (m *SealedBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidAuction.Merge(m, src)
+}
+func (m *SealedBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidAuction proto.InternalMessageInfo
+
+// SealedBid is a hashed bid on a sealed bid auction, the deposit is escrowed
+// in the marketplace module account until the auction is settled
+type SealedBid struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+	Bidder    string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment is the sha256 hash of the auction id, bidder, amount and salt
+	Commitment []byte     `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Deposit    types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit"`
+	// amount is set once the bid is revealed
+	Amount   types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	Revealed bool       `protobuf:"varint,6,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	Time     time.Time  `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *SealedBid) Reset()         { *m = SealedBid{} }
+func (m *SealedBid) String() string { return proto.CompactTextString(m) }
+func (*SealedBid) ProtoMessage()    {}
+func (*SealedBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7c419bfddde4ca0, []int{5}
+}
+func (m *SealedBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBid.Merge(m, src)
+}
+func (m *SealedBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBid proto.InternalMessageInfo
+
 type Bid struct {
 	AuctionId uint64     `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
 	Bidder    string     `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
@@ -114,7 +425,7 @@ func (m *Bid) Reset()         { *m = Bid{} }
 func (m *Bid) String() string { return proto.CompactTextString(m) }
 func (*Bid) ProtoMessage()    {}
 func (*Bid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7c419bfddde4ca0, []int{1}
+	return fileDescriptor_b7c419bfddde4ca0, []int{6}
 }
 func (m *Bid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,8 +455,16 @@ func (m *Bid) XXX_DiscardUnknown() {
 var xxx_messageInfo_Bid proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("OmniFlix.marketplace.v1beta1.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterEnum("OmniFlix.marketplace.v1beta1.DecayType", DecayType_name, DecayType_value)
 	proto.RegisterEnum("OmniFlix.marketplace.v1beta1.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
+	proto.RegisterEnum("OmniFlix.marketplace.v1beta1.SettlementType", SettlementType_name, SettlementType_value)
 	proto.RegisterType((*AuctionListing)(nil), "OmniFlix.marketplace.v1beta1.AuctionListing")
+	proto.RegisterType((*DutchAuction)(nil), "OmniFlix.marketplace.v1beta1.DutchAuction")
+	proto.RegisterType((*SoftClose)(nil), "OmniFlix.marketplace.v1beta1.SoftClose")
+	proto.RegisterType((*AuctionReservePrice)(nil), "OmniFlix.marketplace.v1beta1.AuctionReservePrice")
+	proto.RegisterType((*SealedBidAuction)(nil), "OmniFlix.marketplace.v1beta1.SealedBidAuction")
+	proto.RegisterType((*SealedBid)(nil), "OmniFlix.marketplace.v1beta1.SealedBid")
 	proto.RegisterType((*Bid)(nil), "OmniFlix.marketplace.v1beta1.Bid")
 }
 
@@ -154,56 +473,98 @@ func init() {
 }
 
 var fileDescriptor_b7c419bfddde4ca0 = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x3d, 0x8f, 0xdb, 0x46,
-	0x10, 0x15, 0xf5, 0x75, 0xd2, 0x2a, 0x76, 0x7c, 0x7b, 0xe7, 0x98, 0x27, 0x25, 0xa4, 0xc2, 0x26,
-	0x82, 0x01, 0x93, 0xb0, 0xf3, 0x09, 0x77, 0xa2, 0x2c, 0x03, 0x04, 0x0c, 0x59, 0xa0, 0x74, 0x89,
-	0x91, 0x86, 0xa0, 0xb8, 0x2b, 0x6a, 0x71, 0x22, 0x57, 0xe0, 0xae, 0x6c, 0x5f, 0x99, 0x7f, 0xe0,
-	0x9f, 0x90, 0x3a, 0xbf, 0xe4, 0x8a, 0x14, 0x57, 0x06, 0x29, 0xe4, 0xf8, 0xae, 0x49, 0xad, 0x22,
-	0x75, 0xc0, 0xdd, 0xd5, 0x47, 0x1c, 0xe3, 0x5c, 0x71, 0x67, 0xe6, 0xbd, 0xc7, 0xe1, 0xe3, 0xcc,
-	0x82, 0xfb, 0xcf, 0x93, 0x94, 0x3c, 0x9d, 0x93, 0xd7, 0x4e, 0x12, 0x66, 0x67, 0x98, 0x2f, 0xe6,
-	0x61, 0x84, 0x9d, 0x97, 0x0f, 0x27, 0x98, 0x87, 0x0f, 0x9d, 0x70, 0x19, 0x71, 0x42, 0x53, 0x7b,
-	0x91, 0x51, 0x4e, 0xe1, 0xe7, 0x1b, 0xac, 0xbd, 0x87, 0xb5, 0x15, 0xb6, 0x69, 0x44, 0x94, 0x25,
-	0x94, 0x39, 0x93, 0x90, 0xed, 0x04, 0x22, 0x4a, 0x14, 0xbb, 0x79, 0x1c, 0xd3, 0x98, 0x8a, 0xa3,
-	0x93, 0x9f, 0x54, 0xd6, 0x8c, 0x29, 0x8d, 0xe7, 0xd8, 0x11, 0xd1, 0x64, 0x39, 0x75, 0x38, 0x49,
-	0x30, 0xe3, 0x61, 0xb2, 0x50, 0x80, 0x9b, 0x1b, 0x9c, 0x13, 0xc6, 0x49, 0x1a, 0x4b, 0xac, 0xf5,
-	0x4f, 0x05, 0xdc, 0xee, 0xca, 0x96, 0x9f, 0xc9, 0x02, 0xbc, 0x0d, 0x8a, 0x04, 0xe9, 0x5a, 0x5b,
-	0xeb, 0x94, 0xfd, 0x22, 0x41, 0xb0, 0x03, 0xaa, 0xe9, 0x94, 0x07, 0x04, 0xe9, 0xc5, 0xb6, 0xd6,
-	0xa9, 0xbb, 0x87, 0xeb, 0x95, 0x79, 0xeb, 0x3c, 0x4c, 0xe6, 0x8f, 0x2d, 0x99, 0xb7, 0xfc, 0x4a,
-	0x3a, 0xe5, 0x1e, 0x82, 0x36, 0xa8, 0x21, 0x9c, 0xd2, 0x24, 0xc7, 0x96, 0x04, 0xf6, 0x68, 0xbd,
-	0x32, 0x3f, 0x95, 0xd8, 0x4d, 0xc5, 0xf2, 0x0f, 0xc4, 0xd1, 0x43, 0xf0, 0x17, 0x0d, 0x34, 0x18,
-	0x0f, 0x33, 0x1e, 0x2c, 0x32, 0x12, 0x61, 0xbd, 0xdc, 0xd6, 0x3a, 0x8d, 0x47, 0x27, 0xb6, 0xb4,
-	0xc5, 0xce, 0x6d, 0xd9, 0x78, 0x65, 0xf7, 0x28, 0x49, 0xdd, 0xfe, 0xc5, 0xca, 0x2c, 0xac, 0x57,
-	0x26, 0x94, 0x92, 0x7b, 0x5c, 0xeb, 0xb7, 0xb7, 0xe6, 0x57, 0x31, 0xe1, 0xb3, 0xe5, 0xc4, 0x8e,
-	0x68, 0xe2, 0x28, 0x67, 0xe5, 0xe3, 0x01, 0x43, 0x67, 0x0e, 0x3f, 0x5f, 0x60, 0x26, 0x64, 0x7c,
-	0x20, 0x88, 0xc3, 0x9c, 0x07, 0x5f, 0x00, 0x19, 0x05, 0xb9, 0x8b, 0x7a, 0x45, 0x74, 0xd0, 0xb4,
-	0xa5, 0xc5, 0xf6, 0xc6, 0x62, 0x7b, 0xbc, 0xb1, 0xd8, 0xfd, 0x42, 0xb5, 0x70, 0xb8, 0xdf, 0x42,
-	0xce, 0xb5, 0xde, 0xbc, 0x35, 0x35, 0xbf, 0x2e, 0x12, 0x39, 0x1c, 0x0e, 0x40, 0x0d, 0xa7, 0x48,
-	0xea, 0x56, 0x3f, 0xaa, 0x7b, 0x6f, 0xe7, 0xd4, 0x86, 0x25, 0x15, 0x0f, 0x70, 0x8a, 0x84, 0xde,
-	0x31, 0xa8, 0xd0, 0x57, 0x29, 0xce, 0xf4, 0x83, 0xdc, 0x5a, 0x5f, 0x06, 0x70, 0x09, 0x8e, 0x49,
-	0x1a, 0x65, 0x38, 0xc1, 0x29, 0x0f, 0x16, 0x38, 0x8b, 0x70, 0xca, 0xc3, 0x18, 0xeb, 0x35, 0xe1,
-	0xbf, 0x9b, 0x77, 0xfb, 0xe7, 0xca, 0x6c, 0x49, 0x23, 0x18, 0x3a, 0xb3, 0x09, 0x75, 0x92, 0x90,
-	0xcf, 0xec, 0x67, 0x38, 0x0e, 0xa3, 0xf3, 0x27, 0x38, 0x5a, 0xaf, 0xcc, 0x96, 0x7c, 0xf1, 0x87,
-	0x84, 0x2c, 0xff, 0x68, 0x9b, 0x1e, 0x6e, 0xb3, 0x70, 0x08, 0xe0, 0xab, 0x19, 0xe1, 0x38, 0x9f,
-	0xa6, 0x20, 0x8c, 0x22, 0xba, 0x4c, 0x39, 0xd3, 0xeb, 0xed, 0x52, 0xa7, 0xee, 0x7e, 0xa9, 0x2c,
-	0x3a, 0x91, 0xaa, 0xff, 0xc7, 0x59, 0xfe, 0xe1, 0x36, 0xd9, 0x55, 0x39, 0x98, 0x80, 0x4f, 0xd8,
-	0x62, 0x4e, 0x78, 0xc0, 0x66, 0x61, 0x86, 0x99, 0x0e, 0xda, 0xa5, 0x4e, 0xe3, 0xd1, 0x03, 0xfb,
-	0xa6, 0x0d, 0xb2, 0x7f, 0xc2, 0x24, 0x9e, 0x71, 0x8c, 0xba, 0x08, 0x65, 0x98, 0x31, 0xb7, 0xa5,
-	0x5e, 0x7d, 0xa4, 0xfe, 0xce, 0x9e, 0xa0, 0xe5, 0x37, 0x44, 0x38, 0x92, 0xd1, 0xef, 0x1a, 0x28,
-	0xb9, 0x04, 0xc1, 0x6f, 0x00, 0x50, 0x2b, 0x1b, 0x6c, 0xa6, 0xde, 0xbd, 0xbb, 0xfb, 0xbf, 0xbb,
-	0x9a, 0xe5, 0xd7, 0x55, 0xe0, 0x21, 0xf8, 0x19, 0xa8, 0x4e, 0x08, 0x42, 0x38, 0x93, 0x3b, 0xe1,
-	0xab, 0x08, 0x7e, 0x0f, 0xaa, 0x61, 0x92, 0x7f, 0x8f, 0x98, 0xff, 0x1b, 0x67, 0xb9, 0x9c, 0xb7,
-	0xea, 0x2b, 0x38, 0xfc, 0x01, 0x94, 0xc5, 0xa0, 0x94, 0x3f, 0x3a, 0x28, 0xb5, 0x9c, 0x27, 0x26,
-	0x43, 0x30, 0x1e, 0x97, 0xff, 0xfe, 0xd5, 0xd4, 0xee, 0xc7, 0xe0, 0x96, 0x5a, 0xe3, 0x11, 0x0f,
-	0xf9, 0x92, 0x41, 0x03, 0x34, 0xbb, 0xa7, 0xbd, 0xb1, 0xf7, 0x7c, 0x10, 0x8c, 0xc6, 0xdd, 0xf1,
-	0xe9, 0x28, 0x38, 0x1d, 0x8c, 0x86, 0xfd, 0x9e, 0xf7, 0xd4, 0xeb, 0x3f, 0xb9, 0x53, 0x80, 0x2d,
-	0x70, 0xef, 0xbd, 0xba, 0x37, 0xe8, 0xf6, 0xc6, 0xde, 0x8f, 0xfd, 0x3b, 0x1a, 0x3c, 0x01, 0x77,
-	0xdf, 0x2b, 0xaa, 0x52, 0xd1, 0x7d, 0x71, 0xf1, 0xce, 0x28, 0x5c, 0xbe, 0x33, 0x0a, 0x17, 0x57,
-	0x86, 0x76, 0x79, 0x65, 0x68, 0x7f, 0x5d, 0x19, 0xda, 0x9b, 0x6b, 0xa3, 0x70, 0x79, 0x6d, 0x14,
-	0xfe, 0xb8, 0x36, 0x0a, 0x3f, 0x7f, 0xb7, 0xb7, 0x86, 0xdb, 0x9b, 0x88, 0x26, 0x29, 0x99, 0xce,
-	0xc9, 0xeb, 0xd9, 0x72, 0xe2, 0xbc, 0xfc, 0xd6, 0xf9, 0xef, 0xd5, 0x24, 0x56, 0x73, 0x52, 0x15,
-	0x1f, 0xfb, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x24, 0xd6, 0xf8, 0x59, 0x60, 0x05, 0x00,
-	0x00,
+	// 1450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x93, 0xfd, 0x48, 0x66, 0xb3, 0xbb, 0xd9, 0xd9, 0x6d, 0xeb, 0x66, 0xdb, 0x24, 0xf8,
+	0xd2, 0xb0, 0xa2, 0x8e, 0x5a, 0x50, 0x81, 0x72, 0x80, 0x7c, 0xb8, 0x34, 0xd2, 0x92, 0x46, 0x4e,
+	0x16, 0x5a, 0x44, 0x65, 0x1c, 0x7b, 0x92, 0x8c, 0x1a, 0xdb, 0xc1, 0x33, 0xd9, 0x0f, 0x71, 0xe2,
+	0x3f, 0xe8, 0x91, 0x23, 0x67, 0x0e, 0xfc, 0x13, 0x08, 0xa9, 0x07, 0x0e, 0x95, 0xb8, 0x20, 0x0e,
+	0x29, 0x6d, 0x2f, 0x9c, 0x38, 0xec, 0x5f, 0x80, 0x3c, 0x33, 0x4e, 0x9c, 0x2c, 0xda, 0x74, 0x11,
+	0xa7, 0x78, 0xde, 0xfc, 0xde, 0xef, 0xcd, 0x7b, 0xf3, 0x3e, 0x26, 0x60, 0xef, 0x81, 0xe3, 0xe2,
+	0x7b, 0x03, 0x7c, 0x5c, 0x72, 0x4c, 0xff, 0x09, 0xa2, 0xc3, 0x81, 0x69, 0xa1, 0xd2, 0xe1, 0xad,
+	0x0e, 0xa2, 0xe6, 0xad, 0x92, 0x39, 0xb2, 0x28, 0xf6, 0x5c, 0x75, 0xe8, 0x7b, 0xd4, 0x83, 0xd7,
+	0x42, 0xac, 0x1a, 0xc1, 0xaa, 0x02, 0x9b, 0xcd, 0x59, 0x1e, 0x71, 0x3c, 0x52, 0xea, 0x98, 0x64,
+	0x4a, 0x60, 0x79, 0x58, 0x68, 0x67, 0x77, 0x7a, 0x5e, 0xcf, 0x63, 0x9f, 0xa5, 0xe0, 0x4b, 0x48,
+	0x73, 0x3d, 0xcf, 0xeb, 0x0d, 0x50, 0x89, 0xad, 0x3a, 0xa3, 0x6e, 0xc9, 0x1e, 0xf9, 0xe6, 0xd4,
+	0x66, 0x36, 0x3f, 0xbf, 0x4f, 0xb1, 0x83, 0x08, 0x35, 0x9d, 0xa1, 0x00, 0x9c, 0xef, 0xc0, 0x00,
+	0x13, 0x8a, 0xdd, 0x1e, 0xc7, 0x2a, 0x7f, 0xa7, 0xc0, 0x46, 0x99, 0xbb, 0xb4, 0xcf, 0x37, 0xe0,
+	0x06, 0x88, 0x63, 0x5b, 0x96, 0x0a, 0x52, 0x71, 0x49, 0x8f, 0x63, 0x1b, 0x16, 0xc1, 0x8a, 0xdb,
+	0xa5, 0x06, 0xb6, 0xe5, 0x78, 0x41, 0x2a, 0xa6, 0x2a, 0x5b, 0xa7, 0xe3, 0xfc, 0xfa, 0x89, 0xe9,
+	0x0c, 0xee, 0x2a, 0x5c, 0xae, 0xe8, 0xcb, 0x6e, 0x97, 0xd6, 0x6d, 0xa8, 0x82, 0xa4, 0x8d, 0x5c,
+	0xcf, 0x09, 0xb0, 0x09, 0x86, 0xdd, 0x3e, 0x1d, 0xe7, 0x37, 0x39, 0x36, 0xdc, 0x51, 0xf4, 0x55,
+	0xf6, 0x59, 0xb7, 0xe1, 0x77, 0x12, 0x58, 0x23, 0xd4, 0xf4, 0xa9, 0x31, 0xf4, 0xb1, 0x85, 0xe4,
+	0xa5, 0x82, 0x54, 0x5c, 0xbb, 0x7d, 0x55, 0xe5, 0x61, 0x53, 0x83, 0xb0, 0x85, 0xb1, 0x54, 0xab,
+	0x1e, 0x76, 0x2b, 0xda, 0xb3, 0x71, 0x3e, 0x76, 0x3a, 0xce, 0x43, 0x4e, 0x19, 0xd1, 0x55, 0x7e,
+	0x7c, 0x91, 0xbf, 0xd1, 0xc3, 0xb4, 0x3f, 0xea, 0xa8, 0x96, 0xe7, 0x94, 0x44, 0xe4, 0xf9, 0xcf,
+	0x4d, 0x62, 0x3f, 0x29, 0xd1, 0x93, 0x21, 0x22, 0x8c, 0x46, 0x07, 0x4c, 0xb1, 0x19, 0xe8, 0xc1,
+	0x87, 0x80, 0xaf, 0x8c, 0x20, 0x8a, 0xf2, 0x32, 0x3b, 0x41, 0x56, 0xe5, 0x21, 0x56, 0xc3, 0x10,
+	0xab, 0xed, 0x30, 0xc4, 0x95, 0xeb, 0xe2, 0x08, 0x5b, 0xd1, 0x23, 0x04, 0xba, 0xca, 0xd3, 0x17,
+	0x79, 0x49, 0x4f, 0x31, 0x41, 0x00, 0x87, 0x0d, 0x90, 0x44, 0xae, 0xcd, 0x79, 0x57, 0x16, 0xf2,
+	0x5e, 0x99, 0x46, 0x2a, 0xd4, 0xe2, 0x8c, 0xab, 0xc8, 0xb5, 0x19, 0xdf, 0x0e, 0x58, 0xf6, 0x8e,
+	0x5c, 0xe4, 0xcb, 0xab, 0x41, 0x68, 0x75, 0xbe, 0x80, 0x23, 0xb0, 0x83, 0x5d, 0xcb, 0x47, 0x0e,
+	0x72, 0xa9, 0x31, 0x44, 0xbe, 0x85, 0x5c, 0x6a, 0xf6, 0x90, 0x9c, 0x64, 0xf1, 0xaf, 0x04, 0xa7,
+	0xfd, 0x63, 0x9c, 0xdf, 0xe5, 0x81, 0x20, 0xf6, 0x13, 0x15, 0x7b, 0x25, 0xc7, 0xa4, 0x7d, 0x75,
+	0x1f, 0xf5, 0x4c, 0xeb, 0xa4, 0x86, 0xac, 0xd3, 0x71, 0x7e, 0x97, 0x1b, 0xfe, 0x37, 0x22, 0x45,
+	0xdf, 0x9e, 0x88, 0x9b, 0x13, 0x29, 0x6c, 0x02, 0x78, 0xd4, 0xc7, 0x14, 0x05, 0xd9, 0x64, 0x98,
+	0x96, 0xe5, 0x8d, 0x5c, 0x4a, 0xe4, 0x54, 0x21, 0x51, 0x4c, 0x55, 0xde, 0x12, 0x21, 0xba, 0xca,
+	0x59, 0xcf, 0xe2, 0x14, 0x7d, 0x6b, 0x22, 0x2c, 0x0b, 0x19, 0x74, 0x40, 0x9a, 0x0c, 0x07, 0x98,
+	0x1a, 0xa4, 0x6f, 0xfa, 0x88, 0xc8, 0xa0, 0x90, 0x28, 0xae, 0xdd, 0xbe, 0xa9, 0x9e, 0x57, 0x61,
+	0xea, 0x17, 0x08, 0xf7, 0xfa, 0x14, 0xd9, 0x65, 0xdb, 0xf6, 0x11, 0x21, 0x95, 0x5d, 0x61, 0x7a,
+	0x5b, 0xdc, 0x4e, 0x84, 0x50, 0xd1, 0xd7, 0xd8, 0xb2, 0xc5, 0x56, 0x10, 0x81, 0xb4, 0x28, 0x65,
+	0x23, 0xc8, 0x0c, 0x79, 0xad, 0x20, 0x15, 0x37, 0x6e, 0xbf, 0x7d, 0xbe, 0x39, 0x51, 0x29, 0xed,
+	0x93, 0x21, 0xaa, 0x5c, 0x99, 0x9a, 0x89, 0x12, 0x29, 0xfa, 0x9a, 0x39, 0x45, 0x41, 0x0c, 0xd6,
+	0xed, 0x11, 0xb5, 0xfa, 0x86, 0x10, 0xca, 0x69, 0x96, 0x09, 0x7b, 0xe7, 0xdb, 0xa9, 0x05, 0x2a,
+	0xc2, 0x58, 0x45, 0x3e, 0x1d, 0xe7, 0x77, 0x44, 0x0d, 0x45, 0xa9, 0x14, 0x3d, 0x6d, 0x47, 0x70,
+	0xf0, 0x5b, 0x00, 0x09, 0x32, 0x07, 0xc8, 0x36, 0x3a, 0xd8, 0x9e, 0xd8, 0x5b, 0x67, 0xf6, 0xd4,
+	0xf3, 0xed, 0xb5, 0x98, 0x5e, 0x05, 0xdb, 0xa1, 0xcd, 0xeb, 0xd3, 0xeb, 0x3b, 0xcb, 0xa9, 0xe8,
+	0x19, 0x32, 0xa7, 0x00, 0x1f, 0x03, 0x40, 0xbc, 0x2e, 0x35, 0xac, 0x81, 0x47, 0x90, 0xbc, 0xc1,
+	0x8c, 0xde, 0x58, 0x60, 0xd4, 0xeb, 0xd2, 0x6a, 0x00, 0xaf, 0x5c, 0x8a, 0xd4, 0xd3, 0x84, 0x44,
+	0xd1, 0x53, 0x24, 0x44, 0xc0, 0xfb, 0x60, 0xab, 0x6f, 0x12, 0xc3, 0x47, 0x04, 0xf9, 0x87, 0x48,
+	0xb4, 0x8b, 0xcd, 0x82, 0x54, 0x4c, 0x56, 0xae, 0x9d, 0x8e, 0xf3, 0x32, 0x57, 0x3e, 0x03, 0x51,
+	0xf4, 0xcd, 0xbe, 0x49, 0x74, 0x2e, 0x62, 0xf5, 0xae, 0xfc, 0x16, 0x07, 0xe9, 0x68, 0x78, 0x59,
+	0x13, 0xea, 0x0e, 0x3c, 0xcf, 0x17, 0xac, 0xd2, 0x05, 0x9b, 0x50, 0x44, 0xf7, 0x62, 0x4d, 0x88,
+	0x29, 0xf2, 0x26, 0xf4, 0x18, 0x00, 0x1b, 0x59, 0xe6, 0x09, 0x4f, 0xc5, 0x38, 0x4b, 0xc5, 0x05,
+	0xd1, 0xab, 0x05, 0x78, 0x96, 0x88, 0x91, 0xe8, 0x4d, 0x49, 0x14, 0x3d, 0x65, 0x87, 0x08, 0xf8,
+	0x35, 0x58, 0x27, 0x14, 0x0d, 0x0d, 0xec, 0x52, 0xe4, 0x1f, 0x9a, 0x03, 0x39, 0x21, 0x7c, 0x9c,
+	0x6f, 0x47, 0x35, 0x31, 0x69, 0x2a, 0x05, 0xe1, 0xe3, 0x4e, 0xd8, 0xe5, 0x22, 0xda, 0xca, 0xf7,
+	0x41, 0x5b, 0x4a, 0x07, 0xb2, 0x7a, 0x28, 0xfa, 0x29, 0x0e, 0x52, 0x93, 0xfb, 0x84, 0x1f, 0x81,
+	0x95, 0x23, 0xec, 0xda, 0xde, 0x91, 0x2c, 0x2d, 0x32, 0x94, 0x0c, 0x0c, 0x31, 0x42, 0xa1, 0x02,
+	0xcb, 0x20, 0x85, 0x8e, 0x29, 0x72, 0x49, 0x90, 0xbd, 0xf1, 0x37, 0xd7, 0x9f, 0x6a, 0x05, 0xfe,
+	0x3a, 0xe6, 0xb1, 0x31, 0xa5, 0xb9, 0xa8, 0xbf, 0x33, 0xda, 0xc2, 0x5f, 0xc7, 0x3c, 0xd6, 0x26,
+	0x16, 0x3e, 0x06, 0x49, 0xb6, 0x6f, 0x23, 0x5b, 0x5e, 0x5a, 0x44, 0x3e, 0x3d, 0xe3, 0x44, 0x49,
+	0xf9, 0x45, 0x02, 0xdb, 0x22, 0x03, 0xa3, 0xe9, 0x09, 0xdf, 0x03, 0x20, 0xec, 0x26, 0xe1, 0x10,
+	0x8e, 0x5e, 0xf0, 0x74, 0x4f, 0xd1, 0x53, 0x62, 0x51, 0xb7, 0x61, 0x0e, 0x00, 0xcb, 0x73, 0x1c,
+	0x4c, 0x83, 0x2e, 0xcd, 0x82, 0x96, 0xd6, 0x23, 0x12, 0xf8, 0x10, 0xac, 0xcf, 0x96, 0x4e, 0x62,
+	0x51, 0x92, 0x47, 0x9a, 0xce, 0x5c, 0x45, 0xa5, 0xfd, 0x68, 0x39, 0x8d, 0xe3, 0x20, 0x33, 0xdf,
+	0x3d, 0x60, 0x17, 0x6c, 0xfa, 0xe8, 0x10, 0x99, 0x03, 0x63, 0x32, 0x00, 0xa5, 0x85, 0x03, 0x50,
+	0x11, 0x57, 0x70, 0x39, 0xb4, 0x3a, 0x43, 0xc0, 0x67, 0xe1, 0x3a, 0x97, 0x6a, 0x62, 0x22, 0x7e,
+	0x03, 0x36, 0x09, 0xa2, 0x74, 0xc0, 0x67, 0x56, 0xa4, 0x76, 0xde, 0x59, 0xd4, 0xee, 0x42, 0x25,
+	0x56, 0x40, 0xd9, 0xa9, 0xd5, 0x39, 0x3a, 0x45, 0xdf, 0x20, 0x33, 0x58, 0xe8, 0x01, 0xd8, 0xf5,
+	0xfc, 0x2e, 0xc2, 0x33, 0xc3, 0x96, 0x3f, 0x76, 0x3e, 0x79, 0xb3, 0x61, 0x2b, 0xfa, 0xea, 0x59,
+	0x1a, 0x45, 0xdf, 0x12, 0xc2, 0xe9, 0xa0, 0x55, 0x7e, 0x0e, 0x2a, 0x2b, 0x0c, 0xf0, 0x7f, 0x4c,
+	0x8f, 0xcb, 0x60, 0xa5, 0x83, 0x6d, 0x1b, 0xf9, 0xfc, 0x05, 0xa7, 0x8b, 0xd5, 0x5c, 0xda, 0x24,
+	0xce, 0xa4, 0xcd, 0x87, 0x60, 0xd5, 0x46, 0x43, 0x8f, 0x60, 0xba, 0xf8, 0x69, 0xb6, 0x14, 0x38,
+	0xaf, 0x87, 0x78, 0xf8, 0x3e, 0x58, 0x31, 0x9d, 0x60, 0xb0, 0xcb, 0xcb, 0x6f, 0xa6, 0x29, 0xe0,
+	0x30, 0x0b, 0x92, 0xfc, 0x92, 0x91, 0xcd, 0x5e, 0x4d, 0x49, 0x7d, 0xb2, 0x86, 0x1f, 0x80, 0x25,
+	0x96, 0x4c, 0xab, 0x0b, 0x93, 0x89, 0x95, 0x1c, 0x4b, 0x19, 0xa6, 0xa1, 0xfc, 0x2a, 0x81, 0xc4,
+	0xff, 0x1f, 0xbf, 0xa9, 0x93, 0x89, 0x8b, 0x39, 0x19, 0x3a, 0xb2, 0x74, 0x51, 0x47, 0xee, 0x2e,
+	0xfd, 0xf5, 0x43, 0x5e, 0xda, 0xfb, 0x0a, 0xac, 0x45, 0x9e, 0x22, 0x50, 0x06, 0x3b, 0xe5, 0x83,
+	0x6a, 0xbb, 0xfe, 0xa0, 0x61, 0xb4, 0x1f, 0x35, 0x35, 0x43, 0x6b, 0x7c, 0xba, 0x5f, 0x6f, 0xdd,
+	0xcf, 0xc4, 0xe0, 0x65, 0x00, 0x67, 0x76, 0x6a, 0x07, 0xed, 0xea, 0xfd, 0x8c, 0x04, 0x77, 0xc1,
+	0x95, 0x19, 0x79, 0x4b, 0x2b, 0xef, 0x6b, 0x35, 0xa3, 0x52, 0xaf, 0x65, 0xe2, 0x7b, 0x77, 0x41,
+	0x6a, 0x32, 0x5d, 0xe0, 0x25, 0xb0, 0x55, 0xd3, 0xaa, 0xe5, 0x47, 0x1c, 0xb7, 0x5f, 0x6f, 0x68,
+	0x65, 0x9d, 0x13, 0x47, 0xc4, 0xad, 0xb6, 0xd6, 0x6c, 0x6a, 0xb5, 0x8c, 0xb4, 0xd7, 0x03, 0xeb,
+	0xe2, 0x64, 0x2d, 0x6a, 0xd2, 0x11, 0x81, 0x39, 0x90, 0x0d, 0x2d, 0xb5, 0xda, 0xe5, 0xf6, 0x41,
+	0xcb, 0x38, 0x68, 0xb4, 0x9a, 0x5a, 0xb5, 0x7e, 0xaf, 0xae, 0xd5, 0x32, 0xb1, 0xe8, 0x49, 0xc4,
+	0x7e, 0xbd, 0x51, 0xae, 0xb6, 0xeb, 0x9f, 0x6b, 0x19, 0x09, 0x5e, 0x05, 0x97, 0xe6, 0x36, 0xc5,
+	0x56, 0x7c, 0xaf, 0x05, 0x36, 0x66, 0xcb, 0x18, 0xe6, 0xc1, 0x6e, 0x4b, 0x6b, 0xb7, 0xf7, 0xb5,
+	0xcf, 0xb4, 0x46, 0x9b, 0x9f, 0xeb, 0x5e, 0x5d, 0x6f, 0xb5, 0x8d, 0xa6, 0x5e, 0xaf, 0x6a, 0x99,
+	0x18, 0x2c, 0x80, 0x6b, 0xf3, 0x80, 0x96, 0x56, 0x7d, 0xd0, 0xa8, 0x09, 0x84, 0x54, 0x79, 0xf8,
+	0xec, 0x65, 0x2e, 0xf6, 0xfc, 0x65, 0x2e, 0xf6, 0xec, 0x55, 0x4e, 0x7a, 0xfe, 0x2a, 0x27, 0xfd,
+	0xf9, 0x2a, 0x27, 0x3d, 0x7d, 0x9d, 0x8b, 0x3d, 0x7f, 0x9d, 0x8b, 0xfd, 0xfe, 0x3a, 0x17, 0xfb,
+	0xf2, 0x4e, 0x64, 0xbc, 0x4f, 0xfe, 0x66, 0x79, 0x8e, 0x8b, 0xbb, 0x03, 0x7c, 0xdc, 0x1f, 0x75,
+	0x4a, 0x87, 0x77, 0x4a, 0xb3, 0xff, 0xbb, 0xd8, 0xc8, 0xef, 0xac, 0xb0, 0xbb, 0x7d, 0xf7, 0x9f,
+	0x01, 0x00, 0x1c, 0xfd, 0xeb, 0xe0, 0x5d, 0x0e, 0x00, 0x00,
 }
 
 func (this *Bid) Equal(that interface{}) bool {
@@ -259,6 +620,57 @@ func (m *AuctionListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HasReservePrice {
+		i--
+		if m.HasReservePrice {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SoftClose != nil {
+		{
+			size, err := m.SoftClose.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.SealedBidAuction != nil {
+		{
+			size, err := m.SealedBidAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DutchAuction != nil {
+		{
+			size, err := m.DutchAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AuctionType != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionType))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.SplitShares) > 0 {
 		for iNdEx := len(m.SplitShares) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -300,21 +712,21 @@ func (m *AuctionListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 	}
 	if m.EndTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
-		if err1 != nil {
-			return 0, err1
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n1
-		i = encodeVarintAuction(dAtA, i, uint64(n1))
+		i -= n4
+		i = encodeVarintAuction(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintAuction(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintAuction(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -349,7 +761,7 @@ func (m *AuctionListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Bid) Marshal() (dAtA []byte, err error) {
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -359,26 +771,31 @@ func (m *Bid) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err4 != nil {
-		return 0, err4
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StepInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StepInterval):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintAuction(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintAuction(dAtA, i, uint64(n7))
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x1a
+	if m.DecayType != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.DecayType))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.FloorPrice.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -386,11 +803,101 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SoftClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SoftClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SoftClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Extended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Extended):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintAuction(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxExtension):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintAuction(dAtA, i, uint64(n10))
+	i--
 	dAtA[i] = 0x1a
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Extension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Extension):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintAuction(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAuction(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionReservePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionReservePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionReservePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReservePrice != nil {
+		{
+			size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Commitment)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -402,23 +909,202 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SealedBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AuctionListing) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *SealedBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ForfeitPercentage.Size()
+		i -= size
+		if _, err := m.ForfeitPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SettlementType != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.SettlementType))
+		i--
+		dAtA[i] = 0x10
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevealEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealEndTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintAuction(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintAuction(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x3a
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintAuction(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuctionListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Id != 0 {
 		n += 1 + sovAuction(uint64(m.Id))
 	}
@@ -432,10 +1118,10 @@ func (m *AuctionListing) Size() (n int) {
 	}
 	l = m.StartPrice.Size()
 	n += 1 + l + sovAuction(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovAuction(uint64(l))
 	if m.EndTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
 		n += 1 + l + sovAuction(uint64(l))
 	}
 	l = len(m.Owner)
@@ -456,6 +1142,122 @@ func (m *AuctionListing) Size() (n int) {
 			n += 1 + l + sovAuction(uint64(l))
 		}
 	}
+	if m.AuctionType != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionType))
+	}
+	if m.DutchAuction != nil {
+		l = m.DutchAuction.Size()
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.SealedBidAuction != nil {
+		l = m.SealedBidAuction.Size()
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.SoftClose != nil {
+		l = m.SoftClose.Size()
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.HasReservePrice {
+		n += 2
+	}
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.DecayType != 0 {
+		n += 1 + sovAuction(uint64(m.DecayType))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StepInterval)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *SoftClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Extension)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxExtension)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Extended)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *AuctionReservePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionId))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.ReservePrice != nil {
+		l = m.ReservePrice.Size()
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	return n
+}
+
+func (m *SealedBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealEndTime)
+	n += 1 + l + sovAuction(uint64(l))
+	if m.SettlementType != 0 {
+		n += 1 + sovAuction(uint64(m.SettlementType))
+	}
+	l = m.ForfeitPercentage.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *SealedBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Revealed {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
@@ -474,7 +1276,7 @@ func (m *Bid) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovAuction(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
 	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
@@ -659,7 +1461,7 @@ func (m *AuctionListing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -695,7 +1497,7 @@ func (m *AuctionListing) Unmarshal(dAtA []byte) error {
 			if m.EndTime == nil {
 				m.EndTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -831,6 +1633,999 @@ func (m *AuctionListing) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			m.AuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DutchAuction == nil {
+				m.DutchAuction = &DutchAuction{}
+			}
+			if err := m.DutchAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SealedBidAuction == nil {
+				m.SealedBidAuction = &SealedBidAuction{}
+			}
+			if err := m.SealedBidAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftClose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SoftClose == nil {
+				m.SoftClose = &SoftClose{}
+			}
+			if err := m.SoftClose.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasReservePrice", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasReservePrice = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayType", wireType)
+			}
+			m.DecayType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayType |= DecayType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StepInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SoftClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SoftClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SoftClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Extension, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxExtension, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Extended, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionReservePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionReservePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionReservePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReservePrice == nil {
+				m.ReservePrice = &types.Coin{}
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevealEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementType", wireType)
+			}
+			m.SettlementType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementType |= SettlementType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForfeitPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -994,7 +2789,7 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
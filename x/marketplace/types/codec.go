@@ -17,6 +17,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateAuction{}, "OmniFlix/marketplace/MsgCreateAuction")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelAuction{}, "OmniFlix/marketplace/MsgCancelAuction")
 	legacy.RegisterAminoMsg(cdc, &MsgPlaceBid{}, "OmniFlix/marketplace/MsgPlaceBid")
+	legacy.RegisterAminoMsg(cdc, &MsgMakeOffer{}, "OmniFlix/marketplace/MsgMakeOffer")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelOffer{}, "OmniFlix/marketplace/MsgCancelOffer")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptOffer{}, "OmniFlix/marketplace/MsgAcceptOffer")
+	legacy.RegisterAminoMsg(cdc, &MsgRejectOffer{}, "OmniFlix/marketplace/MsgRejectOffer")
+	legacy.RegisterAminoMsg(cdc, &MsgMakeCollectionOffer{}, "OmniFlix/marketplace/MsgMakeCollOffer")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelCollectionOffer{}, "OmniFlix/marketplace/MsgCancelCollOffer")
+	legacy.RegisterAminoMsg(cdc, &MsgSellToCollectionOffer{}, "OmniFlix/marketplace/MsgSellToCollOffer")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDutchAuction{}, "OmniFlix/marketplace/MsgCreateDutchAuc")
+	legacy.RegisterAminoMsg(cdc, &MsgBuyDutchAuction{}, "OmniFlix/marketplace/MsgBuyDutchAuction")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateSealedBidAuction{}, "OmniFlix/marketplace/MsgCreateSealedAuc")
+	legacy.RegisterAminoMsg(cdc, &MsgCommitSealedBid{}, "OmniFlix/marketplace/MsgCommitSealedBid")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealSealedBid{}, "OmniFlix/marketplace/MsgRevealSealedBid")
+	legacy.RegisterAminoMsg(cdc, &MsgRevealReservePrice{}, "OmniFlix/marketplace/MsgRevealReserve")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "OmniFlix/marketplace/MsgUpdateParams")
 
 	cdc.RegisterInterface((*exported.ListingI)(nil), nil)
@@ -35,6 +48,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateAuction{},
 		&MsgCancelAuction{},
 		&MsgPlaceBid{},
+		&MsgMakeOffer{},
+		&MsgCancelOffer{},
+		&MsgAcceptOffer{},
+		&MsgRejectOffer{},
+		&MsgMakeCollectionOffer{},
+		&MsgCancelCollectionOffer{},
+		&MsgSellToCollectionOffer{},
+		&MsgCreateDutchAuction{},
+		&MsgBuyDutchAuction{},
+		&MsgCreateSealedBidAuction{},
+		&MsgCommitSealedBid{},
+		&MsgRevealSealedBid{},
+		&MsgRevealReservePrice{},
 		&MsgUpdateParams{},
 	)
 
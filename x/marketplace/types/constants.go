@@ -4,4 +4,6 @@ const (
 	MinListingIdLength = 4
 	MaxListingIdLength = 64
 	MaxSplits          = 5
+
+	MaxCollectionOfferQuantity = 10000
 )
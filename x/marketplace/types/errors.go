@@ -33,4 +33,14 @@ var (
 	ErrInvalidPercentage        = errorsmod.Register(ModuleName, 26, "invalid percentage decimal value")
 	ErrInvalidTime              = errorsmod.Register(ModuleName, 27, "invalid timestamp value")
 	ErrInvalidDuration          = errorsmod.Register(ModuleName, 28, "invalid duration")
+	ErrOfferDoesNotExists       = errorsmod.Register(ModuleName, 29, "offer doesn't exists")
+	ErrInvalidOfferId           = errorsmod.Register(ModuleName, 30, "invalid offer id")
+	ErrOfferExpired             = errorsmod.Register(ModuleName, 31, "offer expired")
+	ErrNftNotAvailable          = errorsmod.Register(ModuleName, 32, "nft is listed or in auction")
+	ErrInvalidQuantity          = errorsmod.Register(ModuleName, 33, "invalid quantity")
+	ErrOfferFilled              = errorsmod.Register(ModuleName, 34, "offer already filled")
+	ErrInvalidAuctionType       = errorsmod.Register(ModuleName, 35, "invalid auction type")
+	ErrInvalidFloorPrice        = errorsmod.Register(ModuleName, 36, "invalid floor price")
+	ErrInvalidCommitment        = errorsmod.Register(ModuleName, 37, "invalid sealed bid commitment")
+	ErrInvalidReservePrice      = errorsmod.Register(ModuleName, 38, "invalid reserve price")
 )
@@ -14,6 +14,30 @@ const (
 	EventTypePlaceBid      = "place_bid"
 	EventTypeRemoveAuction = "remove_auction"
 	EventTypeProcessBid    = "process_bid"
+	EventTypeExtendAuction = "extend_auction"
+	EventTypeReserveNotMet = "reserve_not_met"
+
+	EventTypeRevealReservePrice = "reveal_reserve_price"
+
+	EventTypeCreateDutchAuction = "create_dutch_auction"
+	EventTypeBuyDutchAuction    = "buy_dutch_auction"
+
+	EventTypeCreateSealedBidAuction = "create_sealed_bid_auction"
+	EventTypeCommitSealedBid        = "commit_sealed_bid"
+	EventTypeRevealSealedBid        = "reveal_sealed_bid"
+	EventTypeSettleSealedBidAuction = "settle_sealed_bid_auction"
+	EventTypeForfeitSealedBid       = "forfeit_sealed_bid"
+
+	EventTypeMakeOffer   = "make_offer"
+	EventTypeCancelOffer = "cancel_offer"
+	EventTypeAcceptOffer = "accept_offer"
+	EventTypeRejectOffer = "reject_offer"
+	EventTypeExpireOffer = "expire_offer"
+
+	EventTypeMakeCollectionOffer   = "make_collection_offer"
+	EventTypeCancelCollectionOffer = "cancel_collection_offer"
+	EventTypeSellToCollectionOffer = "sell_to_collection_offer"
+	EventTypeExpireCollectionOffer = "expire_collection_offer"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyListingId  = "listing-id"
@@ -26,4 +50,18 @@ const (
 	AttributeKeyAuctionId  = "auction-id"
 	AttributeKeyStartPrice = "start-price"
 	AttributeKeyBidder     = "bidder"
+	AttributeKeyOfferId    = "offer-id"
+	AttributeKeyExpiration = "expiration"
+	AttributeKeySeller     = "seller"
+	AttributeKeyQuantity   = "quantity"
+	AttributeKeyRemaining  = "remaining"
+	AttributeKeyRefund     = "refund"
+	AttributeKeyFloorPrice = "floor-price"
+	AttributeKeyDecayType  = "decay-type"
+	AttributeKeyDeposit    = "deposit"
+	AttributeKeyWinner     = "winner"
+	AttributeKeySettlement = "settlement-type"
+	AttributeKeyEndTime    = "end-time"
+
+	AttributeKeyReservePrice = "reserve-price"
 )
@@ -29,7 +29,16 @@ type BankKeeper interface {
 type NftKeeper interface {
 	GetONFT(ctx sdk.Context, denomId, onftId string) (nft nft.ONFTI, err error)
 	GetDenomInfo(ctx sdk.Context, denomId string) (*nftypes.Denom, error)
+	ValidateTransferRestrictions(ctx sdk.Context, denomId, nftId string) error
 	TransferOwnership(ctx sdk.Context, denomId, nftId string, srcOwner, dstOwner sdk.AccAddress) error
+	TransferOwnershipWithCause(
+		ctx sdk.Context,
+		denomId,
+		nftId string,
+		srcOwner,
+		dstOwner sdk.AccAddress,
+		cause nftypes.OwnershipChangeCause,
+	) error
 }
 
 // DistributionKeeper defines the expected distribution keeper
@@ -1,12 +1,17 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func NewGenesisState(listings []Listing, listingCount uint64, params Params,
 	auctions []AuctionListing, bids []Bid, nextAuctionNumber uint64,
+	offers []Offer, nextOfferNumber uint64,
+	collectionOffers []CollectionOffer, nextCollectionOfferNumber uint64,
+	sealedBids []SealedBid, auctionReservePrices []AuctionReservePrice,
 ) *GenesisState {
 	return &GenesisState{
 		Listings:          listings,
@@ -15,6 +20,14 @@ func NewGenesisState(listings []Listing, listingCount uint64, params Params,
 		Auctions:          auctions,
 		Bids:              bids,
 		NextAuctionNumber: nextAuctionNumber,
+		Offers:            offers,
+		NextOfferNumber:   nextOfferNumber,
+
+		CollectionOffers:          collectionOffers,
+		NextCollectionOfferNumber: nextCollectionOfferNumber,
+
+		SealedBids:           sealedBids,
+		AuctionReservePrices: auctionReservePrices,
 	}
 }
 
@@ -43,5 +56,42 @@ func (m *GenesisState) ValidateGenesis() error {
 	if m.NextAuctionNumber <= 0 {
 		return errorsmod.Wrap(ErrNonPositiveNumber, "must be a number and greater than 0.")
 	}
+	for _, offer := range m.Offers {
+		if err := ValidateOffer(offer); err != nil {
+			return err
+		}
+		if offer.Id >= m.NextOfferNumber {
+			return errorsmod.Wrapf(ErrInvalidOfferId, "offer id %d must be less than next offer number %d",
+				offer.Id, m.NextOfferNumber)
+		}
+	}
+	for _, offer := range m.CollectionOffers {
+		if err := ValidateCollectionOffer(offer); err != nil {
+			return err
+		}
+		if offer.Id >= m.NextCollectionOfferNumber {
+			return errorsmod.Wrapf(ErrInvalidOfferId, "collection offer id %d must be less than next collection offer number %d",
+				offer.Id, m.NextCollectionOfferNumber)
+		}
+	}
+	for _, bid := range m.SealedBids {
+		if err := ValidateSealedBid(bid); err != nil {
+			return err
+		}
+	}
+	for _, reserve := range m.AuctionReservePrices {
+		if err := validateAuctionId(reserve.AuctionId); err != nil {
+			return err
+		}
+		if len(reserve.Commitment) != sha256.Size {
+			return errorsmod.Wrapf(ErrInvalidReservePrice,
+				"reserve price commitment of auction %d must be a %d bytes sha256 hash", reserve.AuctionId, sha256.Size)
+		}
+		if reserve.ReservePrice != nil {
+			if err := ValidatePrice(*reserve.ReservePrice); err != nil {
+				return errorsmod.Wrapf(ErrInvalidReservePrice, "invalid reserve price of auction %d", reserve.AuctionId)
+			}
+		}
+	}
 	return nil
 }
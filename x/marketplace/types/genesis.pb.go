@@ -25,12 +25,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	// NFTs that are listed in marketplace
-	Listings          []Listing        `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
-	ListingCount      uint64           `protobuf:"varint,2,opt,name=ListingCount,proto3" json:"ListingCount,omitempty"`
-	Params            Params           `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
-	Auctions          []AuctionListing `protobuf:"bytes,4,rep,name=auctions,proto3" json:"auctions"`
-	Bids              []Bid            `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids"`
-	NextAuctionNumber uint64           `protobuf:"varint,6,opt,name=next_auction_number,json=nextAuctionNumber,proto3" json:"next_auction_number,omitempty"`
+	Listings                  []Listing             `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
+	ListingCount              uint64                `protobuf:"varint,2,opt,name=ListingCount,proto3" json:"ListingCount,omitempty"`
+	Params                    Params                `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	Auctions                  []AuctionListing      `protobuf:"bytes,4,rep,name=auctions,proto3" json:"auctions"`
+	Bids                      []Bid                 `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids"`
+	NextAuctionNumber         uint64                `protobuf:"varint,6,opt,name=next_auction_number,json=nextAuctionNumber,proto3" json:"next_auction_number,omitempty"`
+	Offers                    []Offer               `protobuf:"bytes,7,rep,name=offers,proto3" json:"offers"`
+	NextOfferNumber           uint64                `protobuf:"varint,8,opt,name=next_offer_number,json=nextOfferNumber,proto3" json:"next_offer_number,omitempty"`
+	CollectionOffers          []CollectionOffer     `protobuf:"bytes,9,rep,name=collection_offers,json=collectionOffers,proto3" json:"collection_offers"`
+	NextCollectionOfferNumber uint64                `protobuf:"varint,10,opt,name=next_collection_offer_number,json=nextCollectionOfferNumber,proto3" json:"next_collection_offer_number,omitempty"`
+	SealedBids                []SealedBid           `protobuf:"bytes,11,rep,name=sealed_bids,json=sealedBids,proto3" json:"sealed_bids"`
+	AuctionReservePrices      []AuctionReservePrice `protobuf:"bytes,12,rep,name=auction_reserve_prices,json=auctionReservePrices,proto3" json:"auction_reserve_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +114,48 @@ func (m *GenesisState) GetNextAuctionNumber() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetOffers() []Offer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOfferNumber() uint64 {
+	if m != nil {
+		return m.NextOfferNumber
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCollectionOffers() []CollectionOffer {
+	if m != nil {
+		return m.CollectionOffers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextCollectionOfferNumber() uint64 {
+	if m != nil {
+		return m.NextCollectionOfferNumber
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSealedBids() []SealedBid {
+	if m != nil {
+		return m.SealedBids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionReservePrices() []AuctionReservePrice {
+	if m != nil {
+		return m.AuctionReservePrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "OmniFlix.marketplace.v1beta1.GenesisState")
 }
@@ -117,30 +165,39 @@ func init() {
 }
 
 var fileDescriptor_8a65cfd42fa482d5 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x4d, 0x4f, 0xe2, 0x40,
-	0x18, 0xc7, 0x5b, 0xe8, 0x12, 0x32, 0x70, 0xd9, 0xd9, 0x3d, 0x34, 0x64, 0xd3, 0x45, 0xa2, 0x09,
-	0x1a, 0x33, 0x13, 0x30, 0x7a, 0xf1, 0x64, 0x4d, 0xe4, 0x62, 0x90, 0xe0, 0xcd, 0x0b, 0x99, 0xc2,
-	0x58, 0x26, 0xb6, 0x33, 0x4d, 0x67, 0x4a, 0xf0, 0x5b, 0xf8, 0xb1, 0x38, 0x72, 0xf4, 0x64, 0x0c,
-	0x7c, 0x0d, 0x0f, 0x86, 0xe9, 0x40, 0xc4, 0x43, 0xe3, 0x6d, 0x32, 0xcf, 0xef, 0xf9, 0xbf, 0xe4,
-	0x01, 0x27, 0x77, 0x31, 0x67, 0x37, 0x11, 0x9b, 0xe3, 0x98, 0xa4, 0x4f, 0x54, 0x25, 0x11, 0x19,
-	0x53, 0x3c, 0xeb, 0x04, 0x54, 0x91, 0x0e, 0x0e, 0x29, 0xa7, 0x92, 0x49, 0x94, 0xa4, 0x42, 0x09,
-	0xf8, 0x6f, 0xcb, 0xa2, 0x2f, 0x2c, 0x32, 0x6c, 0xe3, 0x6f, 0x28, 0x42, 0xa1, 0x41, 0xbc, 0x79,
-	0xe5, 0x3b, 0x8d, 0x62, 0xfd, 0x88, 0x49, 0xc5, 0x78, 0xf8, 0x23, 0x96, 0x64, 0x63, 0xc5, 0x04,
-	0x37, 0xec, 0x71, 0x21, 0x9b, 0x90, 0x94, 0xc4, 0x26, 0x76, 0xeb, 0xa3, 0x04, 0xea, 0xbd, 0xbc,
-	0xc8, 0xbd, 0x22, 0x8a, 0xc2, 0x1e, 0xa8, 0x1a, 0x63, 0xe9, 0xda, 0xcd, 0x72, 0xbb, 0xd6, 0x3d,
-	0x42, 0x45, 0xd5, 0xd0, 0x6d, 0x4e, 0xfb, 0xce, 0xe2, 0xed, 0xbf, 0x35, 0xdc, 0x2d, 0xc3, 0x16,
-	0xa8, 0x9b, 0xd1, 0xb5, 0xc8, 0xb8, 0x72, 0x4b, 0x4d, 0xbb, 0xed, 0x0c, 0xf7, 0xfe, 0xa0, 0x0f,
-	0x2a, 0x79, 0x1a, 0xb7, 0xdc, 0xb4, 0xdb, 0xb5, 0xee, 0x61, 0xb1, 0xd5, 0x40, 0xb3, 0xc6, 0xc9,
-	0x6c, 0xc2, 0x3e, 0xa8, 0x9a, 0xf6, 0xd2, 0x75, 0x74, 0xe0, 0xd3, 0x62, 0x95, 0xab, 0x9c, 0xfe,
-	0x96, 0x7b, 0xab, 0x01, 0x2f, 0x81, 0x13, 0xb0, 0x89, 0x74, 0x7f, 0x69, 0xad, 0x83, 0x62, 0x2d,
-	0x9f, 0x4d, 0x8c, 0x80, 0x5e, 0x82, 0x08, 0xfc, 0xe1, 0x74, 0xae, 0x46, 0x46, 0x6d, 0xc4, 0xb3,
-	0x38, 0xa0, 0xa9, 0x5b, 0xd1, 0xdd, 0x7f, 0x6f, 0x46, 0xc6, 0xbd, 0xaf, 0x07, 0xfe, 0x60, 0xb1,
-	0xf2, 0xec, 0xe5, 0xca, 0xb3, 0xdf, 0x57, 0x9e, 0xfd, 0xb2, 0xf6, 0xac, 0xe5, 0xda, 0xb3, 0x5e,
-	0xd7, 0x9e, 0xf5, 0x70, 0x11, 0x32, 0x35, 0xcd, 0x02, 0x34, 0x16, 0x31, 0xde, 0x9d, 0x53, 0xc4,
-	0x9c, 0x3d, 0x46, 0x6c, 0x3e, 0xcd, 0x02, 0x3c, 0x3b, 0xc7, 0xfb, 0xf7, 0x55, 0xcf, 0x09, 0x95,
-	0x41, 0x45, 0xdf, 0xf5, 0xec, 0x33, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xe3, 0x7d, 0xda, 0xbc, 0x02,
-	0x00, 0x00,
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x56, 0x42, 0x71, 0x2b, 0xc1, 0xcc, 0x84, 0xc2, 0x34, 0x85, 0x32, 0x40, 0x94,
+	0x09, 0x12, 0x75, 0x48, 0xbb, 0x70, 0x40, 0xeb, 0x24, 0x76, 0x41, 0x5d, 0xd5, 0xdd, 0xb8, 0x84,
+	0x24, 0x7d, 0x9a, 0x59, 0x24, 0x71, 0x14, 0x3b, 0x55, 0xf9, 0x16, 0x7c, 0xac, 0x5d, 0x90, 0x76,
+	0xe4, 0x84, 0x50, 0xfb, 0x45, 0x50, 0x9e, 0x38, 0x65, 0xed, 0x24, 0xab, 0xb7, 0xd6, 0xcf, 0xef,
+	0xff, 0x62, 0x3b, 0x26, 0x47, 0x17, 0x49, 0xca, 0x3e, 0xc7, 0x6c, 0xee, 0x26, 0x7e, 0xfe, 0x1d,
+	0x64, 0x16, 0xfb, 0x21, 0xb8, 0xb3, 0x7e, 0x00, 0xd2, 0xef, 0xbb, 0x11, 0xa4, 0x20, 0x98, 0x70,
+	0xb2, 0x9c, 0x4b, 0x4e, 0x0f, 0x6a, 0xd6, 0xb9, 0xc5, 0x3a, 0x8a, 0xdd, 0xdf, 0x8b, 0x78, 0xc4,
+	0x11, 0x74, 0xcb, 0x5f, 0x95, 0x66, 0x5f, 0xef, 0x1f, 0x33, 0x21, 0x59, 0x1a, 0x6d, 0xc5, 0xfa,
+	0x45, 0x28, 0x19, 0x4f, 0x15, 0xdb, 0xd3, 0xb2, 0x7c, 0x3a, 0x85, 0x5c, 0x91, 0x6f, 0xb5, 0x64,
+	0xe6, 0xe7, 0x7e, 0xa2, 0x36, 0x78, 0xf8, 0xcb, 0x24, 0x9d, 0xf3, 0x6a, 0xcb, 0x97, 0xd2, 0x97,
+	0x40, 0xcf, 0x49, 0x4b, 0x55, 0x14, 0x96, 0xd1, 0xdd, 0xe9, 0xb5, 0x8f, 0x5f, 0x3b, 0xba, 0x43,
+	0x70, 0xbe, 0x54, 0xf4, 0xa0, 0x79, 0xfd, 0xe7, 0x79, 0x63, 0xbc, 0x12, 0xd3, 0x43, 0xd2, 0x51,
+	0xa3, 0x33, 0x5e, 0xa4, 0xd2, 0xba, 0xd7, 0x35, 0x7a, 0xcd, 0xf1, 0xda, 0x1a, 0x1d, 0x10, 0xb3,
+	0x6a, 0x63, 0xed, 0x74, 0x8d, 0x5e, 0xfb, 0xf8, 0x95, 0x3e, 0x6a, 0x84, 0xac, 0x4a, 0x52, 0x4a,
+	0x3a, 0x24, 0x2d, 0x75, 0x4e, 0xc2, 0x6a, 0x62, 0xe1, 0x77, 0x7a, 0x97, 0xd3, 0x8a, 0xde, 0xe8,
+	0x5d, 0x7b, 0xd0, 0x8f, 0xa4, 0x19, 0xb0, 0x89, 0xb0, 0xee, 0xa3, 0xd7, 0x0b, 0xbd, 0xd7, 0x80,
+	0x4d, 0x94, 0x01, 0x8a, 0xa8, 0x43, 0x9e, 0xa4, 0x30, 0x97, 0x9e, 0x72, 0xf3, 0xd2, 0x22, 0x09,
+	0x20, 0xb7, 0x4c, 0xdc, 0xfb, 0x6e, 0x39, 0x52, 0xe9, 0x43, 0x1c, 0xd0, 0x53, 0x62, 0xe2, 0xc5,
+	0x09, 0xeb, 0x01, 0xc6, 0xbd, 0xd4, 0xc7, 0x5d, 0x94, 0x6c, 0xbd, 0xff, 0x4a, 0x48, 0x8f, 0x08,
+	0xfa, 0x7a, 0xf8, 0xb7, 0x0e, 0x6c, 0x61, 0xe0, 0xa3, 0x72, 0x80, 0x1a, 0x15, 0xf7, 0x8d, 0xec,
+	0x86, 0x3c, 0x8e, 0xa1, 0x2a, 0xa7, 0x92, 0x1f, 0x62, 0xf2, 0x7b, 0x7d, 0xf2, 0xd9, 0x4a, 0x76,
+	0xbb, 0xc3, 0xe3, 0x70, 0x7d, 0x59, 0xd0, 0x4f, 0xe4, 0x00, 0xdb, 0x6c, 0xc6, 0xd4, 0xc5, 0x08,
+	0x16, 0x7b, 0x56, 0x32, 0x1b, 0x96, 0xaa, 0xe2, 0x90, 0xb4, 0x05, 0xf8, 0x31, 0x4c, 0x3c, 0xbc,
+	0x85, 0x36, 0x96, 0x7b, 0xa3, 0x2f, 0x77, 0x89, 0x82, 0xff, 0x77, 0x41, 0x44, 0xbd, 0x20, 0x68,
+	0x42, 0x9e, 0xd6, 0x97, 0x91, 0x83, 0x80, 0x7c, 0x06, 0x5e, 0x96, 0xb3, 0x10, 0x84, 0xd5, 0x41,
+	0xeb, 0xfe, 0x56, 0x1f, 0xcb, 0xb8, 0x92, 0x8e, 0x4a, 0xa5, 0x0a, 0xd9, 0xf3, 0xef, 0x8e, 0xc4,
+	0x60, 0x74, 0xbd, 0xb0, 0x8d, 0x9b, 0x85, 0x6d, 0xfc, 0x5d, 0xd8, 0xc6, 0xcf, 0xa5, 0xdd, 0xb8,
+	0x59, 0xda, 0x8d, 0xdf, 0x4b, 0xbb, 0xf1, 0xf5, 0x24, 0x62, 0xf2, 0xaa, 0x08, 0x9c, 0x90, 0x27,
+	0xee, 0xea, 0x7d, 0xf2, 0x24, 0x65, 0xd3, 0x98, 0xcd, 0xaf, 0x8a, 0xc0, 0x9d, 0x9d, 0xb8, 0xeb,
+	0x0f, 0x56, 0xfe, 0xc8, 0x40, 0x04, 0x26, 0x3e, 0xd4, 0x0f, 0xff, 0x06, 0x00, 0x26, 0x1e, 0x39,
+	0xec, 0xb7, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -163,6 +220,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuctionReservePrices) > 0 {
+		for iNdEx := len(m.AuctionReservePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionReservePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SealedBids) > 0 {
+		for iNdEx := len(m.SealedBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SealedBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextCollectionOfferNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextCollectionOfferNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.CollectionOffers) > 0 {
+		for iNdEx := len(m.CollectionOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectionOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextOfferNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOfferNumber))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextAuctionNumber != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextAuctionNumber))
 		i--
@@ -271,6 +394,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextAuctionNumber != 0 {
 		n += 1 + sovGenesis(uint64(m.NextAuctionNumber))
 	}
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOfferNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOfferNumber))
+	}
+	if len(m.CollectionOffers) > 0 {
+		for _, e := range m.CollectionOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextCollectionOfferNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.NextCollectionOfferNumber))
+	}
+	if len(m.SealedBids) > 0 {
+		for _, e := range m.SealedBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionReservePrices) > 0 {
+		for _, e := range m.AuctionReservePrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -482,6 +635,180 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOfferNumber", wireType)
+			}
+			m.NextOfferNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOfferNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionOffers = append(m.CollectionOffers, CollectionOffer{})
+			if err := m.CollectionOffers[len(m.CollectionOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCollectionOfferNumber", wireType)
+			}
+			m.NextCollectionOfferNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCollectionOfferNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealedBids = append(m.SealedBids, SealedBid{})
+			if err := m.SealedBids[len(m.SealedBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionReservePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionReservePrices = append(m.AuctionReservePrices, AuctionReservePrice{})
+			if err := m.AuctionReservePrices[len(m.AuctionReservePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -29,6 +33,20 @@ var (
 	PrefixActiveAuction     = []byte{0x14}
 
 	ParamsKey = []byte{0x15}
+
+	PrefixOfferId         = []byte{0x16}
+	PrefixOfferBidder     = []byte{0x17}
+	PrefixOfferNFT        = []byte{0x19}
+	PrefixOfferExpiration = []byte{0x20}
+	PrefixNextOfferNumber = []byte{0x21}
+
+	PrefixCollectionOfferId         = []byte{0x22}
+	PrefixCollectionOfferDenom      = []byte{0x23}
+	PrefixCollectionOfferExpiration = []byte{0x24}
+	PrefixNextCollectionOfferNumber = []byte{0x25}
+
+	PrefixSealedBid           = []byte{0x26}
+	PrefixAuctionReservePrice = []byte{0x27}
 )
 
 func KeyListingIdPrefix(id string) []byte {
@@ -74,3 +92,83 @@ func KeyInActiveAuctionPrefix(id uint64) []byte {
 func KeyActiveAuctionPrefix(id uint64) []byte {
 	return append(PrefixActiveAuction, sdk.Uint64ToBigEndian(id)...)
 }
+
+func KeyOfferIdPrefix(id uint64) []byte {
+	return append(PrefixOfferId, sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyOfferBidderPrefix returns the key of an offer id under the given bidder, a zero
+// offer id gives the prefix of all offers of the bidder
+func KeyOfferBidderPrefix(bidder sdk.AccAddress, id uint64) []byte {
+	key := append(PrefixOfferBidder, address.MustLengthPrefix(bidder.Bytes())...)
+	if id == 0 {
+		return key
+	}
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyOfferNFTPrefix returns the key of an offer id under the given nft, an empty
+// nft id with a zero offer id gives the prefix of all offers of the denom. Both ids
+// are length prefixed so an id is never read as the prefix of a longer one
+func KeyOfferNFTPrefix(denomId, nftId string, id uint64) []byte {
+	key := append(PrefixOfferNFT, address.MustLengthPrefix([]byte(denomId))...)
+	if len(nftId) == 0 {
+		return key
+	}
+	key = append(key, address.MustLengthPrefix([]byte(nftId))...)
+	if id == 0 {
+		return key
+	}
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+func KeyOfferExpirationPrefix(expiration time.Time, id uint64) []byte {
+	return append(append(PrefixOfferExpiration, sdk.FormatTimeBytes(expiration)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+func KeyCollectionOfferIdPrefix(id uint64) []byte {
+	return append(PrefixCollectionOfferId, sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyCollectionOfferDenomPrefix returns the prefix of collection offers of a denom,
+// narrowed to a price denom when given. Both are length prefixed as price denoms
+// like ibc and factory denoms contain '/'
+func KeyCollectionOfferDenomPrefix(denomId, priceDenom string) []byte {
+	key := append(PrefixCollectionOfferDenom, address.MustLengthPrefix([]byte(denomId))...)
+	if len(priceDenom) == 0 {
+		return key
+	}
+	return append(key, address.MustLengthPrefix([]byte(priceDenom))...)
+}
+
+// KeyCollectionOfferPricePrefix returns the key of a collection offer in the denom
+// index, keys are ordered by price from highest to lowest and then by offer id
+func KeyCollectionOfferPricePrefix(denomId string, price sdk.Coin, id uint64) []byte {
+	key := KeyCollectionOfferDenomPrefix(denomId, price.Denom)
+	return append(append(key, invertedAmountBytes(price.Amount)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+func KeyCollectionOfferExpirationPrefix(expiration time.Time, id uint64) []byte {
+	return append(append(PrefixCollectionOfferExpiration, sdk.FormatTimeBytes(expiration)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeySealedBidPrefix returns the key of a sealed bid, or the prefix of all sealed
+// bids of an auction when bidder is empty
+func KeySealedBidPrefix(auctionId uint64, bidder sdk.AccAddress) []byte {
+	return append(append(PrefixSealedBid, sdk.Uint64ToBigEndian(auctionId)...), bidder.Bytes()...)
+}
+
+func KeyAuctionReservePricePrefix(id uint64) []byte {
+	return append(PrefixAuctionReservePrice, sdk.Uint64ToBigEndian(id)...)
+}
+
+// invertedAmountBytes encodes an amount as fixed length bytes with inverted bits,
+// so that ascending key order gives descending amounts
+func invertedAmountBytes(amount sdkmath.Int) []byte {
+	bz := make([]byte, sdkmath.MaxBitLen/8)
+	amount.BigInt().FillBytes(bz)
+	for i := range bz {
+		bz[i] = ^bz[i]
+	}
+	return bz
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -21,6 +22,23 @@ const (
 	TypeMsgCreateAuction = "create_auction"
 	TypeMsgCancelAuction = "cancel_auction"
 	TypeMsgPlaceBid      = "place_bid"
+	TypeMsgMakeOffer     = "make_offer"
+	TypeMsgCancelOffer   = "cancel_offer"
+	TypeMsgAcceptOffer   = "accept_offer"
+	TypeMsgRejectOffer   = "reject_offer"
+
+	TypeMsgMakeCollectionOffer   = "make_collection_offer"
+	TypeMsgCancelCollectionOffer = "cancel_collection_offer"
+	TypeMsgSellToCollectionOffer = "sell_to_collection_offer"
+
+	TypeMsgCreateDutchAuction = "create_dutch_auction"
+	TypeMsgBuyDutchAuction    = "buy_dutch_auction"
+
+	TypeMsgCreateSealedBidAuction = "create_sealed_bid_auction"
+	TypeMsgCommitSealedBid        = "commit_sealed_bid"
+	TypeMsgRevealSealedBid        = "reveal_sealed_bid"
+
+	TypeMsgRevealReservePrice = "reveal_reserve_price"
 
 	// DoNotModify used to indicate that some field should not be updated
 	DoNotModify = "[do-not-modify]"
@@ -36,6 +54,19 @@ var (
 	_ sdk.Msg = &MsgCreateAuction{}
 	_ sdk.Msg = &MsgCancelAuction{}
 	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgMakeOffer{}
+	_ sdk.Msg = &MsgCancelOffer{}
+	_ sdk.Msg = &MsgAcceptOffer{}
+	_ sdk.Msg = &MsgRejectOffer{}
+	_ sdk.Msg = &MsgMakeCollectionOffer{}
+	_ sdk.Msg = &MsgCancelCollectionOffer{}
+	_ sdk.Msg = &MsgSellToCollectionOffer{}
+	_ sdk.Msg = &MsgCreateDutchAuction{}
+	_ sdk.Msg = &MsgBuyDutchAuction{}
+	_ sdk.Msg = &MsgCreateSealedBidAuction{}
+	_ sdk.Msg = &MsgCommitSealedBid{}
+	_ sdk.Msg = &MsgRevealSealedBid{}
+	_ sdk.Msg = &MsgRevealReservePrice{}
 )
 
 func NewMsgListNFT(denomId, nftId string, price sdk.Coin, owner sdk.AccAddress, splitShares []WeightedAddress) *MsgListNFT {
@@ -211,6 +242,21 @@ func (msg MsgCreateAuction) ValidateBasic() error {
 	if !msg.IncrementPercentage.IsPositive() || !msg.IncrementPercentage.LTE(sdkmath.LegacyNewDec(1)) {
 		return errorsmod.Wrapf(ErrInvalidPercentage, "invalid percentage value (%s)", msg.IncrementPercentage.String())
 	}
+	if msg.SoftClose != nil {
+		if msg.Duration == nil {
+			return errorsmod.Wrapf(ErrInvalidDuration, "soft close requires a duration")
+		}
+		if msg.SoftClose.Extended != 0 {
+			return errorsmod.Wrapf(ErrInvalidDuration, "soft close extended must be zero")
+		}
+		if err = ValidateSoftClose(*msg.SoftClose); err != nil {
+			return err
+		}
+	}
+	if len(msg.ReservePriceCommitment) > 0 && len(msg.ReservePriceCommitment) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidReservePrice, "reserve price commitment must be a %d bytes sha256 hash",
+			sha256.Size)
+	}
 	if err = ValidateSplitShares(msg.SplitShares); err != nil {
 		return err
 	}
@@ -299,6 +345,504 @@ func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// Offer messages
+
+func NewMsgMakeOffer(denomId, nftId string, amount sdk.Coin, duration time.Duration, bidder sdk.AccAddress) *MsgMakeOffer {
+	return &MsgMakeOffer{
+		NftId:    nftId,
+		DenomId:  denomId,
+		Amount:   amount,
+		Duration: duration,
+		Bidder:   bidder.String(),
+	}
+}
+
+func (msg MsgMakeOffer) Route() string { return MsgRoute }
+
+func (msg MsgMakeOffer) Type() string { return TypeMsgMakeOffer }
+
+func (msg MsgMakeOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	if len(msg.DenomId) == 0 || len(msg.NftId) == 0 {
+		return errorsmod.Wrapf(ErrInvalidNftId, "denom id and nft id are required")
+	}
+	if err := ValidatePrice(msg.Amount); err != nil {
+		return err
+	}
+	return ValidateDuration(&msg.Duration)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMakeOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelOffer(offerId uint64, bidder sdk.AccAddress) *MsgCancelOffer {
+	return &MsgCancelOffer{
+		OfferId: offerId,
+		Bidder:  bidder.String(),
+	}
+}
+
+func (msg MsgCancelOffer) Route() string { return MsgRoute }
+
+func (msg MsgCancelOffer) Type() string { return TypeMsgCancelOffer }
+
+func (msg MsgCancelOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	return validateOfferId(msg.OfferId)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgAcceptOffer(offerId uint64, owner sdk.AccAddress, splitShares []WeightedAddress) *MsgAcceptOffer {
+	return &MsgAcceptOffer{
+		OfferId:     offerId,
+		Owner:       owner.String(),
+		SplitShares: splitShares,
+	}
+}
+
+func (msg MsgAcceptOffer) Route() string { return MsgRoute }
+
+func (msg MsgAcceptOffer) Type() string { return TypeMsgAcceptOffer }
+
+func (msg MsgAcceptOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if err := validateOfferId(msg.OfferId); err != nil {
+		return err
+	}
+	return ValidateSplitShares(msg.SplitShares)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRejectOffer(offerId uint64, owner sdk.AccAddress) *MsgRejectOffer {
+	return &MsgRejectOffer{
+		OfferId: offerId,
+		Owner:   owner.String(),
+	}
+}
+
+func (msg MsgRejectOffer) Route() string { return MsgRoute }
+
+func (msg MsgRejectOffer) Type() string { return TypeMsgRejectOffer }
+
+func (msg MsgRejectOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return validateOfferId(msg.OfferId)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRejectOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// Collection offer messages
+
+func NewMsgMakeCollectionOffer(denomId string, price sdk.Coin, quantity uint64, duration time.Duration,
+	bidder sdk.AccAddress,
+) *MsgMakeCollectionOffer {
+	return &MsgMakeCollectionOffer{
+		DenomId:  denomId,
+		Price:    price,
+		Quantity: quantity,
+		Duration: duration,
+		Bidder:   bidder.String(),
+	}
+}
+
+func (msg MsgMakeCollectionOffer) Route() string { return MsgRoute }
+
+func (msg MsgMakeCollectionOffer) Type() string { return TypeMsgMakeCollectionOffer }
+
+func (msg MsgMakeCollectionOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	if len(msg.DenomId) == 0 {
+		return errorsmod.Wrapf(ErrInvalidNftId, "denom id is required")
+	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return err
+	}
+	if err := validateQuantity(msg.Quantity); err != nil {
+		return err
+	}
+	return ValidateDuration(&msg.Duration)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMakeCollectionOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelCollectionOffer(offerId uint64, bidder sdk.AccAddress) *MsgCancelCollectionOffer {
+	return &MsgCancelCollectionOffer{
+		OfferId: offerId,
+		Bidder:  bidder.String(),
+	}
+}
+
+func (msg MsgCancelCollectionOffer) Route() string { return MsgRoute }
+
+func (msg MsgCancelCollectionOffer) Type() string { return TypeMsgCancelCollectionOffer }
+
+func (msg MsgCancelCollectionOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	return validateOfferId(msg.OfferId)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelCollectionOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSellToCollectionOffer(offerId uint64, nftId string, seller sdk.AccAddress,
+	splitShares []WeightedAddress,
+) *MsgSellToCollectionOffer {
+	return &MsgSellToCollectionOffer{
+		OfferId:     offerId,
+		NftId:       nftId,
+		Seller:      seller.String(),
+		SplitShares: splitShares,
+	}
+}
+
+func (msg MsgSellToCollectionOffer) Route() string { return MsgRoute }
+
+func (msg MsgSellToCollectionOffer) Type() string { return TypeMsgSellToCollectionOffer }
+
+func (msg MsgSellToCollectionOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address (%s)", err)
+	}
+	if err := validateOfferId(msg.OfferId); err != nil {
+		return err
+	}
+	if len(msg.NftId) == 0 {
+		return errorsmod.Wrapf(ErrInvalidNftId, "nft id is required")
+	}
+	return ValidateSplitShares(msg.SplitShares)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSellToCollectionOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateDutchAuction(denomId, nftId string, startTime time.Time, duration time.Duration,
+	startPrice, floorPrice sdk.Coin, decayType DecayType, stepInterval time.Duration, owner sdk.AccAddress,
+	whitelistAccounts []string, splitShares []WeightedAddress,
+) *MsgCreateDutchAuction {
+	return &MsgCreateDutchAuction{
+		NftId:             nftId,
+		DenomId:           denomId,
+		StartTime:         startTime,
+		StartPrice:        startPrice,
+		Duration:          duration,
+		FloorPrice:        floorPrice,
+		DecayType:         decayType,
+		StepInterval:      stepInterval,
+		WhitelistAccounts: whitelistAccounts,
+		SplitShares:       splitShares,
+		Owner:             owner.String(),
+	}
+}
+
+func (msg MsgCreateDutchAuction) Route() string { return MsgRoute }
+
+func (msg MsgCreateDutchAuction) Type() string { return TypeMsgCreateDutchAuction }
+
+func (msg MsgCreateDutchAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err = ValidatePrice(msg.StartPrice); err != nil {
+		return err
+	}
+	dutchAuction := NewDutchAuction(msg.FloorPrice, msg.DecayType, msg.StepInterval)
+	if err = ValidateDutchAuction(msg.StartPrice, dutchAuction, msg.Duration); err != nil {
+		return err
+	}
+	if err = ValidateSplitShares(msg.SplitShares); err != nil {
+		return err
+	}
+	return ValidateWhiteListAccounts(msg.WhitelistAccounts)
+}
+
+func (msg MsgCreateDutchAuction) Validate(now time.Time) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.StartTime.Before(now) {
+		return errorsmod.Wrapf(ErrInvalidStartTime, "start time must be after current time %s", now.String())
+	}
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateDutchAuction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgBuyDutchAuction(auctionId uint64, maxPrice sdk.Coin, buyer sdk.AccAddress) *MsgBuyDutchAuction {
+	return &MsgBuyDutchAuction{
+		AuctionId: auctionId,
+		MaxPrice:  maxPrice,
+		Buyer:     buyer.String(),
+	}
+}
+
+func (msg MsgBuyDutchAuction) Route() string { return MsgRoute }
+
+func (msg MsgBuyDutchAuction) Type() string { return TypeMsgBuyDutchAuction }
+
+func (msg MsgBuyDutchAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+	if err := validateAuctionId(msg.AuctionId); err != nil {
+		return err
+	}
+	return ValidatePrice(msg.MaxPrice)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBuyDutchAuction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateSealedBidAuction(denomId, nftId string, startTime time.Time, minPrice sdk.Coin,
+	bidDuration, revealDuration time.Duration, settlementType SettlementType, forfeitPercentage sdkmath.LegacyDec,
+	owner sdk.AccAddress, whitelistAccounts []string, splitShares []WeightedAddress,
+) *MsgCreateSealedBidAuction {
+	return &MsgCreateSealedBidAuction{
+		NftId:             nftId,
+		DenomId:           denomId,
+		StartTime:         startTime,
+		MinPrice:          minPrice,
+		BidDuration:       bidDuration,
+		RevealDuration:    revealDuration,
+		SettlementType:    settlementType,
+		ForfeitPercentage: forfeitPercentage,
+		WhitelistAccounts: whitelistAccounts,
+		SplitShares:       splitShares,
+		Owner:             owner.String(),
+	}
+}
+
+func (msg MsgCreateSealedBidAuction) Route() string { return MsgRoute }
+
+func (msg MsgCreateSealedBidAuction) Type() string { return TypeMsgCreateSealedBidAuction }
+
+func (msg MsgCreateSealedBidAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err = ValidatePrice(msg.MinPrice); err != nil {
+		return err
+	}
+	if err = ValidateDuration(&msg.BidDuration); err != nil {
+		return err
+	}
+	sealedBidAuction := NewSealedBidAuction(time.Time{}, msg.SettlementType, msg.ForfeitPercentage)
+	if err = ValidateSealedBidAuction(sealedBidAuction, msg.RevealDuration); err != nil {
+		return err
+	}
+	if err = ValidateSplitShares(msg.SplitShares); err != nil {
+		return err
+	}
+	return ValidateWhiteListAccounts(msg.WhitelistAccounts)
+}
+
+func (msg MsgCreateSealedBidAuction) Validate(now time.Time) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.StartTime.Before(now) {
+		return errorsmod.Wrapf(ErrInvalidStartTime, "start time must be after current time %s", now.String())
+	}
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateSealedBidAuction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCommitSealedBid(auctionId uint64, commitment []byte, deposit sdk.Coin,
+	bidder sdk.AccAddress,
+) *MsgCommitSealedBid {
+	return &MsgCommitSealedBid{
+		AuctionId:  auctionId,
+		Commitment: commitment,
+		Deposit:    deposit,
+		Bidder:     bidder.String(),
+	}
+}
+
+func (msg MsgCommitSealedBid) Route() string { return MsgRoute }
+
+func (msg MsgCommitSealedBid) Type() string { return TypeMsgCommitSealedBid }
+
+func (msg MsgCommitSealedBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	if err := validateAuctionId(msg.AuctionId); err != nil {
+		return err
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidCommitment, "commitment must be a %d bytes sha256 hash", sha256.Size)
+	}
+	return ValidatePrice(msg.Deposit)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCommitSealedBid) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevealSealedBid(auctionId uint64, amount sdk.Coin, salt string, bidder sdk.AccAddress) *MsgRevealSealedBid {
+	return &MsgRevealSealedBid{
+		AuctionId: auctionId,
+		Amount:    amount,
+		Salt:      salt,
+		Bidder:    bidder.String(),
+	}
+}
+
+func (msg MsgRevealSealedBid) Route() string { return MsgRoute }
+
+func (msg MsgRevealSealedBid) Type() string { return TypeMsgRevealSealedBid }
+
+func (msg MsgRevealSealedBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	if err := validateAuctionId(msg.AuctionId); err != nil {
+		return err
+	}
+	return ValidatePrice(msg.Amount)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealSealedBid) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevealReservePrice(auctionId uint64, reservePrice sdk.Coin, salt string,
+	owner sdk.AccAddress,
+) *MsgRevealReservePrice {
+	return &MsgRevealReservePrice{
+		AuctionId:    auctionId,
+		ReservePrice: reservePrice,
+		Salt:         salt,
+		Owner:        owner.String(),
+	}
+}
+
+func (msg MsgRevealReservePrice) Route() string { return MsgRoute }
+
+func (msg MsgRevealReservePrice) Type() string { return TypeMsgRevealReservePrice }
+
+func (msg MsgRevealReservePrice) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if err := validateAuctionId(msg.AuctionId); err != nil {
+		return err
+	}
+	return ValidatePrice(msg.ReservePrice)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealReservePrice) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
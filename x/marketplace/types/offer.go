@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/marketplace/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+var (
+	_ proto.Message   = &Offer{}
+	_ proto.Message   = &CollectionOffer{}
+	_ exported.OfferI = &Offer{}
+)
+
+func NewOffer(id uint64, nftId, denomId string, amount sdk.Coin, bidder, owner sdk.AccAddress,
+	createdAt, expiration time.Time,
+) Offer {
+	return Offer{
+		Id:         id,
+		NftId:      nftId,
+		DenomId:    denomId,
+		Bidder:     bidder.String(),
+		Owner:      owner.String(),
+		Amount:     amount,
+		CreatedAt:  createdAt,
+		Expiration: expiration,
+	}
+}
+
+func (o Offer) GetId() uint64 {
+	return o.Id
+}
+
+func (o Offer) GetDenomId() string {
+	return o.DenomId
+}
+
+func (o Offer) GetNftId() string {
+	return o.NftId
+}
+
+func (o Offer) GetAmount() sdk.Coin {
+	return o.Amount
+}
+
+func (o Offer) GetBidder() sdk.AccAddress {
+	bidder, _ := sdk.AccAddressFromBech32(o.Bidder)
+	return bidder
+}
+
+func (o Offer) GetOwner() sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(o.Owner)
+	return owner
+}
+
+func (o Offer) GetExpiration() time.Time {
+	return o.Expiration
+}
+
+// IsExpired returns true if the offer can no longer be accepted at given time
+func (o Offer) IsExpired(now time.Time) bool {
+	return !now.Before(o.Expiration)
+}
+
+func NewCollectionOffer(id uint64, denomId string, price sdk.Coin, quantity uint64, bidder sdk.AccAddress,
+	createdAt, expiration time.Time,
+) CollectionOffer {
+	return CollectionOffer{
+		Id:         id,
+		DenomId:    denomId,
+		Bidder:     bidder.String(),
+		Price:      price,
+		Quantity:   quantity,
+		CreatedAt:  createdAt,
+		Expiration: expiration,
+	}
+}
+
+func (o CollectionOffer) GetBidder() sdk.AccAddress {
+	bidder, _ := sdk.AccAddressFromBech32(o.Bidder)
+	return bidder
+}
+
+// Remaining returns the number of nfts that can still be sold into the offer
+func (o CollectionOffer) Remaining() uint64 {
+	if o.Filled >= o.Quantity {
+		return 0
+	}
+	return o.Quantity - o.Filled
+}
+
+// Escrowed returns the amount held in escrow for the unfilled quantity
+func (o CollectionOffer) Escrowed() sdk.Coin {
+	return sdk.NewCoin(o.Price.Denom, o.Price.Amount.Mul(sdkmath.NewIntFromUint64(o.Remaining())))
+}
+
+// IsExpired returns true if the offer can no longer be filled at given time
+func (o CollectionOffer) IsExpired(now time.Time) bool {
+	return !now.Before(o.Expiration)
+}
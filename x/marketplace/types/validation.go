@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -117,8 +118,42 @@ func ValidateAuctionListing(auction AuctionListing) error {
 	if err := ValidatePrice(auction.StartPrice); err != nil {
 		return err
 	}
-	if err := validateIncrementPercentage(auction.IncrementPercentage); err != nil {
-		return err
+	if auction.AuctionType != AUCTION_TYPE_ENGLISH && (auction.SoftClose != nil || auction.HasReservePrice) {
+		return errorsmod.Wrapf(ErrInvalidAuctionType,
+			"soft close and reserve price are only allowed on english auctions")
+	}
+	switch auction.AuctionType {
+	case AUCTION_TYPE_ENGLISH:
+		if err := validateIncrementPercentage(auction.IncrementPercentage); err != nil {
+			return err
+		}
+		if auction.SoftClose != nil {
+			if auction.EndTime == nil {
+				return errorsmod.Wrapf(ErrInvalidDuration, "soft close of auction %d requires an end time", auction.Id)
+			}
+			if err := ValidateSoftClose(*auction.SoftClose); err != nil {
+				return err
+			}
+		}
+	case AUCTION_TYPE_DUTCH:
+		if auction.DutchAuction == nil || auction.EndTime == nil {
+			return errorsmod.Wrapf(ErrInvalidAuctionType, "dutch auction %d requires dutch params and end time", auction.Id)
+		}
+		if err := ValidateDutchAuction(auction.StartPrice, *auction.DutchAuction,
+			auction.EndTime.Sub(auction.StartTime)); err != nil {
+			return err
+		}
+	case AUCTION_TYPE_SEALED_BID:
+		if auction.SealedBidAuction == nil || auction.EndTime == nil {
+			return errorsmod.Wrapf(ErrInvalidAuctionType,
+				"sealed bid auction %d requires sealed bid params and end time", auction.Id)
+		}
+		if err := ValidateSealedBidAuction(*auction.SealedBidAuction,
+			auction.SealedBidAuction.RevealEndTime.Sub(*auction.EndTime)); err != nil {
+			return err
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidAuctionType, "invalid auction type %s", auction.AuctionType)
 	}
 	if err := ValidateSplitShares(auction.SplitShares); err != nil {
 		return err
@@ -129,6 +164,114 @@ func ValidateAuctionListing(auction AuctionListing) error {
 	return nil
 }
 
+// ValidateDutchAuction checks the floor price and decay schedule of a dutch auction
+// running for given duration
+func ValidateDutchAuction(startPrice sdk.Coin, dutchAuction DutchAuction, duration time.Duration) error {
+	if err := ValidateDuration(&duration); err != nil {
+		return err
+	}
+	floorPrice := dutchAuction.FloorPrice
+	if err := ValidatePrice(floorPrice); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFloorPrice, "invalid floor price %s, only accepts positive amount", floorPrice)
+	}
+	if floorPrice.Denom != startPrice.Denom {
+		return errorsmod.Wrapf(ErrInvalidFloorPrice,
+			"floor price denom %s must match start price denom %s", floorPrice.Denom, startPrice.Denom)
+	}
+	if !floorPrice.IsLT(startPrice) {
+		return errorsmod.Wrapf(ErrInvalidFloorPrice,
+			"floor price %s must be less than start price %s", floorPrice, startPrice)
+	}
+	switch dutchAuction.DecayType {
+	case DECAY_TYPE_LINEAR:
+		if dutchAuction.StepInterval != 0 {
+			return errorsmod.Wrapf(ErrInvalidDuration, "step interval is only allowed for stepped decay")
+		}
+	case DECAY_TYPE_STEPPED:
+		if dutchAuction.StepInterval <= 0 || dutchAuction.StepInterval > duration {
+			return errorsmod.Wrapf(ErrInvalidDuration,
+				"step interval %s must be positive and not exceed duration %s", dutchAuction.StepInterval, duration)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidAuctionType, "invalid decay type %s", dutchAuction.DecayType)
+	}
+	return nil
+}
+
+// ValidateSoftClose checks the window, extension and cap of a soft close
+func ValidateSoftClose(softClose SoftClose) error {
+	if softClose.Window <= 0 || softClose.Extension <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDuration, "soft close window %s and extension %s must be positive",
+			softClose.Window, softClose.Extension)
+	}
+	if softClose.MaxExtension < softClose.Extension {
+		return errorsmod.Wrapf(ErrInvalidDuration, "soft close max extension %s must be at least extension %s",
+			softClose.MaxExtension, softClose.Extension)
+	}
+	if softClose.Extended < 0 || softClose.Extended > softClose.MaxExtension {
+		return errorsmod.Wrapf(ErrInvalidDuration, "soft close extended %s must be between 0 and max extension %s",
+			softClose.Extended, softClose.MaxExtension)
+	}
+	return nil
+}
+
+// ValidateReservePrice checks the reserve price is above the start price of an auction
+func ValidateReservePrice(reservePrice, startPrice sdk.Coin) error {
+	if err := ValidatePrice(reservePrice); err != nil {
+		return errorsmod.Wrapf(ErrInvalidReservePrice, "invalid reserve price %s, only accepts positive amount",
+			reservePrice)
+	}
+	if reservePrice.Denom != startPrice.Denom || !startPrice.IsLT(reservePrice) {
+		return errorsmod.Wrapf(ErrInvalidReservePrice,
+			"reserve price %s must be greater than start price %s", reservePrice, startPrice)
+	}
+	return nil
+}
+
+// ValidateSealedBidAuction checks the settlement type, forfeit percentage and
+// reveal duration of a sealed bid auction
+func ValidateSealedBidAuction(sealedBidAuction SealedBidAuction, revealDuration time.Duration) error {
+	if err := ValidateDuration(&revealDuration); err != nil {
+		return err
+	}
+	if _, ok := SettlementType_name[int32(sealedBidAuction.SettlementType)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidAuctionType, "invalid settlement type %s", sealedBidAuction.SettlementType)
+	}
+	forfeit := sealedBidAuction.ForfeitPercentage
+	if forfeit.IsNil() || !forfeit.IsPositive() || forfeit.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidPercentage, "invalid forfeit percentage (%s)", forfeit)
+	}
+	return nil
+}
+
+// ValidateSealedBid checks sealed bid is valid or not
+func ValidateSealedBid(bid SealedBid) error {
+	if _, err := sdk.AccAddressFromBech32(bid.Bidder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", bid.Bidder)
+	}
+	if err := validateAuctionId(bid.AuctionId); err != nil {
+		return err
+	}
+	if len(bid.Commitment) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidCommitment, "commitment must be a %d bytes sha256 hash", sha256.Size)
+	}
+	if err := ValidatePrice(bid.Deposit); err != nil {
+		return err
+	}
+	if bid.Revealed {
+		if err := ValidatePrice(bid.Amount); err != nil {
+			return err
+		}
+		if bid.Amount.Denom != bid.Deposit.Denom || bid.Deposit.IsLT(bid.Amount) {
+			return errorsmod.Wrapf(ErrInvalidPrice, "revealed amount %s exceeds deposit %s", bid.Amount, bid.Deposit)
+		}
+	}
+	if bid.Time.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidTime, "invalid time (%s)", bid.Time.String())
+	}
+	return nil
+}
+
 // ValidateBid checks bid is valid or not
 func ValidateBid(bid Bid) error {
 	if len(bid.Bidder) > 0 {
@@ -144,3 +287,68 @@ func ValidateBid(bid Bid) error {
 	}
 	return nil
 }
+
+func validateOfferId(id uint64) error {
+	if id == 0 {
+		return errorsmod.Wrapf(ErrInvalidOfferId, "invalid offer id (%d)", id)
+	}
+	return nil
+}
+
+// ValidateOffer checks offer is valid or not
+func ValidateOffer(offer Offer) error {
+	if err := validateOfferId(offer.Id); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(offer.Bidder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", offer.Bidder)
+	}
+	if _, err := sdk.AccAddressFromBech32(offer.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", offer.Owner)
+	}
+	if len(offer.DenomId) == 0 || len(offer.NftId) == 0 {
+		return errorsmod.Wrapf(ErrInvalidNftId, "denom id and nft id are required for offer %d", offer.Id)
+	}
+	if err := ValidatePrice(offer.Amount); err != nil {
+		return err
+	}
+	if offer.CreatedAt.IsZero() || !offer.Expiration.After(offer.CreatedAt) {
+		return errorsmod.Wrapf(ErrInvalidTime, "invalid expiration (%s) for offer %d", offer.Expiration.String(), offer.Id)
+	}
+	return nil
+}
+
+func validateQuantity(quantity uint64) error {
+	if quantity == 0 || quantity > MaxCollectionOfferQuantity {
+		return errorsmod.Wrapf(ErrInvalidQuantity,
+			"invalid quantity %d, only accepts value [1, %d]", quantity, MaxCollectionOfferQuantity)
+	}
+	return nil
+}
+
+// ValidateCollectionOffer checks collection offer is valid or not
+func ValidateCollectionOffer(offer CollectionOffer) error {
+	if err := validateOfferId(offer.Id); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(offer.Bidder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", offer.Bidder)
+	}
+	if len(offer.DenomId) == 0 {
+		return errorsmod.Wrapf(ErrInvalidNftId, "denom id is required for collection offer %d", offer.Id)
+	}
+	if err := ValidatePrice(offer.Price); err != nil {
+		return err
+	}
+	if err := validateQuantity(offer.Quantity); err != nil {
+		return err
+	}
+	if offer.Filled >= offer.Quantity {
+		return errorsmod.Wrapf(ErrOfferFilled, "collection offer %d is filled", offer.Id)
+	}
+	if offer.CreatedAt.IsZero() || !offer.Expiration.After(offer.CreatedAt) {
+		return errorsmod.Wrapf(ErrInvalidTime, "invalid expiration (%s) for collection offer %d",
+			offer.Expiration.String(), offer.Id)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package bindings_test
+
+import (
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/medianode/bindings/types"
+	"github.com/OmniFlix/omniflixhub/v6/x/medianode/types"
+)
+
+const testMediaNodeId = "mnreflect1"
+
+// createTestMediaNode sets an active media node of the owner priced at 100uflix per hour
+func createTestMediaNode(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, owner sdk.AccAddress) {
+	t.Helper()
+
+	customApp.MedianodeKeeper.SetMediaNode(ctx, types.MediaNode{
+		Id:            testMediaNodeId,
+		Url:           "https://medianode.reflect",
+		HardwareSpecs: types.HardwareSpecs{Cpus: 4, RamInGb: 16, StorageInGb: 500},
+		Owner:         owner.String(),
+		PricePerHour:  sdk.NewInt64Coin("uflix", 100),
+		Status:        types.STATUS_ACTIVE,
+		RegisteredAt:  ctx.BlockTime(),
+		Info:          types.Info{Moniker: "reflect"},
+	})
+}
+
+func TestLeaseMediaNodeMsg(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	require.NotEmpty(t, reflect)
+	createTestMediaNode(t, ctx, customApp, creator)
+
+	// the lease amount is paid by the contract
+	msg := bindings.MedianodeMsg{LeaseMediaNode: &bindings.LeaseMediaNode{
+		MediaNodeId: testMediaNodeId,
+		LeaseHours:  2,
+		Amount:      wasmvmtypes.Coin{Denom: "uflix", Amount: "200"},
+	}}
+	err := executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.Error(t, err)
+
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(sdk.NewInt64Coin("uflix", 300)))
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+
+	lease, found := customApp.MedianodeKeeper.GetMediaNodeLease(ctx, testMediaNodeId)
+	require.True(t, found)
+	require.Equal(t, reflect.String(), lease.Lessee)
+	require.Equal(t, uint64(2), lease.LeasedHours)
+	require.Equal(t, int64(100), customApp.BankKeeper.GetBalance(ctx, reflect, "uflix").Amount.Int64())
+}
+
+func TestExtendAndCancelLeaseMsgs(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	other := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	createTestMediaNode(t, ctx, customApp, creator)
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(sdk.NewInt64Coin("uflix", 300)))
+
+	err := executeCustom(t, ctx, customApp, reflect, lucky, bindings.MedianodeMsg{LeaseMediaNode: &bindings.LeaseMediaNode{
+		MediaNodeId: testMediaNodeId,
+		LeaseHours:  1,
+		Amount:      wasmvmtypes.Coin{Denom: "uflix", Amount: "100"},
+	}})
+	require.NoError(t, err)
+
+	err = executeCustom(t, ctx, customApp, reflect, lucky, bindings.MedianodeMsg{ExtendLease: &bindings.ExtendLease{
+		MediaNodeId: testMediaNodeId,
+		LeaseHours:  2,
+		Amount:      wasmvmtypes.Coin{Denom: "uflix", Amount: "200"},
+	}})
+	require.NoError(t, err)
+
+	lease, found := customApp.MedianodeKeeper.GetMediaNodeLease(ctx, testMediaNodeId)
+	require.True(t, found)
+	require.Equal(t, uint64(3), lease.LeasedHours)
+	require.Equal(t, int64(300), lease.TotalLeaseAmount.Amount.Int64())
+
+	// only the lessee can cancel the lease
+	cancelMsg := bindings.MedianodeMsg{CancelLease: &bindings.CancelLease{MediaNodeId: testMediaNodeId}}
+	err = executeCustom(t, ctx, customApp, other, lucky, cancelMsg)
+	require.Error(t, err)
+
+	// the unused amount is refunded to the contract
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	err = executeCustom(t, ctx, customApp, reflect, lucky, cancelMsg)
+	require.NoError(t, err)
+
+	_, found = customApp.MedianodeKeeper.GetMediaNodeLease(ctx, testMediaNodeId)
+	require.False(t, found)
+	require.Equal(t, int64(250), customApp.BankKeeper.GetBalance(ctx, reflect, "uflix").Amount.Int64())
+}
+
+func executeCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, sender sdk.AccAddress, msg bindings.MedianodeMsg) error {
+	t.Helper()
+
+	return wasmtesting.ExecuteCustom(t, ctx, customApp, contract, sender, bindings.MedianodeCustomMsg{Medianode: &msg})
+}
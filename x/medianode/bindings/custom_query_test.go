@@ -0,0 +1,58 @@
+package bindings_test
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/medianode/bindings/types"
+)
+
+func TestQueryMediaNode(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	createTestMediaNode(t, ctx, customApp, creator)
+
+	mediaNodeResp := bindings.MediaNodeResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.MedianodeQuery{
+		MediaNode: &bindings.GetMediaNode{Id: testMediaNodeId},
+	}, &mediaNodeResp)
+	require.Equal(t, testMediaNodeId, mediaNodeResp.MediaNode.Id)
+	require.Equal(t, creator.String(), mediaNodeResp.MediaNode.Owner)
+	require.Equal(t, "active", mediaNodeResp.MediaNode.Status)
+	require.Equal(t, int64(16), mediaNodeResp.MediaNode.HardwareSpecs.RamInGb)
+	require.False(t, mediaNodeResp.MediaNode.Leased)
+
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(sdk.NewInt64Coin("uflix", 100)))
+	err := executeCustom(t, ctx, customApp, reflect, lucky, bindings.MedianodeMsg{LeaseMediaNode: &bindings.LeaseMediaNode{
+		MediaNodeId: testMediaNodeId,
+		LeaseHours:  1,
+		Amount:      wasmvmtypes.Coin{Denom: "uflix", Amount: "100"},
+	}})
+	require.NoError(t, err)
+
+	leaseResp := bindings.LeaseResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.MedianodeQuery{
+		Lease: &bindings.GetLease{MediaNodeId: testMediaNodeId},
+	}, &leaseResp)
+	require.Equal(t, testMediaNodeId, leaseResp.Lease.MediaNodeId)
+	require.Equal(t, reflect.String(), leaseResp.Lease.Lessee)
+	require.Equal(t, creator.String(), leaseResp.Lease.Owner)
+	require.Equal(t, wasmvmtypes.Coin{Denom: "uflix", Amount: "100"}, leaseResp.Lease.TotalLeaseAmount)
+	require.Equal(t, wasmvmtypes.Coin{Denom: "uflix", Amount: "0"}, leaseResp.Lease.SettledLeaseAmount)
+	require.Equal(t, uint64(ctx.BlockTime().Unix()), leaseResp.Lease.StartTime)
+}
+
+func queryCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, request bindings.MedianodeQuery, response interface{}) {
+	t.Helper()
+
+	wasmtesting.QueryCustom(t, ctx, customApp, contract, bindings.MedianodeCustomQuery{Medianode: &request}, response)
+}
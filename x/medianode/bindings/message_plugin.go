@@ -0,0 +1,149 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/medianode/bindings/types"
+	medianodekeeper "github.com/OmniFlix/omniflixhub/v6/x/medianode/keeper"
+	medianodetypes "github.com/OmniFlix/omniflixhub/v6/x/medianode/types"
+)
+
+// CustomMessageDecorator returns decorator for medianode custom CosmWasm bindings messages
+func CustomMessageDecorator(medianode *medianodekeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:   old,
+			medianode: medianode,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped   wasmkeeper.Messenger
+	medianode *medianodekeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom != nil {
+		// only handle messages namespaced under medianode,
+		// leave everything else for the wrapped version
+		var customMsg bindingstypes.MedianodeCustomMsg
+		if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+			return nil, nil, [][]*codectypes.Any{}, errorsmod.Wrap(err, "medianode msg")
+		}
+
+		if contractMsg := customMsg.Medianode; contractMsg != nil {
+			var (
+				data []byte
+				err  error
+			)
+			switch {
+			case contractMsg.LeaseMediaNode != nil:
+				data, err = PerformLeaseMediaNode(m.medianode, ctx, contractAddr, contractMsg.LeaseMediaNode)
+			case contractMsg.ExtendLease != nil:
+				data, err = PerformExtendLease(m.medianode, ctx, contractAddr, contractMsg.ExtendLease)
+			case contractMsg.CancelLease != nil:
+				data, err = PerformCancelLease(m.medianode, ctx, contractAddr, contractMsg.CancelLease)
+			default:
+				return nil, nil, [][]*codectypes.Any{}, wasmvmtypes.UnsupportedRequest{Kind: "unknown medianode msg variant"}
+			}
+			if err != nil {
+				return nil, nil, [][]*codectypes.Any{}, err
+			}
+			return nil, [][]byte{data}, [][]*codectypes.Any{}, nil
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// PerformLeaseMediaNode leases a media node, the lease amount is paid by the contract.
+func PerformLeaseMediaNode(k *medianodekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, lease *bindingstypes.LeaseMediaNode) ([]byte, error) {
+	if lease == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lease media node null lease"}
+	}
+	amount, err := parseCoin(lease.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msgLeaseMediaNode := medianodetypes.NewMsgLeaseMediaNode(lease.MediaNodeId, lease.LeaseHours, amount, contractAddr.String())
+	if err := msgLeaseMediaNode.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgLeaseMediaNode")
+	}
+
+	msgServer := medianodekeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.LeaseMediaNode(ctx, msgLeaseMediaNode)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "leasing media node")
+	}
+	return resp.Marshal()
+}
+
+// PerformExtendLease extends a lease of the contract, the extension amount is paid by the contract.
+func PerformExtendLease(k *medianodekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, extend *bindingstypes.ExtendLease) ([]byte, error) {
+	if extend == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "extend lease null extend"}
+	}
+	amount, err := parseCoin(extend.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	msgExtendLease := medianodetypes.NewMsgExtendLease(extend.MediaNodeId, extend.LeaseHours, amount, contractAddr.String())
+	if err := msgExtendLease.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgExtendLease")
+	}
+
+	msgServer := medianodekeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.ExtendLease(ctx, msgExtendLease)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "extending lease")
+	}
+	return resp.Marshal()
+}
+
+// PerformCancelLease cancels a lease of the contract.
+func PerformCancelLease(k *medianodekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, cancel *bindingstypes.CancelLease) ([]byte, error) {
+	if cancel == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "cancel lease null cancel"}
+	}
+
+	msgCancelLease := medianodetypes.NewMsgCancelLease(cancel.MediaNodeId, contractAddr.String())
+	if err := msgCancelLease.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgCancelLease")
+	}
+
+	msgServer := medianodekeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.CancelLease(ctx, msgCancelLease)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "cancelling lease")
+	}
+	return resp.Marshal()
+}
+
+// parseCoin converts a wasm vm type coin to a sdk type coin
+func parseCoin(coin wasmvmtypes.Coin) (sdk.Coin, error) {
+	amount, ok := sdkmath.NewIntFromString(coin.Amount)
+	if !ok {
+		return sdk.Coin{}, wasmvmtypes.InvalidRequest{Err: "invalid coin amount " + coin.Amount}
+	}
+	return sdk.Coin{Denom: coin.Denom, Amount: amount}, nil
+}
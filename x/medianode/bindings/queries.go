@@ -0,0 +1,86 @@
+package bindings
+
+import (
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/medianode/bindings/types"
+	medianodekeeper "github.com/OmniFlix/omniflixhub/v6/x/medianode/keeper"
+	medianodetypes "github.com/OmniFlix/omniflixhub/v6/x/medianode/types"
+)
+
+type QueryPlugin struct {
+	medianodeKeeper *medianodekeeper.Keeper
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(k *medianodekeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		medianodeKeeper: k,
+	}
+}
+
+// GetMediaNode is a query to get a media node by id.
+func (qp QueryPlugin) GetMediaNode(ctx sdk.Context, id string) (*bindingstypes.MediaNodeResponse, error) {
+	resp, err := qp.medianodeKeeper.MediaNode(ctx, &medianodetypes.QueryMediaNodeRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.MediaNodeResponse{MediaNode: SdkMediaNodeToWasm(resp.MediaNode)}, nil
+}
+
+// GetLease is a query to get the lease of a media node.
+func (qp QueryPlugin) GetLease(ctx sdk.Context, mediaNodeID string) (*bindingstypes.LeaseResponse, error) {
+	resp, err := qp.medianodeKeeper.Lease(ctx, &medianodetypes.QueryLeaseRequest{MediaNodeId: mediaNodeID})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.LeaseResponse{Lease: SdkLeaseToWasm(resp.Lease)}, nil
+}
+
+func SdkMediaNodeToWasm(mediaNode medianodetypes.MediaNode) bindingstypes.MediaNode {
+	return bindingstypes.MediaNode{
+		Id:  mediaNode.Id,
+		Url: mediaNode.Url,
+		HardwareSpecs: bindingstypes.HardwareSpecs{
+			Cpus:        mediaNode.HardwareSpecs.Cpus,
+			RamInGb:     mediaNode.HardwareSpecs.RamInGb,
+			StorageInGb: mediaNode.HardwareSpecs.StorageInGb,
+		},
+		Owner:        mediaNode.Owner,
+		PricePerHour: ConvertSdkCoinToWasmCoin(mediaNode.PricePerHour),
+		Status:       strings.ToLower(strings.TrimPrefix(mediaNode.Status.String(), "STATUS_")),
+		Leased:       mediaNode.Leased,
+		RegisteredAt: uint64(mediaNode.RegisteredAt.Unix()),
+		Info: bindingstypes.Info{
+			Moniker:     mediaNode.Info.Moniker,
+			Description: mediaNode.Info.Description,
+			Contact:     mediaNode.Info.Contact,
+		},
+	}
+}
+
+func SdkLeaseToWasm(lease medianodetypes.Lease) bindingstypes.Lease {
+	return bindingstypes.Lease{
+		MediaNodeId:        lease.MediaNodeId,
+		Owner:              lease.Owner,
+		Lessee:             lease.Lessee,
+		PricePerHour:       ConvertSdkCoinToWasmCoin(lease.PricePerHour),
+		TotalLeaseAmount:   ConvertSdkCoinToWasmCoin(lease.TotalLeaseAmount),
+		SettledLeaseAmount: ConvertSdkCoinToWasmCoin(lease.SettledLeaseAmount),
+		StartTime:          uint64(lease.StartTime.Unix()),
+		LeasedHours:        lease.LeasedHours,
+		LastSettledAt:      uint64(lease.LastSettledAt.Unix()),
+	}
+}
+
+// ConvertSdkCoinToWasmCoin converts a sdk type coin to a wasm vm type coin
+func ConvertSdkCoinToWasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
+	return wasmvmtypes.Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.String(),
+	}
+}
@@ -0,0 +1,61 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/medianode/bindings/types"
+)
+
+// CustomQueryDecorator returns decorator for medianode custom CosmWasm bindings queries.
+// Custom queries that are not namespaced under medianode are left for the wrapped handler.
+func CustomQueryDecorator(qp *QueryPlugin) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(
+			func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+				if request.Custom != nil {
+					var customQuery bindingstypes.MedianodeCustomQuery
+					if err := json.Unmarshal(request.Custom, &customQuery); err != nil {
+						return nil, errorsmod.Wrap(err, "medianode query")
+					}
+					if customQuery.Medianode != nil {
+						return CustomQuerier(qp)(ctx, *customQuery.Medianode)
+					}
+				}
+				return old.HandleQuery(ctx, caller, request)
+			},
+		)
+	}
+}
+
+// CustomQuerier dispatches medianode custom CosmWasm bindings queries.
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, contractQuery bindingstypes.MedianodeQuery) ([]byte, error) {
+	return func(ctx sdk.Context, contractQuery bindingstypes.MedianodeQuery) ([]byte, error) {
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case contractQuery.MediaNode != nil:
+			res, err = qp.GetMediaNode(ctx, contractQuery.MediaNode.Id)
+		case contractQuery.Lease != nil:
+			res, err = qp.GetLease(ctx, contractQuery.Lease.MediaNodeId)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown medianode query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal medianode query response: %w", err)
+		}
+		return bz, nil
+	}
+}
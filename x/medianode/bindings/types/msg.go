@@ -0,0 +1,34 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+// MedianodeCustomMsg is the custom message handled by the medianode bindings.
+// Messages are namespaced under the module name, ex: {"medianode": {"lease_media_node": {...}}}
+type MedianodeCustomMsg struct {
+	Medianode *MedianodeMsg `json:"medianode,omitempty"`
+}
+
+type MedianodeMsg struct {
+	/// Contracts can lease media nodes, the lease amount is paid by the contract.
+	LeaseMediaNode *LeaseMediaNode `json:"lease_media_node,omitempty"`
+	/// Contracts can extend the leases they own.
+	ExtendLease *ExtendLease `json:"extend_lease,omitempty"`
+	/// Contracts can cancel the leases they own, the remaining amount is refunded to the contract.
+	CancelLease *CancelLease `json:"cancel_lease,omitempty"`
+}
+
+type LeaseMediaNode struct {
+	MediaNodeId string           `json:"media_node_id"`
+	LeaseHours  uint64           `json:"lease_hours"`
+	Amount      wasmvmtypes.Coin `json:"amount"`
+}
+
+type ExtendLease struct {
+	MediaNodeId string           `json:"media_node_id"`
+	LeaseHours  uint64           `json:"lease_hours"`
+	Amount      wasmvmtypes.Coin `json:"amount"`
+}
+
+type CancelLease struct {
+	MediaNodeId string `json:"media_node_id"`
+}
@@ -0,0 +1,32 @@
+package types
+
+// MedianodeCustomQuery is the custom query handled by the medianode bindings.
+// Queries are namespaced under the module name, ex: {"medianode": {"media_node": {...}}}
+type MedianodeCustomQuery struct {
+	Medianode *MedianodeQuery `json:"medianode,omitempty"`
+}
+
+type MedianodeQuery struct {
+	MediaNode *GetMediaNode `json:"media_node,omitempty"`
+	Lease     *GetLease     `json:"lease,omitempty"`
+}
+
+// query types
+
+type GetMediaNode struct {
+	Id string `json:"id"`
+}
+
+type GetLease struct {
+	MediaNodeId string `json:"media_node_id"`
+}
+
+// responses
+
+type MediaNodeResponse struct {
+	MediaNode MediaNode `json:"media_node"`
+}
+
+type LeaseResponse struct {
+	Lease Lease `json:"lease"`
+}
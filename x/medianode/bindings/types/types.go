@@ -0,0 +1,42 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+type Info struct {
+	Moniker     string `json:"moniker"`
+	Description string `json:"description"`
+	Contact     string `json:"contact"`
+}
+
+type HardwareSpecs struct {
+	Cpus        int64 `json:"cpus"`
+	RamInGb     int64 `json:"ram_in_gb"`
+	StorageInGb int64 `json:"storage_in_gb"`
+}
+
+type MediaNode struct {
+	Id            string           `json:"id"`
+	Url           string           `json:"url"`
+	HardwareSpecs HardwareSpecs    `json:"hardware_specs"`
+	Owner         string           `json:"owner"`
+	PricePerHour  wasmvmtypes.Coin `json:"price_per_hour"`
+	// Status is one of "pending", "active" or "closed"
+	Status string `json:"status"`
+	Leased bool   `json:"leased"`
+	// RegisteredAt in unix seconds
+	RegisteredAt uint64 `json:"registered_at"`
+	Info         Info   `json:"info"`
+}
+
+type Lease struct {
+	MediaNodeId        string           `json:"media_node_id"`
+	Owner              string           `json:"owner"`
+	Lessee             string           `json:"lessee"`
+	PricePerHour       wasmvmtypes.Coin `json:"price_per_hour"`
+	TotalLeaseAmount   wasmvmtypes.Coin `json:"total_lease_amount"`
+	SettledLeaseAmount wasmvmtypes.Coin `json:"settled_lease_amount"`
+	// StartTime and LastSettledAt in unix seconds
+	StartTime     uint64 `json:"start_time"`
+	LeasedHours   uint64 `json:"leased_hours"`
+	LastSettledAt uint64 `json:"last_settled_at"`
+}
@@ -0,0 +1,28 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	medianodekeeper "github.com/OmniFlix/omniflixhub/v6/x/medianode/keeper"
+)
+
+// Capability is the wasm capability of contracts using the medianode bindings
+const Capability = "medianode"
+
+func RegisterCustomPlugins(
+	medianode *medianodekeeper.Keeper,
+) []wasmkeeper.Option {
+	wasmQueryPlugin := NewQueryPlugin(medianode)
+
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		CustomQueryDecorator(wasmQueryPlugin),
+	)
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(medianode),
+	)
+
+	return []wasmkeeper.Option{
+		queryDecoratorOpt,
+		messengerDecoratorOpt,
+	}
+}
@@ -0,0 +1,186 @@
+package bindings_test
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/onft/bindings/types"
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+const (
+	testDenomId = "onftdenomreflect"
+	testONFTId  = "onftreflect1"
+)
+
+func createTestDenom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, reflect, sender sdk.AccAddress) {
+	t.Helper()
+
+	creationFee := types.DefaultDenomCreationFee
+	wasmtesting.FundAccount(t, ctx, customApp, reflect, sdk.NewCoins(creationFee))
+
+	msg := bindings.OnftMsg{CreateDenom: &bindings.CreateDenom{
+		Id:            testDenomId,
+		Symbol:        "reflect",
+		Name:          "reflect denom",
+		UpdatableData: true,
+		CreationFee: wasmvmtypes.Coin{
+			Denom:  creationFee.Denom,
+			Amount: creationFee.Amount.String(),
+		},
+	}}
+	err := executeCustom(t, ctx, customApp, reflect, sender, msg)
+	require.NoError(t, err)
+}
+
+func mintTestONFT(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, reflect, sender sdk.AccAddress) {
+	t.Helper()
+
+	msg := bindings.OnftMsg{MintONFT: &bindings.MintONFT{
+		DenomId: testDenomId,
+		Id:      testONFTId,
+		Metadata: bindings.Metadata{
+			Name:     "reflect onft",
+			MediaURI: "ipfs://reflect",
+		},
+		Data:         `{"level":1}`,
+		Transferable: true,
+		Extensible:   true,
+		RoyaltyShare: "0.05",
+	}}
+	err := executeCustom(t, ctx, customApp, reflect, sender, msg)
+	require.NoError(t, err)
+}
+
+func TestCreateDenomMsg(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	require.NotEmpty(t, reflect)
+
+	// creation fee is paid by the contract
+	msg := bindings.OnftMsg{CreateDenom: &bindings.CreateDenom{
+		Id:     testDenomId,
+		Symbol: "reflect",
+		Name:   "reflect denom",
+		CreationFee: wasmvmtypes.Coin{
+			Denom:  types.DefaultDenomCreationFee.Denom,
+			Amount: types.DefaultDenomCreationFee.Amount.String(),
+		},
+	}}
+	err := executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.Error(t, err)
+
+	createTestDenom(t, ctx, customApp, reflect, lucky)
+
+	denom, err := customApp.AppKeepers.ONFTKeeper.GetDenomInfo(ctx, testDenomId)
+	require.NoError(t, err)
+	require.Equal(t, reflect.String(), denom.Creator)
+	require.True(t, customApp.AppKeepers.BankKeeper.GetAllBalances(ctx, reflect).IsZero())
+
+	// denom ids must be set by the contract
+	msg.CreateDenom.Id = ""
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.Error(t, err)
+}
+
+func TestMintTransferBurnMsgs(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	createTestDenom(t, ctx, customApp, reflect, lucky)
+	mintTestONFT(t, ctx, customApp, reflect, lucky)
+
+	onft, err := customApp.AppKeepers.ONFTKeeper.GetONFT(ctx, testDenomId, testONFTId)
+	require.NoError(t, err)
+	require.Equal(t, reflect, onft.GetOwner())
+	require.Equal(t, "reflect onft", onft.GetName())
+
+	// update data of the oNFT in the denom of the contract
+	msg := bindings.OnftMsg{UpdateONFTData: &bindings.UpdateONFTData{
+		DenomId: testDenomId,
+		Id:      testONFTId,
+		Data:    `{"level":2}`,
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+	onft, err = customApp.AppKeepers.ONFTKeeper.GetONFT(ctx, testDenomId, testONFTId)
+	require.NoError(t, err)
+	require.Equal(t, `{"level":2}`, onft.GetData())
+
+	// transfer to lucky
+	msg = bindings.OnftMsg{TransferONFT: &bindings.TransferONFT{
+		DenomId:   testDenomId,
+		Id:        testONFTId,
+		Recipient: lucky.String(),
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+	onft, err = customApp.AppKeepers.ONFTKeeper.GetONFT(ctx, testDenomId, testONFTId)
+	require.NoError(t, err)
+	require.Equal(t, lucky, onft.GetOwner())
+
+	// contract can no longer transfer or burn the oNFT of lucky
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.Error(t, err)
+	burnMsg := bindings.OnftMsg{BurnONFT: &bindings.BurnONFT{
+		DenomId: testDenomId,
+		Id:      testONFTId,
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, burnMsg)
+	require.Error(t, err)
+
+	// mint another oNFT to the contract and burn it
+	mintMsg := bindings.OnftMsg{MintONFT: &bindings.MintONFT{
+		DenomId:      testDenomId,
+		Id:           "onftreflect2",
+		Metadata:     bindings.Metadata{MediaURI: "ipfs://reflect2"},
+		Transferable: true,
+	}}
+	err = executeCustom(t, ctx, customApp, reflect, lucky, mintMsg)
+	require.NoError(t, err)
+	burnMsg.BurnONFT.Id = "onftreflect2"
+	err = executeCustom(t, ctx, customApp, reflect, lucky, burnMsg)
+	require.NoError(t, err)
+	require.False(t, customApp.AppKeepers.ONFTKeeper.HasONFT(ctx, testDenomId, "onftreflect2"))
+}
+
+func TestTransferDenomMsg(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	createTestDenom(t, ctx, customApp, reflect, lucky)
+
+	msg := bindings.OnftMsg{TransferDenom: &bindings.TransferDenom{
+		Id:        testDenomId,
+		Recipient: lucky.String(),
+	}}
+	err := executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.NoError(t, err)
+
+	denom, err := customApp.AppKeepers.ONFTKeeper.GetDenomInfo(ctx, testDenomId)
+	require.NoError(t, err)
+	require.Equal(t, lucky.String(), denom.Creator)
+
+	// the contract is no longer the creator of the denom
+	err = executeCustom(t, ctx, customApp, reflect, lucky, msg)
+	require.Error(t, err)
+}
+
+func executeCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, sender sdk.AccAddress, msg bindings.OnftMsg) error {
+	t.Helper()
+
+	return wasmtesting.ExecuteCustom(t, ctx, customApp, contract, sender, bindings.OnftCustomMsg{Onft: &msg})
+}
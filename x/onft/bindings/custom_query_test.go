@@ -0,0 +1,64 @@
+package bindings_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/app"
+	"github.com/OmniFlix/omniflixhub/v6/app/apptesting/wasmtesting"
+	bindings "github.com/OmniFlix/omniflixhub/v6/x/onft/bindings/types"
+)
+
+func TestQueryONFTs(t *testing.T) {
+	creator := wasmtesting.RandomAccountAddress()
+	customApp, ctx := wasmtesting.SetupCustomApp(t, creator)
+
+	lucky := wasmtesting.RandomAccountAddress()
+	reflect := wasmtesting.InstantiateReflectContract(t, ctx, customApp, lucky)
+	createTestDenom(t, ctx, customApp, reflect, lucky)
+	mintTestONFT(t, ctx, customApp, reflect, lucky)
+
+	denomResp := bindings.DenomResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.OnftQuery{
+		Denom: &bindings.GetDenom{DenomId: testDenomId},
+	}, &denomResp)
+	require.Equal(t, testDenomId, denomResp.Denom.Id)
+	require.Equal(t, reflect.String(), denomResp.Denom.Creator)
+	require.True(t, denomResp.Denom.UpdatableData)
+
+	onftResp := bindings.ONFTResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.OnftQuery{
+		ONFT: &bindings.GetONFT{DenomId: testDenomId, Id: testONFTId},
+	}, &onftResp)
+	require.Equal(t, testONFTId, onftResp.ONFT.Id)
+	require.Equal(t, reflect.String(), onftResp.ONFT.Owner)
+	require.Equal(t, "ipfs://reflect", onftResp.ONFT.Metadata.MediaURI)
+	require.Equal(t, "0.050000000000000000", onftResp.ONFT.RoyaltyShare)
+
+	ownerResp := bindings.OwnerONFTsResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.OnftQuery{
+		OwnerONFTs: &bindings.GetOwnerONFTs{Owner: reflect.String()},
+	}, &ownerResp)
+	require.Len(t, ownerResp.Collections, 1)
+	require.Equal(t, testDenomId, ownerResp.Collections[0].DenomId)
+	require.Equal(t, []string{testONFTId}, ownerResp.Collections[0].OnftIds)
+
+	supplyResp := bindings.SupplyResponse{}
+	queryCustom(t, ctx, customApp, reflect, bindings.OnftQuery{
+		Supply: &bindings.GetSupply{DenomId: testDenomId},
+	}, &supplyResp)
+	require.Equal(t, uint64(1), supplyResp.Amount)
+	queryCustom(t, ctx, customApp, reflect, bindings.OnftQuery{
+		Supply: &bindings.GetSupply{DenomId: testDenomId, Owner: lucky.String()},
+	}, &supplyResp)
+	require.Equal(t, uint64(0), supplyResp.Amount)
+}
+
+func queryCustom(t *testing.T, ctx sdk.Context, customApp *app.OmniFlixApp, contract sdk.AccAddress, request bindings.OnftQuery, response interface{}) {
+	t.Helper()
+
+	wasmtesting.QueryCustom(t, ctx, customApp, contract, bindings.OnftCustomQuery{Onft: &request}, response)
+}
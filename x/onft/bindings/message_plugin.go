@@ -0,0 +1,307 @@
+package bindings
+
+import (
+	"encoding/json"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/onft/bindings/types"
+	onftkeeper "github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// CustomMessageDecorator returns decorator for onft custom CosmWasm bindings messages
+func CustomMessageDecorator(onft *onftkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			onft:    onft,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	onft    *onftkeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom != nil {
+		// only handle messages namespaced under onft,
+		// leave everything else for the wrapped version
+		var customMsg bindingstypes.OnftCustomMsg
+		if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+			return nil, nil, [][]*codectypes.Any{}, errorsmod.Wrap(err, "onft msg")
+		}
+
+		if contractMsg := customMsg.Onft; contractMsg != nil {
+			var (
+				data []byte
+				err  error
+			)
+			switch {
+			case contractMsg.CreateDenom != nil:
+				data, err = PerformCreateDenom(m.onft, ctx, contractAddr, contractMsg.CreateDenom)
+			case contractMsg.TransferDenom != nil:
+				data, err = PerformTransferDenom(m.onft, ctx, contractAddr, contractMsg.TransferDenom)
+			case contractMsg.MintONFT != nil:
+				data, err = PerformMintONFT(m.onft, ctx, contractAddr, contractMsg.MintONFT)
+			case contractMsg.TransferONFT != nil:
+				data, err = PerformTransferONFT(m.onft, ctx, contractAddr, contractMsg.TransferONFT)
+			case contractMsg.BurnONFT != nil:
+				data, err = PerformBurnONFT(m.onft, ctx, contractAddr, contractMsg.BurnONFT)
+			case contractMsg.UpdateONFTData != nil:
+				data, err = PerformUpdateONFTData(m.onft, ctx, contractAddr, contractMsg.UpdateONFTData)
+			default:
+				return nil, nil, [][]*codectypes.Any{}, wasmvmtypes.UnsupportedRequest{Kind: "unknown onft msg variant"}
+			}
+			if err != nil {
+				return nil, nil, [][]*codectypes.Any{}, err
+			}
+			return nil, [][]byte{data}, [][]*codectypes.Any{}, nil
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// PerformCreateDenom creates a denom owned by the contract, the creation fee is paid by the contract.
+func PerformCreateDenom(k *onftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindingstypes.CreateDenom) ([]byte, error) {
+	if createDenom == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "create denom null create denom"}
+	}
+	creationFee, err := parseCoin(createDenom.CreationFee)
+	if err != nil {
+		return nil, err
+	}
+	royaltyReceivers, err := parseWeightedAddresses(createDenom.RoyaltyReceivers)
+	if err != nil {
+		return nil, err
+	}
+
+	msgCreateDenom := onfttypes.NewMsgCreateDenom(
+		createDenom.Symbol,
+		createDenom.Name,
+		createDenom.Schema,
+		createDenom.Description,
+		createDenom.URI,
+		createDenom.URIHash,
+		createDenom.PreviewURI,
+		createDenom.Data,
+		contractAddr.String(),
+		creationFee,
+		royaltyReceivers,
+		createDenom.UpdatableData,
+		createDenom.MaxSupply,
+		createDenom.Revocable,
+		createDenom.IndexedTraits,
+	)
+	msgCreateDenom.Id = createDenom.Id
+	if createDenom.DataHistoryRetention != nil {
+		msgCreateDenom.DataHistoryRetention = *createDenom.DataHistoryRetention
+	}
+	if err := msgCreateDenom.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgCreateDenom")
+	}
+
+	msgServer := onftkeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.CreateDenom(ctx, msgCreateDenom)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "creating denom")
+	}
+	return resp.Marshal()
+}
+
+// PerformTransferDenom transfers a denom created by the contract to the recipient.
+func PerformTransferDenom(k *onftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, transferDenom *bindingstypes.TransferDenom) ([]byte, error) {
+	if transferDenom == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "transfer denom null transfer denom"}
+	}
+	if _, err := parseAddress(transferDenom.Recipient); err != nil {
+		return nil, err
+	}
+
+	msgTransferDenom := onfttypes.NewMsgTransferDenom(transferDenom.Id, contractAddr.String(), transferDenom.Recipient)
+	if err := msgTransferDenom.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgTransferDenom")
+	}
+
+	msgServer := onftkeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.TransferDenom(ctx, msgTransferDenom)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "transferring denom")
+	}
+	return resp.Marshal()
+}
+
+// PerformMintONFT mints an oNFT to the recipient, the contract must be allowed to mint in the denom.
+func PerformMintONFT(k *onftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindingstypes.MintONFT) ([]byte, error) {
+	if mint == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "mint onft null mint"}
+	}
+	recipient := contractAddr
+	if mint.Recipient != "" {
+		rcpt, err := parseAddress(mint.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		recipient = rcpt
+	}
+	royaltyShare := sdkmath.LegacyZeroDec()
+	if mint.RoyaltyShare != "" {
+		share, err := sdkmath.LegacyNewDecFromStr(mint.RoyaltyShare)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid royalty share " + mint.RoyaltyShare}
+		}
+		royaltyShare = share
+	}
+	var transferLockedUntil *time.Time
+	if mint.TransferLockedUntil > 0 {
+		lockedUntil := time.Unix(mint.TransferLockedUntil, 0).UTC()
+		transferLockedUntil = &lockedUntil
+	}
+
+	msgMintONFT := onfttypes.NewMsgMintONFT(
+		mint.DenomId,
+		contractAddr.String(),
+		recipient.String(),
+		onfttypes.Metadata{
+			Name:        mint.Metadata.Name,
+			Description: mint.Metadata.Description,
+			MediaURI:    mint.Metadata.MediaURI,
+			PreviewURI:  mint.Metadata.PreviewURI,
+			UriHash:     mint.Metadata.URIHash,
+		},
+		mint.Data,
+		mint.Transferable,
+		mint.Extensible,
+		mint.Nsfw,
+		royaltyShare,
+		nil,
+		transferLockedUntil,
+	)
+	msgMintONFT.Id = mint.Id
+	if err := msgMintONFT.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgMintONFT")
+	}
+
+	msgServer := onftkeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.MintONFT(ctx, msgMintONFT)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "minting onft")
+	}
+	return resp.Marshal()
+}
+
+// PerformTransferONFT transfers an oNFT owned by the contract, or approved to the contract, to the recipient.
+func PerformTransferONFT(k *onftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, transfer *bindingstypes.TransferONFT) ([]byte, error) {
+	if transfer == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "transfer onft null transfer"}
+	}
+	if _, err := parseAddress(transfer.Recipient); err != nil {
+		return nil, err
+	}
+
+	msgTransferONFT := onfttypes.NewMsgTransferONFT(transfer.Id, transfer.DenomId, contractAddr.String(), transfer.Recipient)
+	if err := msgTransferONFT.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgTransferONFT")
+	}
+
+	msgServer := onftkeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.TransferONFT(ctx, msgTransferONFT)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "transferring onft")
+	}
+	return resp.Marshal()
+}
+
+// PerformBurnONFT burns an oNFT owned by the contract.
+func PerformBurnONFT(k *onftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindingstypes.BurnONFT) ([]byte, error) {
+	if burn == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "burn onft null burn"}
+	}
+
+	msgBurnONFT := onfttypes.NewMsgBurnONFT(burn.DenomId, burn.Id, contractAddr.String())
+	if err := msgBurnONFT.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgBurnONFT")
+	}
+
+	msgServer := onftkeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.BurnONFT(ctx, msgBurnONFT)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "burning onft")
+	}
+	return resp.Marshal()
+}
+
+// PerformUpdateONFTData updates the data of an oNFT in a denom created by the contract.
+func PerformUpdateONFTData(k *onftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, update *bindingstypes.UpdateONFTData) ([]byte, error) {
+	if update == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "update onft data null update"}
+	}
+
+	msgUpdateONFTData := onfttypes.NewMsgUpdateONFTData(update.DenomId, update.Id, update.Data, contractAddr.String())
+	if err := msgUpdateONFTData.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgUpdateONFTData")
+	}
+
+	msgServer := onftkeeper.NewMsgServerImpl(*k)
+	resp, err := msgServer.UpdateONFTData(ctx, msgUpdateONFTData)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "updating onft data")
+	}
+	return resp.Marshal()
+}
+
+// parseAddress parses address from bech32 string and verifies its format.
+func parseAddress(addr string) (sdk.AccAddress, error) {
+	parsed, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "address from bech32")
+	}
+	err = sdk.VerifyAddressFormat(parsed)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "verify address format")
+	}
+	return parsed, nil
+}
+
+// parseCoin converts a wasm vm type coin to a sdk type coin
+func parseCoin(coin wasmvmtypes.Coin) (sdk.Coin, error) {
+	amount, ok := sdkmath.NewIntFromString(coin.Amount)
+	if !ok {
+		return sdk.Coin{}, wasmvmtypes.InvalidRequest{Err: "invalid coin amount " + coin.Amount}
+	}
+	return sdk.Coin{Denom: coin.Denom, Amount: amount}, nil
+}
+
+func parseWeightedAddresses(weightedAddrs []bindingstypes.WeightedAddress) ([]*onfttypes.WeightedAddress, error) {
+	var parsed []*onfttypes.WeightedAddress
+	for _, weightedAddr := range weightedAddrs {
+		weight, err := sdkmath.LegacyNewDecFromStr(weightedAddr.Weight)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: "invalid weight " + weightedAddr.Weight}
+		}
+		parsed = append(parsed, &onfttypes.WeightedAddress{
+			Address: weightedAddr.Address,
+			Weight:  weight,
+		})
+	}
+	return parsed, nil
+}
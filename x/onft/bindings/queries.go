@@ -0,0 +1,129 @@
+package bindings
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/onft/bindings/types"
+	onftkeeper "github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+type QueryPlugin struct {
+	onftKeeper *onftkeeper.Keeper
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(k *onftkeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		onftKeeper: k,
+	}
+}
+
+// GetDenom is a query to get a denom by id.
+func (qp QueryPlugin) GetDenom(ctx sdk.Context, denomID string) (*bindingstypes.DenomResponse, error) {
+	resp, err := qp.onftKeeper.Denom(ctx, &onfttypes.QueryDenomRequest{DenomId: denomID})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.DenomResponse{Denom: SdkDenomToWasm(*resp.Denom)}, nil
+}
+
+// GetONFT is a query to get an oNFT of a denom.
+func (qp QueryPlugin) GetONFT(ctx sdk.Context, denomID, onftID string) (*bindingstypes.ONFTResponse, error) {
+	resp, err := qp.onftKeeper.ONFT(ctx, &onfttypes.QueryONFTRequest{DenomId: denomID, Id: onftID})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.ONFTResponse{ONFT: SdkONFTToWasm(denomID, *resp.ONFT)}, nil
+}
+
+// GetOwnerONFTs is a query to get the oNFT ids of an owner grouped by denom.
+func (qp QueryPlugin) GetOwnerONFTs(ctx sdk.Context, owner, denomID string) (*bindingstypes.OwnerONFTsResponse, error) {
+	if _, err := parseAddress(owner); err != nil {
+		return nil, err
+	}
+	resp, err := qp.onftKeeper.OwnerONFTs(ctx, &onfttypes.QueryOwnerONFTsRequest{DenomId: denomID, Owner: owner})
+	if err != nil {
+		return nil, err
+	}
+	collections := []bindingstypes.IDCollection{}
+	for _, idCollection := range resp.Owner.IDCollections {
+		collections = append(collections, bindingstypes.IDCollection{
+			DenomId: idCollection.DenomId,
+			OnftIds: idCollection.OnftIds,
+		})
+	}
+	return &bindingstypes.OwnerONFTsResponse{Owner: owner, Collections: collections}, nil
+}
+
+// GetSupply is a query to get the number of oNFTs in a denom, or of an owner in a denom.
+func (qp QueryPlugin) GetSupply(ctx sdk.Context, denomID, owner string) (*bindingstypes.SupplyResponse, error) {
+	resp, err := qp.onftKeeper.Supply(ctx, &onfttypes.QuerySupplyRequest{DenomId: denomID, Owner: owner})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.SupplyResponse{Amount: resp.Amount}, nil
+}
+
+// SdkDenomToWasm converts a denom to the bindings type
+func SdkDenomToWasm(denom onfttypes.Denom) bindingstypes.Denom {
+	return bindingstypes.Denom{
+		Id:               denom.Id,
+		Symbol:           denom.Symbol,
+		Name:             denom.Name,
+		Schema:           denom.Schema,
+		Creator:          denom.Creator,
+		Description:      denom.Description,
+		PreviewURI:       denom.PreviewURI,
+		URI:              denom.Uri,
+		URIHash:          denom.UriHash,
+		Data:             denom.Data,
+		RoyaltyReceivers: SdkWeightedAddressesToWasm(denom.RoyaltyReceivers),
+		UpdatableData:    denom.UpdatableData,
+		MaxSupply:        denom.MaxSupply,
+		MintingClosed:    denom.MintingClosed,
+		Revocable:        denom.Revocable,
+		Frozen:           denom.Frozen,
+		IndexedTraits:    denom.IndexedTraits,
+
+		DataHistoryRetention: denom.DataHistoryRetention,
+	}
+}
+
+// SdkONFTToWasm converts an oNFT to the bindings type
+func SdkONFTToWasm(denomID string, onft onfttypes.ONFT) bindingstypes.ONFT {
+	converted := bindingstypes.ONFT{
+		Id:      onft.Id,
+		DenomId: denomID,
+		Owner:   onft.Owner,
+		Metadata: bindingstypes.Metadata{
+			Name:        onft.Metadata.Name,
+			Description: onft.Metadata.Description,
+			MediaURI:    onft.Metadata.MediaURI,
+			PreviewURI:  onft.Metadata.PreviewURI,
+			URIHash:     onft.Metadata.UriHash,
+		},
+		Data:             onft.Data,
+		Transferable:     onft.Transferable,
+		Extensible:       onft.Extensible,
+		Nsfw:             onft.Nsfw,
+		RoyaltyShare:     onft.RoyaltyShare.String(),
+		CreatedAt:        onft.CreatedAt.Unix(),
+		RoyaltyReceivers: SdkWeightedAddressesToWasm(onft.RoyaltyReceivers),
+	}
+	if onft.TransferLockedUntil != nil {
+		converted.TransferLockedUntil = onft.TransferLockedUntil.Unix()
+	}
+	return converted
+}
+
+func SdkWeightedAddressesToWasm(weightedAddrs []*onfttypes.WeightedAddress) []bindingstypes.WeightedAddress {
+	converted := []bindingstypes.WeightedAddress{}
+	for _, weightedAddr := range weightedAddrs {
+		converted = append(converted, bindingstypes.WeightedAddress{
+			Address: weightedAddr.Address,
+			Weight:  weightedAddr.Weight.String(),
+		})
+	}
+	return converted
+}
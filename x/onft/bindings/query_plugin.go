@@ -0,0 +1,65 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/OmniFlix/omniflixhub/v6/x/onft/bindings/types"
+)
+
+// CustomQueryDecorator returns decorator for onft custom CosmWasm bindings queries.
+// Custom queries that are not namespaced under onft are left for the wrapped handler.
+func CustomQueryDecorator(qp *QueryPlugin) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(
+			func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+				if request.Custom != nil {
+					var customQuery bindingstypes.OnftCustomQuery
+					if err := json.Unmarshal(request.Custom, &customQuery); err != nil {
+						return nil, errorsmod.Wrap(err, "onft query")
+					}
+					if customQuery.Onft != nil {
+						return CustomQuerier(qp)(ctx, *customQuery.Onft)
+					}
+				}
+				return old.HandleQuery(ctx, caller, request)
+			},
+		)
+	}
+}
+
+// CustomQuerier dispatches onft custom CosmWasm bindings queries.
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, contractQuery bindingstypes.OnftQuery) ([]byte, error) {
+	return func(ctx sdk.Context, contractQuery bindingstypes.OnftQuery) ([]byte, error) {
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case contractQuery.Denom != nil:
+			res, err = qp.GetDenom(ctx, contractQuery.Denom.DenomId)
+		case contractQuery.ONFT != nil:
+			res, err = qp.GetONFT(ctx, contractQuery.ONFT.DenomId, contractQuery.ONFT.Id)
+		case contractQuery.OwnerONFTs != nil:
+			res, err = qp.GetOwnerONFTs(ctx, contractQuery.OwnerONFTs.Owner, contractQuery.OwnerONFTs.DenomId)
+		case contractQuery.Supply != nil:
+			res, err = qp.GetSupply(ctx, contractQuery.Supply.DenomId, contractQuery.Supply.Owner)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown onft query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal onft query response: %w", err)
+		}
+		return bz, nil
+	}
+}
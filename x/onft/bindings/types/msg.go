@@ -0,0 +1,85 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+// OnftCustomMsg is the custom message handled by the onft bindings.
+// Messages are namespaced under the module name, ex: {"onft": {"mint_onft": {...}}}
+type OnftCustomMsg struct {
+	Onft *OnftMsg `json:"onft,omitempty"`
+}
+
+type OnftMsg struct {
+	/// Contracts can create denoms, the creation fee is paid by the contract.
+	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
+	/// Contracts can transfer the denoms they created.
+	TransferDenom *TransferDenom `json:"transfer_denom,omitempty"`
+	/// Contracts can mint oNFTs of the denoms they are allowed to mint.
+	MintONFT *MintONFT `json:"mint_onft,omitempty"`
+	/// Contracts can transfer the oNFTs they own or are approved for.
+	TransferONFT *TransferONFT `json:"transfer_onft,omitempty"`
+	/// Contracts can burn the oNFTs they own.
+	BurnONFT *BurnONFT `json:"burn_onft,omitempty"`
+	/// Contracts can update the data of the oNFTs of the denoms they created.
+	UpdateONFTData *UpdateONFTData `json:"update_onft_data,omitempty"`
+}
+
+// CreateDenom creates a denom with the contract as creator.
+// Unlike the cli, ids are not generated and must be set by the contract.
+type CreateDenom struct {
+	Id               string            `json:"id"`
+	Symbol           string            `json:"symbol"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	PreviewURI       string            `json:"preview_uri,omitempty"`
+	Schema           string            `json:"schema,omitempty"`
+	URI              string            `json:"uri,omitempty"`
+	URIHash          string            `json:"uri_hash,omitempty"`
+	Data             string            `json:"data,omitempty"`
+	RoyaltyReceivers []WeightedAddress `json:"royalty_receivers,omitempty"`
+	UpdatableData    bool              `json:"updatable_data,omitempty"`
+	MaxSupply        uint64            `json:"max_supply,omitempty"`
+	Revocable        bool              `json:"revocable,omitempty"`
+	IndexedTraits    bool              `json:"indexed_traits,omitempty"`
+	CreationFee      wasmvmtypes.Coin  `json:"creation_fee"`
+	// DataHistoryRetention defaults to the default data history retention when not set
+	DataHistoryRetention *uint64 `json:"data_history_retention,omitempty"`
+}
+
+type TransferDenom struct {
+	Id        string `json:"id"`
+	Recipient string `json:"recipient"`
+}
+
+// MintONFT mints an oNFT, the id must be set by the contract.
+type MintONFT struct {
+	DenomId      string   `json:"denom_id"`
+	Id           string   `json:"id"`
+	Metadata     Metadata `json:"metadata"`
+	Data         string   `json:"data,omitempty"`
+	Transferable bool     `json:"transferable"`
+	Extensible   bool     `json:"extensible"`
+	Nsfw         bool     `json:"nsfw"`
+	// RoyaltyShare is a decimal string between 0 and 1, defaults to 0
+	RoyaltyShare string `json:"royalty_share,omitempty"`
+	// Recipient of the oNFT, defaults to the contract
+	Recipient string `json:"recipient,omitempty"`
+	// TransferLockedUntil is the unix time in seconds until which the oNFT can not be transferred
+	TransferLockedUntil int64 `json:"transfer_locked_until,omitempty"`
+}
+
+type TransferONFT struct {
+	DenomId   string `json:"denom_id"`
+	Id        string `json:"id"`
+	Recipient string `json:"recipient"`
+}
+
+type BurnONFT struct {
+	DenomId string `json:"denom_id"`
+	Id      string `json:"id"`
+}
+
+type UpdateONFTData struct {
+	DenomId string `json:"denom_id"`
+	Id      string `json:"id"`
+	Data    string `json:"data"`
+}
@@ -0,0 +1,56 @@
+package types
+
+// OnftCustomQuery is the custom query handled by the onft bindings.
+// Queries are namespaced under the module name, ex: {"onft": {"onft": {...}}}
+type OnftCustomQuery struct {
+	Onft *OnftQuery `json:"onft,omitempty"`
+}
+
+type OnftQuery struct {
+	Denom      *GetDenom      `json:"denom,omitempty"`
+	ONFT       *GetONFT       `json:"onft,omitempty"`
+	OwnerONFTs *GetOwnerONFTs `json:"owner_onfts,omitempty"`
+	Supply     *GetSupply     `json:"supply,omitempty"`
+}
+
+// query types
+
+type GetDenom struct {
+	DenomId string `json:"denom_id"`
+}
+
+type GetONFT struct {
+	DenomId string `json:"denom_id"`
+	Id      string `json:"id"`
+}
+
+type GetOwnerONFTs struct {
+	Owner string `json:"owner"`
+	// DenomId filters the oNFTs of a denom when set
+	DenomId string `json:"denom_id,omitempty"`
+}
+
+type GetSupply struct {
+	DenomId string `json:"denom_id"`
+	// Owner returns the number of oNFTs of the owner in the denom when set
+	Owner string `json:"owner,omitempty"`
+}
+
+// responses
+
+type DenomResponse struct {
+	Denom Denom `json:"denom"`
+}
+
+type ONFTResponse struct {
+	ONFT ONFT `json:"onft"`
+}
+
+type OwnerONFTsResponse struct {
+	Owner       string         `json:"owner"`
+	Collections []IDCollection `json:"collections"`
+}
+
+type SupplyResponse struct {
+	Amount uint64 `json:"amount"`
+}
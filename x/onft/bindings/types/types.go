@@ -0,0 +1,59 @@
+package types
+
+type WeightedAddress struct {
+	Address string `json:"address"`
+	// Weight is a decimal string between 0 and 1
+	Weight string `json:"weight"`
+}
+
+type Metadata struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	MediaURI    string `json:"media_uri"`
+	PreviewURI  string `json:"preview_uri,omitempty"`
+	URIHash     string `json:"uri_hash,omitempty"`
+}
+
+type Denom struct {
+	Id               string            `json:"id"`
+	Symbol           string            `json:"symbol"`
+	Name             string            `json:"name"`
+	Schema           string            `json:"schema"`
+	Creator          string            `json:"creator"`
+	Description      string            `json:"description"`
+	PreviewURI       string            `json:"preview_uri"`
+	URI              string            `json:"uri"`
+	URIHash          string            `json:"uri_hash"`
+	Data             string            `json:"data"`
+	RoyaltyReceivers []WeightedAddress `json:"royalty_receivers"`
+	UpdatableData    bool              `json:"updatable_data"`
+	MaxSupply        uint64            `json:"max_supply"`
+	MintingClosed    bool              `json:"minting_closed"`
+	Revocable        bool              `json:"revocable"`
+	Frozen           bool              `json:"frozen"`
+	IndexedTraits    bool              `json:"indexed_traits"`
+
+	DataHistoryRetention uint64 `json:"data_history_retention"`
+}
+
+type ONFT struct {
+	Id           string   `json:"id"`
+	DenomId      string   `json:"denom_id"`
+	Owner        string   `json:"owner"`
+	Metadata     Metadata `json:"metadata"`
+	Data         string   `json:"data"`
+	Transferable bool     `json:"transferable"`
+	Extensible   bool     `json:"extensible"`
+	Nsfw         bool     `json:"nsfw"`
+	RoyaltyShare string   `json:"royalty_share"`
+	// CreatedAt is the unix time of the mint in seconds
+	CreatedAt        int64             `json:"created_at"`
+	RoyaltyReceivers []WeightedAddress `json:"royalty_receivers"`
+	// TransferLockedUntil is the unix time in seconds until which the oNFT can not be transferred, 0 if not locked
+	TransferLockedUntil int64 `json:"transfer_locked_until,omitempty"`
+}
+
+type IDCollection struct {
+	DenomId string   `json:"denom_id"`
+	OnftIds []string `json:"onft_ids"`
+}
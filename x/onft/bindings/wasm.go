@@ -0,0 +1,28 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	onftkeeper "github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+)
+
+// Capability is the wasm capability of contracts using the onft bindings
+const Capability = "onft"
+
+func RegisterCustomPlugins(
+	onft *onftkeeper.Keeper,
+) []wasmkeeper.Option {
+	wasmQueryPlugin := NewQueryPlugin(onft)
+
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		CustomQueryDecorator(wasmQueryPlugin),
+	)
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(onft),
+	)
+
+	return []wasmkeeper.Option{
+		queryDecoratorOpt,
+		messengerDecoratorOpt,
+	}
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	flag "github.com/spf13/pflag"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 )
 
 const (
@@ -23,17 +25,44 @@ const (
 	FlagURIHash          = "uri-hash"
 	FlagRoyaltyReceivers = "royalty-receivers"
 	FlagUpdatableData    = "updatable-data"
+	FlagQuota            = "quota"
+	FlagExpiry           = "expiry"
+	FlagMaxSupply        = "max-supply"
+	FlagPrice            = "price"
+	FlagNonce            = "nonce"
+	FlagProof            = "proof"
+	FlagAddress          = "address"
+	FlagBuyoutPrice      = "buyout-price"
+	FlagRevocable        = "revocable"
+	FlagIndexedTraits    = "indexed-traits"
+	FlagReason           = "reason"
+	FlagReclaim          = "reclaim"
+	FlagONFTID           = "onft-id"
+
+	FlagTransferLockedUntil  = "transfer-locked-until"
+	FlagDataHistoryRetention = "data-history-retention"
 )
 
 var (
-	FsCreateDenom    = flag.NewFlagSet("", flag.ContinueOnError)
-	FsUpdateDenom    = flag.NewFlagSet("", flag.ContinueOnError)
-	FsTransferDenom  = flag.NewFlagSet("", flag.ContinueOnError)
-	FsMintONFT       = flag.NewFlagSet("", flag.ContinueOnError)
-	FsTransferONFT   = flag.NewFlagSet("", flag.ContinueOnError)
-	FsUpdateONFTData = flag.NewFlagSet("", flag.ContinueOnError)
-	FsQuerySupply    = flag.NewFlagSet("", flag.ContinueOnError)
-	FsQueryOwner     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateDenom                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateDenom                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTransferDenom              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMintONFT                   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTransferONFT               = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateONFTData             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateONFTRoyaltyReceivers = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQuerySupply                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryOwner                 = flag.NewFlagSet("", flag.ContinueOnError)
+	FsGrantMinter                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsApprove                    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSetONFTUser                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSignMintVoucher            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsLaunchpadMint              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryLaunchpad             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsFractionalize              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRevokeONFT                 = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryRevocations           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPrintEdition               = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -52,6 +81,11 @@ func init() {
 	FsCreateDenom.String(FlagURIHash, "", "uri hash for denom")
 	FsCreateDenom.String(FlagData, "", "json data of the denom")
 	FsCreateDenom.Bool(FlagUpdatableData, false, "allows updates to the nft data if true")
+	FsCreateDenom.Uint64(FlagMaxSupply, 0, "maximum number of nfts in the denom, 0 for unlimited")
+	FsCreateDenom.Bool(FlagRevocable, false, "allows the creator to revoke the nfts of the denom if true")
+	FsCreateDenom.Bool(FlagIndexedTraits, false, "indexes the traits of the attributes array in the nft data if true")
+	FsCreateDenom.Uint64(FlagDataHistoryRetention, types.DefaultDataHistoryRetention,
+		"number of prior data versions retained per nft, 0 disables the data history")
 
 	FsTransferDenom.String(FlagRecipient, "", "recipient of the denom")
 
@@ -66,11 +100,50 @@ func init() {
 	FsMintONFT.Bool(FlagNsfw, false, "not safe for work flag for onft")
 	FsMintONFT.String(FlagRoyaltyShare, "", "Royalty share value decimal value between 0 and 1")
 	FsMintONFT.String(FlagURIHash, "", "uri hash for the nft")
+	FsMintONFT.String(FlagRoyaltyReceivers, "", "royalty receivers of the onft, overrides the denom royalty receivers ex: \"address:percentage,address:percentage\"")
+	FsMintONFT.String(FlagTransferLockedUntil, "", "time until which the onft can not be transferred in RFC3339 format")
 
 	FsTransferONFT.String(FlagRecipient, "", "Receiver of the onft. default value is sender address of transaction")
 
 	FsUpdateONFTData.String(FlagData, "", "custom data of onft")
 
+	FsUpdateONFTRoyaltyReceivers.String(FlagRoyaltyReceivers, "", "royalty receivers of the onft ex: \"address:percentage,address:percentage\"")
+
 	FsQuerySupply.String(FlagOwner, "", "The owner of a nft")
 	FsQueryOwner.String(FlagDenomID, "", "id of the denom")
+
+	FsGrantMinter.Uint64(FlagQuota, 0, "maximum number of onfts the minter can mint, 0 for unlimited")
+	FsGrantMinter.String(FlagExpiry, "", "expiry time of the minter role in RFC3339 format")
+
+	FsApprove.String(FlagExpiry, "", "expiry time of the approval in RFC3339 format")
+
+	FsSetONFTUser.String(FlagExpiry, "", "expiry time of the user role in RFC3339 format")
+
+	FsSignMintVoucher.String(FlagMediaURI, "", "Media uri of onft")
+	FsSignMintVoucher.String(FlagPreviewURI, "", "Preview uri of onft")
+	FsSignMintVoucher.String(FlagName, "", "Name of onft")
+	FsSignMintVoucher.String(FlagDescription, "", "Description of onft")
+	FsSignMintVoucher.String(FlagData, "", "custom data of onft")
+	FsSignMintVoucher.Bool(FlagNonTransferable, false, "To mint non-transferable onft")
+	FsSignMintVoucher.Bool(FlagInExtensible, false, "To mint non-extensible onft")
+	FsSignMintVoucher.Bool(FlagNsfw, false, "not safe for work flag for onft")
+	FsSignMintVoucher.String(FlagRoyaltyShare, "", "Royalty share value decimal value between 0 and 1")
+	FsSignMintVoucher.String(FlagURIHash, "", "uri hash for the nft")
+	FsSignMintVoucher.String(FlagRoyaltyReceivers, "", "royalty receivers of the onft ex: \"address:percentage,address:percentage\"")
+	FsSignMintVoucher.String(FlagPrice, "", "price paid by the redeemer to the denom creator ex: 1000000uflix")
+	FsSignMintVoucher.String(FlagExpiry, "", "expiry time of the voucher in RFC3339 format")
+	FsSignMintVoucher.Uint64(FlagNonce, 0, "nonce of the voucher, must be unique per signer and denom")
+
+	FsLaunchpadMint.String(FlagProof, "", "hex encoded merkle proof hashes of the sender in the phase allowlist with comma separated")
+
+	FsQueryLaunchpad.String(FlagAddress, "", "address to query the mints of in each phase")
+
+	FsFractionalize.String(FlagBuyoutPrice, "", "price to buyout the onft from the fraction holders ex: 1000000uflix, buyout is disabled if not set")
+
+	FsRevokeONFT.String(FlagReason, "", "reason code of the revocation ex: expired")
+	FsRevokeONFT.Bool(FlagReclaim, false, "transfers the onft to the denom creator instead of burning it")
+
+	FsQueryRevocations.String(FlagONFTID, "", "id of the onft to query the revocations of")
+
+	FsPrintEdition.String(FlagRecipient, "", "Receiver of the edition. default value is sender address of transaction")
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +30,20 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySupply(),
 		GetCmdQueryONFT(),
 		GetCmdQueryOwner(),
+		GetCmdQueryMinters(),
+		GetCmdQueryApprovals(),
+		GetCmdQueryOperators(),
+		GetCmdQueryUserOf(),
+		GetCmdQueryDataHistory(),
+		GetCmdQueryHistory(),
+		GetCmdQueryRevocations(),
+		GetCmdQueryChildren(),
+		GetCmdQueryEditions(),
+		GetCmdQueryONFTsByTrait(),
+		GetCmdQueryTraitSummary(),
+		GetCmdQueryLaunchpad(),
+		GetCmdQueryVault(),
+		GetCmdQueryVaults(),
 		GetCmdQueryParams(),
 	)
 
@@ -270,6 +285,386 @@ $ %s query onft asset <denom> <onft-id>`, version.AppName)),
 }
 
 // GetCmdQueryParams implements the query params command.
+func GetCmdQueryDataHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "data-history [denom-id] [onft-id]",
+		Long: "Query the prior data versions of an oNFT.",
+		Example: fmt.Sprintf(
+			"$ %s query onft data-history <denom-id> <onft-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ONFTDataHistory(context.Background(), &types.QueryONFTDataHistoryRequest{
+				DenomId:    args[0],
+				OnftId:     args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "data versions")
+
+	return cmd
+}
+
+func GetCmdQueryHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "history [denom-id] [onft-id]",
+		Long: "Query the ownership history of an oNFT.",
+		Example: fmt.Sprintf(
+			"$ %s query onft history <denom-id> <onft-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ONFTHistory(context.Background(), &types.QueryONFTHistoryRequest{
+				DenomId:    args[0],
+				OnftId:     args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ownership records")
+
+	return cmd
+}
+
+func GetCmdQueryRevocations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "revocations [denom-id]",
+		Long: "Query the revocations of the oNFTs of a denom.",
+		Example: fmt.Sprintf(
+			"$ %s query onft revocations <denom-id> --onft-id=<onft-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			onftID, err := cmd.Flags().GetString(FlagONFTID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ONFTRevocations(context.Background(), &types.QueryONFTRevocationsRequest{
+				DenomId:    args[0],
+				OnftId:     onftID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryRevocations)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "revocations")
+
+	return cmd
+}
+
+func GetCmdQueryChildren() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "children [denom-id] [onft-id]",
+		Long: "Query the oNFTs nested directly in an oNFT.",
+		Example: fmt.Sprintf(
+			"$ %s query onft children <denom-id> <onft-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Children(context.Background(), &types.QueryChildrenRequest{
+				DenomId:    args[0],
+				OnftId:     args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "children")
+
+	return cmd
+}
+
+func GetCmdQueryEditions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "editions [denom-id] [master-onft-id]",
+		Long: "Query the edition cap and the printed editions of a master oNFT.",
+		Example: fmt.Sprintf(
+			"$ %s query onft editions <denom-id> <master-onft-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Editions(context.Background(), &types.QueryEditionsRequest{
+				DenomId:    args[0],
+				MasterId:   args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "editions")
+
+	return cmd
+}
+
+func GetCmdQueryONFTsByTrait() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "onfts-by-trait [denom-id] [trait-type] [value]",
+		Long: "Query the oNFTs of a denom with indexed traits that have a trait value.",
+		Example: fmt.Sprintf(
+			"$ %s query onft onfts-by-trait <denom-id> background blue",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ONFTsByTrait(context.Background(), &types.QueryONFTsByTraitRequest{
+				DenomId:    args[0],
+				TraitType:  args[1],
+				Value:      args[2],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "onfts-by-trait")
+
+	return cmd
+}
+
+func GetCmdQueryTraitSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "trait-summary [denom-id]",
+		Long: "Query the number of oNFTs of a denom with indexed traits that have each trait value.",
+		Example: fmt.Sprintf(
+			"$ %s query onft trait-summary <denom-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.TraitSummary(context.Background(), &types.QueryTraitSummaryRequest{
+				DenomId:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trait-summary")
+
+	return cmd
+}
+
+func GetCmdQueryLaunchpad() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "launchpad [denom-id]",
+		Long: "Query the launchpad and mint phases of a denom.",
+		Example: fmt.Sprintf(
+			"$ %s query onft launchpad <denom-id> --address=<address>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Launchpad(context.Background(), &types.QueryLaunchpadRequest{
+				DenomId: args[0],
+				Address: address,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryLaunchpad)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryVault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "vault [vault-id]",
+		Long: "Query a fractionalization vault by id.",
+		Example: fmt.Sprintf(
+			"$ %s query onft vault <vault-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Vault(context.Background(), &types.QueryVaultRequest{
+				Id: vaultId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryVaults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "vaults",
+		Long: "Query all fractionalization vaults.",
+		Example: fmt.Sprintf(
+			"$ %s query onft vaults",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Vaults(context.Background(), &types.QueryVaultsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vaults")
+
+	return cmd
+}
+
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
@@ -294,3 +689,144 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdQueryMinters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "minters [denom-id]",
+		Long: "Query the minters of a denom.",
+		Example: fmt.Sprintf(
+			"$ %s query onft minters <denom-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Minters(context.Background(), &types.QueryMintersRequest{
+				DenomId:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "minters")
+
+	return cmd
+}
+
+func GetCmdQueryApprovals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "approvals [denom-id] [onft-id]",
+		Long: "Query the approved spenders of an oNFT.",
+		Example: fmt.Sprintf(
+			"$ %s query onft approvals <denom-id> <onft-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Approvals(context.Background(), &types.QueryApprovalsRequest{
+				DenomId: args[0],
+				OnftId:  args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryUserOf() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "user-of [denom-id] [onft-id]",
+		Long: "Query the current user of an oNFT.",
+		Example: fmt.Sprintf(
+			"$ %s query onft user-of <denom-id> <onft-id>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.UserOf(context.Background(), &types.QueryUserOfRequest{
+				DenomId: args[0],
+				OnftId:  args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryOperators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "operators [owner]",
+		Long: "Query the approved operators of an owner.",
+		Example: fmt.Sprintf(
+			"$ %s query onft operators <owner>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Operators(context.Background(), &types.QueryOperatorsRequest{
+				Owner:      owner.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "operators")
+
+	return cmd
+}
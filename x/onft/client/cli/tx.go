@@ -2,7 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -11,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 )
@@ -34,6 +38,34 @@ func NewTxCmd() *cobra.Command {
 		GetCmdTransferONFT(),
 		GetCmdBurnONFT(),
 		GetCmdUpdateONFTData(),
+		GetCmdUpdateONFTRoyaltyReceivers(),
+		GetCmdBatchMintONFT(),
+		GetCmdBatchTransferONFT(),
+		GetCmdBatchBurnONFT(),
+		GetCmdGrantMinter(),
+		GetCmdRevokeMinter(),
+		GetCmdCloseMinting(),
+		GetCmdApprove(),
+		GetCmdRevoke(),
+		GetCmdApproveAll(),
+		GetCmdRevokeAll(),
+		GetCmdSetONFTUser(),
+		GetCmdSignMintVoucher(),
+		GetCmdRedeemMintVoucher(),
+		GetCmdSetLaunchpad(),
+		GetCmdLaunchpadMint(),
+		GetCmdFractionalize(),
+		GetCmdRedeem(),
+		GetCmdBuyout(),
+		GetCmdClaimBuyout(),
+		GetCmdRevokeONFT(),
+		GetCmdFreezeDenom(),
+		GetCmdUnfreezeDenom(),
+		GetCmdNestONFT(),
+		GetCmdUnnestONFT(),
+		GetCmdCreateEditions(),
+		GetCmdPrintEdition(),
+		GetCmdUpdateDataHistoryRetention(),
 	)
 
 	return txCmd
@@ -118,6 +150,26 @@ $ %s tx onft create [symbol] --name=<name> --schema=<schema> --description=<desc
 				return err
 			}
 
+			maxSupply, err := cmd.Flags().GetUint64(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+
+			revocable, err := cmd.Flags().GetBool(FlagRevocable)
+			if err != nil {
+				return err
+			}
+
+			indexedTraits, err := cmd.Flags().GetBool(FlagIndexedTraits)
+			if err != nil {
+				return err
+			}
+
+			dataHistoryRetention, err := cmd.Flags().GetUint64(FlagDataHistoryRetention)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateDenom(
 				symbol,
 				denomName,
@@ -131,7 +183,11 @@ $ %s tx onft create [symbol] --name=<name> --schema=<schema> --description=<desc
 				creationFee,
 				royaltyReceivers,
 				updatableData,
+				maxSupply,
+				revocable,
+				indexedTraits,
 			)
+			msg.DataHistoryRetention = dataHistoryRetention
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -166,6 +222,8 @@ Additional Flags
     --inextensible
     --nsfw
     --royalty-share="0.05"
+    --royalty-receivers="address:percentage,address:percentage"
+    --transfer-locked-until="2025-01-01T00:00:00Z"
 `,
 				version.AppName,
 			),
@@ -273,6 +331,21 @@ Additional Flags
 					return err
 				}
 			}
+			royaltyReceiversStr, err := cmd.Flags().GetString(FlagRoyaltyReceivers)
+			if err != nil {
+				return err
+			}
+			var royaltyReceivers []*types.WeightedAddress
+			if len(royaltyReceiversStr) > 0 {
+				royaltyReceivers, err = parseSplitShares(royaltyReceiversStr)
+				if err != nil {
+					return err
+				}
+			}
+			transferLockedUntil, err := parseTransferLockedUntil(cmd)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgMintONFT(
 				denomId,
@@ -284,6 +357,8 @@ Additional Flags
 				extensible,
 				nsfw,
 				royaltyShare,
+				royaltyReceivers,
+				transferLockedUntil,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -556,24 +631,1225 @@ $ %s tx onft update-onft-data [denom-id] [onft-id] --data <new-data-json-string>
 	return cmd
 }
 
-func parseSplitShares(splitSharesStr string) ([]*types.WeightedAddress, error) {
-	splitSharesStr = strings.TrimSpace(splitSharesStr)
-	splitsStrList := strings.Split(splitSharesStr, ",")
-	var weightedAddrsList []*types.WeightedAddress
-	for _, splitStr := range splitsStrList {
-		var share types.WeightedAddress
-		split := strings.Split(strings.TrimSpace(splitStr), ":")
-		address, err := sdk.AccAddressFromBech32(strings.TrimSpace(split[0]))
-		if err != nil {
-			return nil, err
-		}
-		weight, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(split[1]))
-		if err != nil {
-			return nil, err
-		}
-		share.Address = address.String()
-		share.Weight = weight
-		weightedAddrsList = append(weightedAddrsList, &share)
+func GetCmdUpdateONFTRoyaltyReceivers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-royalty-receivers [denom-id] [onft-id] --royalty-receivers <address:percentage,address:percentage>",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the royalty receivers of an oNFT, overriding the royalty receivers of the denom.
+Omit the flag to clear the override and fall back to the denom royalty receivers.
+Example:
+$ %s tx onft update-royalty-receivers [denom-id] [onft-id] --royalty-receivers="address:percentage,address:percentage" --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denomId := args[0]
+			onftId := args[1]
+
+			royaltyReceiversStr, err := cmd.Flags().GetString(FlagRoyaltyReceivers)
+			if err != nil {
+				return err
+			}
+			var royaltyReceivers []*types.WeightedAddress
+			if len(royaltyReceiversStr) > 0 {
+				royaltyReceivers, err = parseSplitShares(royaltyReceiversStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdateONFTRoyaltyReceivers(
+				denomId,
+				onftId,
+				royaltyReceivers,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
-	return weightedAddrsList, nil
+	cmd.Flags().AddFlagSet(FsUpdateONFTRoyaltyReceivers)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBatchMintONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "batch-mint [denom-id] [manifest-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint multiple oNFTs from a json manifest file.
+Example:
+$ %s tx onft batch-mint [denom-id] [path/to/manifest.json] --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+
+Manifest file format:
+{
+  "onfts": [
+    {
+      "id": "<optional onft-id>",
+      "name": "<onft-name>",
+      "description": "<onft-description>",
+      "media_uri": "<media-uri>",
+      "uri_hash": "<uri-hash>",
+      "preview_uri": "<preview-uri>",
+      "data": "<json-data-string>",
+      "transferable": true,
+      "extensible": true,
+      "nsfw": false,
+      "royalty_share": "0.05",
+      "royalty_receivers": "<optional address:percentage,address:percentage>",
+      "recipient": "<optional recipient, default is sender>",
+      "transfer_locked_until": "<optional RFC3339 timestamp>"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := args[0]
+			sender := clientCtx.GetFromAddress().String()
+
+			onfts, err := parseBatchMintManifest(args[1], sender)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchMintONFT(denomId, sender, onfts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBatchTransferONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "batch-transfer [manifest-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer multiple oNFTs from a json manifest file.
+Example:
+$ %s tx onft batch-transfer [path/to/manifest.json] --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+
+Manifest file format:
+{
+  "onfts": [
+    {"id": "<onft-id>", "denom_id": "<denom-id>", "recipient": "<recipient>"}
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			onfts, err := parseBatchTransferManifest(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchTransferONFT(clientCtx.GetFromAddress().String(), onfts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBatchBurnONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "batch-burn [manifest-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn multiple oNFTs from a json manifest file.
+Example:
+$ %s tx onft batch-burn [path/to/manifest.json] --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+
+Manifest file format:
+{
+  "onfts": [
+    {"id": "<onft-id>", "denom_id": "<denom-id>"}
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			onfts, err := parseBatchBurnManifest(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchBurnONFT(clientCtx.GetFromAddress().String(), onfts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSetONFTUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-user [denom-id] [onft-id] [user]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the user of an oNFT until the expiry, the owner keeps the ownership of the oNFT.
+Expiry is a RFC3339 timestamp after which the user role lapses. Omit the user to remove the current user.
+Example:
+$ %s tx onft set-user [denom-id] [onft-id] [user] --expiry="2025-01-01T00:00:00Z" --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+$ %s tx onft set-user [denom-id] [onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var (
+				user   string
+				expiry time.Time
+			)
+			if len(args) == 3 {
+				userAddr, err := sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+				user = userAddr.String()
+				expiryTime, err := parseExpiry(cmd)
+				if err != nil {
+					return err
+				}
+				if expiryTime == nil {
+					return fmt.Errorf("--%s flag is required to set a user", FlagExpiry)
+				}
+				expiry = *expiryTime
+			}
+
+			msg := types.NewMsgSetONFTUser(
+				args[0],
+				args[1],
+				user,
+				expiry,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSetONFTUser)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSignMintVoucher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "sign-mint-voucher [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign a mint voucher offline as a denom creator or minter and print the signed voucher.
+The signed voucher can be redeemed by anyone with redeem-mint-voucher command, the redeemer pays
+the price to the denom creator and receives the minted oNFT.
+Example:
+$ %s tx onft sign-mint-voucher [denom-id] [onft-id] \
+	--name <onft-name> \
+	--media-uri=<uri> \
+	--price=1000000uflix \
+	--expiry="2025-01-01T00:00:00Z" \
+	--nonce=1 \
+	--from=<key-name> \
+	--chain-id=<chain-id> > voucher.json
+
+Additional Flags
+    --description=<onft-description>
+    --preview-uri=<uri>
+    --uri-hash=<uri-hash>
+    --data=<json-data>
+    --non-transferable
+    --inextensible
+    --nsfw
+    --royalty-share="0.05"
+    --royalty-receivers="address:percentage,address:percentage"
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("--%s flag is required to sign a voucher", flags.FlagChainID)
+			}
+
+			voucher, err := parseMintVoucherFlags(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			voucher.Signer = clientCtx.GetFromAddress().String()
+			if err := voucher.Validate(); err != nil {
+				return err
+			}
+
+			signature, pubKey, err := clientCtx.Keyring.Sign(
+				clientCtx.FromName,
+				voucher.GetSignBytes(clientCtx.ChainID),
+				signing.SignMode_SIGN_MODE_DIRECT,
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(types.NewMsgRedeemMintVoucher(voucher, pubKey.Bytes(), signature, ""))
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSignMintVoucher)
+	_ = cmd.MarkFlagRequired(FlagExpiry)
+	_ = cmd.MarkFlagRequired(FlagNonce)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRedeemMintVoucher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "redeem-mint-voucher [voucher-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem a signed mint voucher, pays the voucher price to the denom creator and mints the oNFT to the sender.
+Example:
+$ %s tx onft redeem-mint-voucher [path/to/voucher.json] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var msg types.MsgRedeemMintVoucher
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &msg); err != nil {
+				return fmt.Errorf("failed to parse voucher file %s: %w", args[0], err)
+			}
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSetLaunchpad() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-launchpad [denom-id] [launchpad-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the launchpad and mint phases of a denom from a json file, only the denom creator can set it.
+Minted oNFTs are named "<name> #<token-number>" with media uri "<media_uri>/<token-number>".
+Example:
+$ %s tx onft set-launchpad [denom-id] [path/to/launchpad.json] --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+
+Launchpad file format:
+{
+  "name": "<onft-name>",
+  "description": "<onft-description>",
+  "media_uri": "<base-media-uri>",
+  "preview_uri": "<optional base-preview-uri>",
+  "data": "<json-data-string>",
+  "transferable": true,
+  "extensible": true,
+  "nsfw": false,
+  "royalty_share": "0.05",
+  "split_shares": "<optional address:percentage,address:percentage>",
+  "phases": [
+    {
+      "id": 1,
+      "name": "allowlist",
+      "start_time": "2025-01-01T00:00:00Z",
+      "end_time": "2025-01-02T00:00:00Z",
+      "price": "1000000uflix",
+      "merkle_root": "<optional hex encoded allowlist merkle root>",
+      "per_wallet_limit": 2,
+      "supply": 100
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchpad, err := parseLaunchpadFile(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetLaunchpad(launchpad, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdLaunchpadMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "launchpad-mint [denom-id] [phase-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint an oNFT from the launchpad of a denom in an active mint phase, the phase price is paid by the sender.
+Example:
+$ %s tx onft launchpad-mint [denom-id] [phase-id] --proof="<hash>,<hash>" --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			phaseId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			proofStr, err := cmd.Flags().GetString(FlagProof)
+			if err != nil {
+				return err
+			}
+			proof, err := parseMerkleProof(proofStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLaunchpadMint(args[0], phaseId, proof, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsLaunchpadMint)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdFractionalize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fractionalize [denom-id] [onft-id] [supply]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock an oNFT in a vault and mint the given supply of fraction tokens to the sender.
+The tokenfactory denom creation fee is paid by the sender.
+Example:
+$ %s tx onft fractionalize [denom-id] [onft-id] [supply] --buyout-price=<price> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			supply, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid supply %s", args[2])
+			}
+			buyoutPriceStr, err := cmd.Flags().GetString(FlagBuyoutPrice)
+			if err != nil {
+				return err
+			}
+			buyoutPrice := sdk.NewCoin(types.DefaultDenomCreationFee.Denom, sdkmath.ZeroInt())
+			if len(buyoutPriceStr) > 0 {
+				buyoutPrice, err = sdk.ParseCoinNormalized(buyoutPriceStr)
+				if err != nil {
+					return fmt.Errorf("failed to parse buyout price: %s", buyoutPriceStr)
+				}
+			}
+
+			msg := types.NewMsgFractionalize(args[0], args[1], supply, buyoutPrice, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsFractionalize)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRedeem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "redeem [vault-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the full fraction supply of a vault and release the oNFT to the sender.
+Example:
+$ %s tx onft redeem [vault-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeem(vaultId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBuyout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "buyout [vault-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy the oNFT of a vault for its buyout price, the price is claimable by the fraction holders.
+Example:
+$ %s tx onft buyout [vault-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyout(vaultId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClaimBuyout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "claim-buyout [vault-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the fractions held by the sender and claim the pro-rata share of the buyout proceeds of a vault.
+Example:
+$ %s tx onft claim-buyout [vault-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vaultId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimBuyout(vaultId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRevokeONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke-onft [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke an oNFT of a revocable denom from its holder, burns the oNFT unless reclaim is set.
+Example:
+$ %s tx onft revoke-onft [denom-id] [onft-id] --reason=expired --reclaim --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := args[0]
+			onftId := args[1]
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+			reclaim, err := cmd.Flags().GetBool(FlagReclaim)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeONFT(denomId, onftId, reason, reclaim, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRevokeONFT)
+	_ = cmd.MarkFlagRequired(FlagReason)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseSplitShares(splitSharesStr string) ([]*types.WeightedAddress, error) {
+	splitSharesStr = strings.TrimSpace(splitSharesStr)
+	splitsStrList := strings.Split(splitSharesStr, ",")
+	var weightedAddrsList []*types.WeightedAddress
+	for _, splitStr := range splitsStrList {
+		var share types.WeightedAddress
+		split := strings.Split(strings.TrimSpace(splitStr), ":")
+		address, err := sdk.AccAddressFromBech32(strings.TrimSpace(split[0]))
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(split[1]))
+		if err != nil {
+			return nil, err
+		}
+		share.Address = address.String()
+		share.Weight = weight
+		weightedAddrsList = append(weightedAddrsList, &share)
+	}
+	return weightedAddrsList, nil
+}
+
+func GetCmdGrantMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "grant-minter [denom-id] [minter]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow an address to mint oNFTs under a denom.
+Quota is the maximum number of oNFTs the minter can mint (0 for unlimited).
+Expiry is an optional RFC3339 timestamp after which the minter role is no longer valid.
+Example:
+$ %s tx onft grant-minter [denom-id] [minter] --quota=100 --expiry="2025-01-01T00:00:00Z" --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denomId := args[0]
+			minter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			quota, err := cmd.Flags().GetUint64(FlagQuota)
+			if err != nil {
+				return err
+			}
+			expiry, err := parseExpiry(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantMinter(
+				denomId,
+				minter.String(),
+				quota,
+				expiry,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsGrantMinter)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRevokeMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke-minter [denom-id] [minter]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the minter role of an address on a denom.
+Example:
+$ %s tx onft revoke-minter [denom-id] [minter] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denomId := args[0]
+			minter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeMinter(
+				denomId,
+				minter.String(),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCloseMinting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "close-minting [denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Permanently close minting of new oNFTs under a denom. This action can not be undone.
+Example:
+$ %s tx onft close-minting [denom-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseMinting(
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "approve [denom-id] [onft-id] [spender]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow a spender to transfer an oNFT on behalf of the owner.
+Expiry is an optional RFC3339 timestamp after which the approval is no longer valid.
+Example:
+$ %s tx onft approve [denom-id] [onft-id] [spender] --expiry="2025-01-01T00:00:00Z" --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			expiry, err := parseExpiry(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApprove(
+				args[0],
+				args[1],
+				spender.String(),
+				expiry,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsApprove)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRevoke() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke [denom-id] [onft-id] [spender]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the approval of a spender on an oNFT.
+Example:
+$ %s tx onft revoke [denom-id] [onft-id] [spender] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(
+				args[0],
+				args[1],
+				spender.String(),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdApproveAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "approve-all [operator]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow an operator to transfer all oNFTs of the sender.
+Expiry is an optional RFC3339 timestamp after which the approval is no longer valid.
+Example:
+$ %s tx onft approve-all [operator] --expiry="2025-01-01T00:00:00Z" --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			expiry, err := parseExpiry(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveAll(
+				operator.String(),
+				expiry,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsApprove)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRevokeAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke-all [operator]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the approval of an operator.
+Example:
+$ %s tx onft revoke-all [operator] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAll(
+				operator.String(),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdFreezeDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "freeze-denom [denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a denom, blocks transfers, marketplace listings and ics-721 sends of its oNFTs until unfrozen.
+Example:
+$ %s tx onft freeze-denom [denom-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeDenom(
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUnfreezeDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unfreeze-denom [denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze a frozen denom.
+Example:
+$ %s tx onft unfreeze-denom [denom-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeDenom(
+				args[0],
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdNestONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "nest-onft [denom-id] [onft-id] [parent-denom-id] [parent-onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Nest an oNFT in a parent oNFT, the nested oNFT moves with the parent on transfers.
+Example:
+$ %s tx onft nest-onft [denom-id] [onft-id] [parent-denom-id] [parent-onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgNestONFT(
+				args[0],
+				args[1],
+				args[2],
+				args[3],
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUnnestONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unnest-onft [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unnest an oNFT from its parent oNFT to the owner of the parent.
+Example:
+$ %s tx onft unnest-onft [denom-id] [onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnnestONFT(
+				args[0],
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCreateEditions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-editions [denom-id] [master-onft-id] [max-editions]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the max number of numbered editions that can be printed from a master oNFT, the cap can't be changed later.
+Example:
+$ %s tx onft create-editions [denom-id] [master-onft-id] 50 --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxEditions, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max editions %s: %w", args[2], err)
+			}
+
+			msg := types.NewMsgCreateEditions(
+				args[0],
+				args[1],
+				maxEditions,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdPrintEdition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "print-edition [denom-id] [master-onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Print the next numbered edition of a master oNFT, the edition inherits the media and royalties of the master.
+Example:
+$ %s tx onft print-edition [denom-id] [master-onft-id] --recipient=<recipient> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+			if len(recipient) == 0 {
+				recipient = sender
+			}
+
+			msg := types.NewMsgPrintEdition(
+				args[0],
+				args[1],
+				types.GenUniqueID(types.IDPrefix),
+				recipient,
+				sender,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsPrintEdition)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUpdateDataHistoryRetention() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-data-history-retention [denom-id] [retention]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the number of prior data versions retained per oNFT of a denom, 0 disables the data history.
+The retention is capped by the max data history retention param.
+Example:
+$ %s tx onft update-data-history-retention [denom-id] 20 --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			retention, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDataHistoryRetention(
+				args[0],
+				retention,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
 }
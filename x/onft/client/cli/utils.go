@@ -0,0 +1,366 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// batchMintManifest defines the json manifest file format used by batch-mint command
+type batchMintManifest struct {
+	ONFTs []batchMintItem `json:"onfts"`
+}
+
+type batchMintItem struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	MediaURI     string `json:"media_uri"`
+	URIHash      string `json:"uri_hash"`
+	PreviewURI   string `json:"preview_uri"`
+	Data         string `json:"data"`
+	Transferable *bool  `json:"transferable"`
+	Extensible   *bool  `json:"extensible"`
+	Nsfw         bool   `json:"nsfw"`
+	RoyaltyShare string `json:"royalty_share"`
+	// RoyaltyReceivers is formatted as "address:percentage,address:percentage"
+	RoyaltyReceivers string `json:"royalty_receivers"`
+	Recipient        string `json:"recipient"`
+	// TransferLockedUntil is an optional RFC3339 timestamp
+	TransferLockedUntil string `json:"transfer_locked_until"`
+}
+
+// launchpadFile defines the json file format used by set-launchpad command
+type launchpadFile struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	MediaURI     string `json:"media_uri"`
+	PreviewURI   string `json:"preview_uri"`
+	Data         string `json:"data"`
+	Transferable *bool  `json:"transferable"`
+	Extensible   *bool  `json:"extensible"`
+	Nsfw         bool   `json:"nsfw"`
+	RoyaltyShare string `json:"royalty_share"`
+	// SplitShares is formatted as "address:percentage,address:percentage"
+	SplitShares string          `json:"split_shares"`
+	Phases      []mintPhaseItem `json:"phases"`
+}
+
+type mintPhaseItem struct {
+	Id        uint64 `json:"id"`
+	Name      string `json:"name"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Price     string `json:"price"`
+	// MerkleRoot is the hex encoded root of the allowlist
+	MerkleRoot     string `json:"merkle_root"`
+	PerWalletLimit uint64 `json:"per_wallet_limit"`
+	Supply         uint64 `json:"supply"`
+}
+
+// batchTransferManifest defines the json manifest file format used by batch-transfer command
+type batchTransferManifest struct {
+	ONFTs []types.TransferONFTItem `json:"onfts"`
+}
+
+// batchBurnManifest defines the json manifest file format used by batch-burn command
+type batchBurnManifest struct {
+	ONFTs []types.BurnONFTItem `json:"onfts"`
+}
+
+func readManifest(manifestFile string, manifest interface{}) error {
+	if manifestFile == "" {
+		return fmt.Errorf("manifest file path not provided")
+	}
+	contents, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(contents, manifest); err != nil {
+		return fmt.Errorf("failed to parse manifest file %s: %w", manifestFile, err)
+	}
+	return nil
+}
+
+func parseBatchMintManifest(manifestFile, sender string) ([]types.MintONFTItem, error) {
+	var manifest batchMintManifest
+	if err := readManifest(manifestFile, &manifest); err != nil {
+		return nil, err
+	}
+
+	items := make([]types.MintONFTItem, 0, len(manifest.ONFTs))
+	for _, onft := range manifest.ONFTs {
+		item := types.MintONFTItem{
+			Id: onft.Id,
+			Metadata: types.Metadata{
+				Name:        onft.Name,
+				Description: onft.Description,
+				MediaURI:    onft.MediaURI,
+				PreviewURI:  onft.PreviewURI,
+				UriHash:     onft.URIHash,
+			},
+			Data:         onft.Data,
+			Transferable: true,
+			Extensible:   true,
+			Nsfw:         onft.Nsfw,
+			RoyaltyShare: sdkmath.LegacyZeroDec(),
+			Recipient:    onft.Recipient,
+		}
+		if len(item.Id) == 0 {
+			item.Id = types.GenUniqueID(types.IDPrefix)
+		}
+		if len(item.Recipient) == 0 {
+			item.Recipient = sender
+		}
+		if onft.Transferable != nil {
+			item.Transferable = *onft.Transferable
+		}
+		if onft.Extensible != nil {
+			item.Extensible = *onft.Extensible
+		}
+		if len(onft.RoyaltyShare) > 0 {
+			royaltyShare, err := sdkmath.LegacyNewDecFromStr(onft.RoyaltyShare)
+			if err != nil {
+				return nil, err
+			}
+			item.RoyaltyShare = royaltyShare
+		}
+		if len(onft.RoyaltyReceivers) > 0 {
+			royaltyReceivers, err := parseSplitShares(onft.RoyaltyReceivers)
+			if err != nil {
+				return nil, err
+			}
+			item.RoyaltyReceivers = royaltyReceivers
+		}
+		if len(onft.TransferLockedUntil) > 0 {
+			lockedUntil, err := time.Parse(time.RFC3339, onft.TransferLockedUntil)
+			if err != nil {
+				return nil, fmt.Errorf("invalid transfer lock time %s, must be in RFC3339 format: %w", onft.TransferLockedUntil, err)
+			}
+			item.TransferLockedUntil = &lockedUntil
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func parseBatchTransferManifest(manifestFile string) ([]types.TransferONFTItem, error) {
+	var manifest batchTransferManifest
+	if err := readManifest(manifestFile, &manifest); err != nil {
+		return nil, err
+	}
+	return manifest.ONFTs, nil
+}
+
+func parseBatchBurnManifest(manifestFile string) ([]types.BurnONFTItem, error) {
+	var manifest batchBurnManifest
+	if err := readManifest(manifestFile, &manifest); err != nil {
+		return nil, err
+	}
+	return manifest.ONFTs, nil
+}
+
+// parseExpiry reads the optional RFC3339 expiry flag of the command
+func parseExpiry(cmd *cobra.Command) (*time.Time, error) {
+	expiryStr, err := cmd.Flags().GetString(FlagExpiry)
+	if err != nil {
+		return nil, err
+	}
+	if len(expiryStr) == 0 {
+		return nil, nil
+	}
+	expiry, err := time.Parse(time.RFC3339, expiryStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiry %s, must be in RFC3339 format: %w", expiryStr, err)
+	}
+	return &expiry, nil
+}
+
+// parseTransferLockedUntil reads the optional RFC3339 transfer lock flag of the command
+func parseTransferLockedUntil(cmd *cobra.Command) (*time.Time, error) {
+	lockedUntilStr, err := cmd.Flags().GetString(FlagTransferLockedUntil)
+	if err != nil {
+		return nil, err
+	}
+	if len(lockedUntilStr) == 0 {
+		return nil, nil
+	}
+	lockedUntil, err := time.Parse(time.RFC3339, lockedUntilStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transfer lock time %s, must be in RFC3339 format: %w", lockedUntilStr, err)
+	}
+	return &lockedUntil, nil
+}
+
+// parseMintVoucherFlags reads the mint voucher of an onft from the sign-mint-voucher command flags
+func parseMintVoucherFlags(cmd *cobra.Command, denomID, onftID string) (types.MintVoucher, error) {
+	voucher := types.MintVoucher{
+		DenomId:      denomID,
+		Id:           onftID,
+		RoyaltyShare: sdkmath.LegacyZeroDec(),
+	}
+	var err error
+	if voucher.Metadata.Name, err = cmd.Flags().GetString(FlagName); err != nil {
+		return voucher, err
+	}
+	if voucher.Metadata.Description, err = cmd.Flags().GetString(FlagDescription); err != nil {
+		return voucher, err
+	}
+	if voucher.Metadata.MediaURI, err = cmd.Flags().GetString(FlagMediaURI); err != nil {
+		return voucher, err
+	}
+	if voucher.Metadata.PreviewURI, err = cmd.Flags().GetString(FlagPreviewURI); err != nil {
+		return voucher, err
+	}
+	if voucher.Metadata.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+		return voucher, err
+	}
+	if voucher.Data, err = cmd.Flags().GetString(FlagData); err != nil {
+		return voucher, err
+	}
+	nonTransferable, err := cmd.Flags().GetBool(FlagNonTransferable)
+	if err != nil {
+		return voucher, err
+	}
+	voucher.Transferable = !nonTransferable
+	inExtensible, err := cmd.Flags().GetBool(FlagInExtensible)
+	if err != nil {
+		return voucher, err
+	}
+	voucher.Extensible = !inExtensible
+	if voucher.Nsfw, err = cmd.Flags().GetBool(FlagNsfw); err != nil {
+		return voucher, err
+	}
+	royaltyShareStr, err := cmd.Flags().GetString(FlagRoyaltyShare)
+	if err != nil {
+		return voucher, err
+	}
+	if len(royaltyShareStr) > 0 {
+		if voucher.RoyaltyShare, err = sdkmath.LegacyNewDecFromStr(royaltyShareStr); err != nil {
+			return voucher, err
+		}
+	}
+	royaltyReceiversStr, err := cmd.Flags().GetString(FlagRoyaltyReceivers)
+	if err != nil {
+		return voucher, err
+	}
+	if len(royaltyReceiversStr) > 0 {
+		if voucher.RoyaltyReceivers, err = parseSplitShares(royaltyReceiversStr); err != nil {
+			return voucher, err
+		}
+	}
+	priceStr, err := cmd.Flags().GetString(FlagPrice)
+	if err != nil {
+		return voucher, err
+	}
+	if len(priceStr) > 0 {
+		if voucher.Price, err = sdk.ParseCoinNormalized(priceStr); err != nil {
+			return voucher, err
+		}
+	} else {
+		voucher.Price = sdk.NewInt64Coin(types.DefaultDenomCreationFee.Denom, 0)
+	}
+	expiry, err := parseExpiry(cmd)
+	if err != nil {
+		return voucher, err
+	}
+	if expiry == nil {
+		return voucher, fmt.Errorf("--%s flag is required to sign a voucher", FlagExpiry)
+	}
+	voucher.Expiry = *expiry
+	if voucher.Nonce, err = cmd.Flags().GetUint64(FlagNonce); err != nil {
+		return voucher, err
+	}
+	return voucher, nil
+}
+
+func parseLaunchpadFile(denomID, launchpadFilePath string) (types.Launchpad, error) {
+	var file launchpadFile
+	if err := readManifest(launchpadFilePath, &file); err != nil {
+		return types.Launchpad{}, err
+	}
+
+	launchpad := types.Launchpad{
+		DenomId:      denomID,
+		Name:         file.Name,
+		Description:  file.Description,
+		MediaURI:     file.MediaURI,
+		PreviewURI:   file.PreviewURI,
+		Data:         file.Data,
+		Transferable: true,
+		Extensible:   true,
+		Nsfw:         file.Nsfw,
+		RoyaltyShare: sdkmath.LegacyZeroDec(),
+	}
+	if file.Transferable != nil {
+		launchpad.Transferable = *file.Transferable
+	}
+	if file.Extensible != nil {
+		launchpad.Extensible = *file.Extensible
+	}
+	if len(file.RoyaltyShare) > 0 {
+		royaltyShare, err := sdkmath.LegacyNewDecFromStr(file.RoyaltyShare)
+		if err != nil {
+			return launchpad, err
+		}
+		launchpad.RoyaltyShare = royaltyShare
+	}
+	if len(file.SplitShares) > 0 {
+		splitShares, err := parseSplitShares(file.SplitShares)
+		if err != nil {
+			return launchpad, err
+		}
+		launchpad.SplitShares = splitShares
+	}
+	for _, item := range file.Phases {
+		phase := types.MintPhase{
+			Id:             item.Id,
+			Name:           item.Name,
+			PerWalletLimit: item.PerWalletLimit,
+			Supply:         item.Supply,
+		}
+		var err error
+		if phase.StartTime, err = time.Parse(time.RFC3339, item.StartTime); err != nil {
+			return launchpad, fmt.Errorf("invalid start time of phase %d: %w", item.Id, err)
+		}
+		if phase.EndTime, err = time.Parse(time.RFC3339, item.EndTime); err != nil {
+			return launchpad, fmt.Errorf("invalid end time of phase %d: %w", item.Id, err)
+		}
+		if phase.Price, err = sdk.ParseCoinNormalized(item.Price); err != nil {
+			return launchpad, fmt.Errorf("invalid price of phase %d: %w", item.Id, err)
+		}
+		if len(item.MerkleRoot) > 0 {
+			if phase.MerkleRoot, err = hex.DecodeString(item.MerkleRoot); err != nil {
+				return launchpad, fmt.Errorf("invalid merkle root of phase %d: %w", item.Id, err)
+			}
+		}
+		launchpad.Phases = append(launchpad.Phases, phase)
+	}
+	return launchpad, nil
+}
+
+// parseMerkleProof parses comma separated hex encoded merkle proof hashes
+func parseMerkleProof(proofStr string) ([][]byte, error) {
+	proofStr = strings.TrimSpace(proofStr)
+	if len(proofStr) == 0 {
+		return nil, nil
+	}
+	var proof [][]byte
+	for _, hashStr := range strings.Split(proofStr, ",") {
+		hash, err := hex.DecodeString(strings.TrimSpace(hashStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof hash %s: %w", hashStr, err)
+		}
+		proof = append(proof, hash)
+	}
+	return proof, nil
+}
@@ -16,6 +16,49 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, minter := range data.Minters {
+		k.SetMinter(ctx, minter)
+	}
+	for _, approval := range data.Approvals {
+		k.SetApproval(ctx, approval)
+	}
+	for _, operator := range data.Operators {
+		k.SetOperatorApproval(ctx, operator)
+	}
+	for _, user := range data.Users {
+		k.SetONFTUser(ctx, user)
+	}
+	for _, version := range data.DataHistory {
+		k.SetDataVersion(ctx, version)
+	}
+	for _, nonce := range data.VoucherNonces {
+		k.SetVoucherNonce(ctx, nonce)
+	}
+	for _, launchpad := range data.Launchpads {
+		k.SetLaunchpadState(ctx, launchpad)
+	}
+	for _, walletMint := range data.LaunchpadWalletMints {
+		k.SetLaunchpadWalletMint(ctx, walletMint)
+	}
+	for _, vault := range data.Vaults {
+		k.SetVault(ctx, vault)
+	}
+	k.SetNextVaultID(ctx, data.NextVaultId)
+	for _, revocation := range data.Revocations {
+		k.SetRevocation(ctx, revocation)
+	}
+	for _, nesting := range data.Nestings {
+		k.SetNesting(ctx, nesting)
+	}
+	for _, editionSet := range data.EditionSets {
+		k.SetEditionSet(ctx, editionSet)
+	}
+	for _, edition := range data.Editions {
+		k.SetEdition(ctx, edition)
+	}
+	for _, record := range data.OwnershipHistory {
+		k.SetOwnershipRecord(ctx, record)
+	}
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -26,9 +69,45 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err.Error())
 	}
-	return types.NewGenesisState(collections, k.GetParams(ctx))
+	return types.NewGenesisState(
+		collections,
+		k.GetParams(ctx),
+		k.GetAllMinters(ctx),
+		k.GetAllApprovals(ctx),
+		k.GetAllOperatorApprovals(ctx),
+		k.GetAllONFTUsers(ctx),
+		k.GetAllDataVersions(ctx),
+		k.GetAllVoucherNonces(ctx),
+		k.GetAllLaunchpads(ctx),
+		k.GetAllLaunchpadWalletMints(ctx),
+		k.GetAllVaults(ctx),
+		k.GetNextVaultID(ctx),
+		k.GetAllRevocations(ctx),
+		k.GetAllNestings(ctx),
+		k.GetAllEditionSets(ctx),
+		k.GetAllEditions(ctx),
+		k.GetAllOwnershipRecords(ctx),
+	)
 }
 
 func DefaultGenesisState() *types.GenesisState {
-	return types.NewGenesisState([]types.Collection{}, types.DefaultParams())
+	return types.NewGenesisState(
+		[]types.Collection{},
+		types.DefaultParams(),
+		[]types.Minter{},
+		[]types.Approval{},
+		[]types.OperatorApproval{},
+		[]types.ONFTUser{},
+		[]types.ONFTDataVersion{},
+		[]types.VoucherNonce{},
+		[]types.Launchpad{},
+		[]types.LaunchpadWalletMint{},
+		[]types.Vault{},
+		1,
+		[]types.Revocation{},
+		[]types.Nesting{},
+		[]types.EditionSet{},
+		[]types.Edition{},
+		[]types.OwnershipRecord{},
+	)
 }
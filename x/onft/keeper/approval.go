@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// Approve allows spender to transfer the onft on behalf of its owner.
+// sender must be the owner of the onft or an approved operator of the owner.
+func (k Keeper) Approve(
+	ctx sdk.Context,
+	denomID,
+	onftID string,
+	spender sdk.AccAddress,
+	expiry *time.Time,
+	sender sdk.AccAddress,
+) error {
+	owner, err := k.authorizeOwnerOrOperator(ctx, denomID, onftID, sender)
+	if err != nil {
+		return err
+	}
+	if spender.Equals(owner) {
+		return errorsmod.Wrap(types.ErrInvalidApproval, "owner can not be approved as spender")
+	}
+	if expiry != nil && !expiry.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidApproval,
+			"expiry %s must be after current block time", expiry.String(),
+		)
+	}
+
+	k.SetApproval(ctx, types.NewApproval(denomID, onftID, spender, expiry))
+
+	k.emitApproveONFTEvent(ctx, denomID, onftID, owner.String(), spender.String())
+	return nil
+}
+
+// Revoke removes the approval of spender on the onft.
+// sender must be the owner of the onft or an approved operator of the owner.
+func (k Keeper) Revoke(ctx sdk.Context, denomID, onftID string, spender, sender sdk.AccAddress) error {
+	owner, err := k.authorizeOwnerOrOperator(ctx, denomID, onftID, sender)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetApproval(ctx, denomID, onftID, spender); !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidApproval,
+			"%s is not approved to transfer nft %s of denom %s", spender.String(), onftID, denomID,
+		)
+	}
+	k.DeleteApproval(ctx, denomID, onftID, spender)
+
+	k.emitRevokeONFTEvent(ctx, denomID, onftID, owner.String(), spender.String())
+	return nil
+}
+
+// ApproveAll allows operator to transfer all onfts of the owner
+func (k Keeper) ApproveAll(ctx sdk.Context, owner, operator sdk.AccAddress, expiry *time.Time) error {
+	if expiry != nil && !expiry.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidApproval,
+			"expiry %s must be after current block time", expiry.String(),
+		)
+	}
+	k.SetOperatorApproval(ctx, types.NewOperatorApproval(owner, operator, expiry))
+
+	k.emitApproveAllEvent(ctx, owner.String(), operator.String())
+	return nil
+}
+
+// RevokeAll removes the operator approval of the owner
+func (k Keeper) RevokeAll(ctx sdk.Context, owner, operator sdk.AccAddress) error {
+	if _, found := k.GetOperatorApproval(ctx, owner, operator); !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidApproval,
+			"%s is not an operator of %s", operator.String(), owner.String(),
+		)
+	}
+	k.DeleteOperatorApproval(ctx, owner, operator)
+
+	k.emitRevokeAllEvent(ctx, owner.String(), operator.String())
+	return nil
+}
+
+// IsApprovedSpender returns true if spender has an unexpired approval on the onft
+func (k Keeper) IsApprovedSpender(ctx sdk.Context, denomID, onftID string, spender sdk.AccAddress) bool {
+	approval, found := k.GetApproval(ctx, denomID, onftID, spender)
+	return found && !approval.IsExpired(ctx.BlockTime())
+}
+
+// IsApprovedOperator returns true if operator has an unexpired operator approval of the owner
+func (k Keeper) IsApprovedOperator(ctx sdk.Context, owner, operator sdk.AccAddress) bool {
+	approval, found := k.GetOperatorApproval(ctx, owner, operator)
+	return found && !approval.IsExpired(ctx.BlockTime())
+}
+
+// AuthorizeSpender returns the owner of the onft if sender is the owner,
+// an approved spender of the onft or an approved operator of the owner
+func (k Keeper) AuthorizeSpender(ctx sdk.Context, denomID, onftID string, sender sdk.AccAddress) (sdk.AccAddress, error) {
+	owner, err := k.getExistingOwner(ctx, denomID, onftID)
+	if err != nil {
+		return nil, err
+	}
+	if sender.Equals(owner) ||
+		k.IsApprovedSpender(ctx, denomID, onftID, sender) ||
+		k.IsApprovedOperator(ctx, owner, sender) {
+		return owner, nil
+	}
+	return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not authorized", sender.String())
+}
+
+func (k Keeper) authorizeOwnerOrOperator(ctx sdk.Context, denomID, onftID string, sender sdk.AccAddress) (sdk.AccAddress, error) {
+	owner, err := k.getExistingOwner(ctx, denomID, onftID)
+	if err != nil {
+		return nil, err
+	}
+	if !sender.Equals(owner) && !k.IsApprovedOperator(ctx, owner, sender) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not authorized", sender.String())
+	}
+	return owner, nil
+}
+
+func (k Keeper) getExistingOwner(ctx sdk.Context, denomID, onftID string) (sdk.AccAddress, error) {
+	if !k.nk.HasClass(ctx, denomID) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+	if !k.nk.HasNFT(ctx, denomID, onftID) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", onftID)
+	}
+	return k.nk.GetOwner(ctx, denomID, onftID), nil
+}
+
+func (k Keeper) SetApproval(ctx sdk.Context, approval types.Approval) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&approval)
+	store.Set(types.KeyApproval(approval.DenomId, approval.OnftId, approval.GetSpender()), bz)
+}
+
+func (k Keeper) GetApproval(ctx sdk.Context, denomID, onftID string, spender sdk.AccAddress) (approval types.Approval, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyApproval(denomID, onftID, spender))
+	if bz == nil {
+		return approval, false
+	}
+	k.cdc.MustUnmarshal(bz, &approval)
+	return approval, true
+}
+
+func (k Keeper) DeleteApproval(ctx sdk.Context, denomID, onftID string, spender sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyApproval(denomID, onftID, spender))
+}
+
+// GetApprovals returns all approvals of an onft
+func (k Keeper) GetApprovals(ctx sdk.Context, denomID, onftID string) (approvals []types.Approval) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyApprovalPrefix(denomID, onftID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.Approval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
+
+// DeleteApprovals removes all approvals of an onft
+func (k Keeper) DeleteApprovals(ctx sdk.Context, denomID, onftID string) {
+	for _, approval := range k.GetApprovals(ctx, denomID, onftID) {
+		k.DeleteApproval(ctx, denomID, onftID, approval.GetSpender())
+	}
+}
+
+// GetAllApprovals returns approvals of all onfts
+func (k Keeper) GetAllApprovals(ctx sdk.Context) (approvals []types.Approval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixApproval)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.Approval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
+
+func (k Keeper) SetOperatorApproval(ctx sdk.Context, approval types.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&approval)
+	store.Set(types.KeyOperator(approval.GetOwner(), approval.GetOperator()), bz)
+}
+
+func (k Keeper) GetOperatorApproval(ctx sdk.Context, owner, operator sdk.AccAddress) (approval types.OperatorApproval, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyOperator(owner, operator))
+	if bz == nil {
+		return approval, false
+	}
+	k.cdc.MustUnmarshal(bz, &approval)
+	return approval, true
+}
+
+func (k Keeper) DeleteOperatorApproval(ctx sdk.Context, owner, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOperator(owner, operator))
+}
+
+// GetAllOperatorApprovals returns operator approvals of all owners
+func (k Keeper) GetAllOperatorApprovals(ctx sdk.Context) (approvals []types.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixOperator)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.OperatorApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
@@ -25,12 +25,23 @@ func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) erro
 		denom.Data,
 		denom.RoyaltyReceivers,
 		denom.UpdatableData,
+		denom.MaxSupply,
+		denom.Revocable,
+		denom.IndexedTraits,
+		denom.DataHistoryRetention,
 	); err != nil {
 		return err
 	}
 
+	// onfts are imported as they were minted, the mint rules, data schema and traits
+	// of the denom may have changed since and are not enforced on existing onfts
 	for _, onft := range collection.ONFTs {
-		if err := k.MintONFT(ctx,
+		var traits []types.Trait
+		if denom.IndexedTraits {
+			// onfts with invalid traits are kept out of the trait index
+			traits, _ = types.ParseTraits(onft.GetData())
+		}
+		if err := k.mintONFT(ctx,
 			denom.Id,
 			onft.GetID(),
 			onft.GetName(),
@@ -44,11 +55,24 @@ func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) erro
 			onft.IsExtensible(),
 			onft.IsNSFW(),
 			onft.GetRoyaltyShare(),
+			onft.GetRoyaltyReceivers(),
+			onft.GetTransferLockedUntil(),
+			traits,
 			onft.GetOwner(),
 		); err != nil {
 			return err
 		}
 	}
+	if denom.MintingClosed {
+		if err := k.CloseMinting(ctx, denom.Id, creator); err != nil {
+			return err
+		}
+	}
+	if denom.Frozen {
+		if err := k.FreezeDenom(ctx, denom.Id, creator); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
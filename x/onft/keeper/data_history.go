@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// recordDataVersion stores the data being replaced by updater as the next version
+// of the onft data history and prunes the versions beyond the retention limit
+func (k Keeper) recordDataVersion(ctx sdk.Context, denom *types.Denom, onftID, data string, updater sdk.AccAddress) {
+	denomID := denom.Id
+	retention := k.dataHistoryRetention(ctx, denom)
+	if retention == 0 {
+		return
+	}
+	version := k.latestDataVersion(ctx, denomID, onftID) + 1
+	k.SetDataVersion(ctx, types.NewONFTDataVersion(
+		denomID,
+		onftID,
+		version,
+		data,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		updater,
+	))
+	k.pruneDataHistory(ctx, denomID, onftID, retention)
+}
+
+// dataHistoryRetention returns the data history retention of the denom capped by the module max
+func (k Keeper) dataHistoryRetention(ctx sdk.Context, denom *types.Denom) uint64 {
+	maxRetention := k.GetParams(ctx).MaxDataHistoryRetention
+	if denom.DataHistoryRetention > maxRetention {
+		return maxRetention
+	}
+	return denom.DataHistoryRetention
+}
+
+// latestDataVersion returns the latest version in the data history of an onft, 0 if there is none
+func (k Keeper) latestDataVersion(ctx sdk.Context, denomID, onftID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyDataHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	var version types.ONFTDataVersion
+	k.cdc.MustUnmarshal(iterator.Value(), &version)
+	return version.Version
+}
+
+// pruneDataHistory deletes the oldest versions of an onft data history beyond retention
+func (k Keeper) pruneDataHistory(ctx sdk.Context, denomID, onftID string, retention uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyDataHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	var (
+		kept  uint64
+		stale [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if kept < retention {
+			kept++
+			continue
+		}
+		stale = append(stale, iterator.Key())
+	}
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetDataVersion(ctx sdk.Context, version types.ONFTDataVersion) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&version)
+	store.Set(types.KeyDataVersion(version.DenomId, version.OnftId, version.Version), bz)
+}
+
+func (k Keeper) GetDataVersion(ctx sdk.Context, denomID, onftID string, version uint64) (dataVersion types.ONFTDataVersion, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyDataVersion(denomID, onftID, version))
+	if bz == nil {
+		return dataVersion, false
+	}
+	k.cdc.MustUnmarshal(bz, &dataVersion)
+	return dataVersion, true
+}
+
+// GetDataHistory returns the data history of an onft ordered by version
+func (k Keeper) GetDataHistory(ctx sdk.Context, denomID, onftID string) (versions []types.ONFTDataVersion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyDataHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.ONFTDataVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &version)
+		versions = append(versions, version)
+	}
+	return versions
+}
+
+// DeleteDataHistory deletes the data history of an onft
+func (k Keeper) DeleteDataHistory(ctx sdk.Context, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyDataHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllDataVersions returns the data history of all onfts
+func (k Keeper) GetAllDataVersions(ctx sdk.Context) (versions []types.ONFTDataVersion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixDataHistory)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.ONFTDataVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &version)
+		versions = append(versions, version)
+	}
+	return versions
+}
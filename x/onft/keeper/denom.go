@@ -25,6 +25,10 @@ func (k Keeper) SaveDenom(
 	data string,
 	royaltyReceivers []*types.WeightedAddress,
 	updatableData bool,
+	maxSupply uint64,
+	revocable bool,
+	indexedTraits bool,
+	dataHistoryRetention uint64,
 ) error {
 	denomMetadata := &types.DenomMetadata{
 		Creator:          creator.String(),
@@ -33,6 +37,11 @@ func (k Keeper) SaveDenom(
 		Data:             data,
 		RoyaltyReceivers: royaltyReceivers,
 		UpdatableData:    updatableData,
+		MaxSupply:        maxSupply,
+		Revocable:        revocable,
+		IndexedTraits:    indexedTraits,
+
+		DataHistoryRetention: dataHistoryRetention,
 	}
 	metadata, err := codectypes.NewAnyWithValue(denomMetadata)
 	if err != nil {
@@ -67,6 +76,9 @@ func (k Keeper) TransferDenomOwner(
 	if err != nil {
 		return err
 	}
+	if err := validateDenomManageable(denomID); err != nil {
+		return err
+	}
 	sender := srcOwner.String()
 	recipient := dstOwner.String()
 
@@ -86,6 +98,13 @@ func (k Keeper) TransferDenomOwner(
 		Data:             denom.Data,
 		RoyaltyReceivers: denom.RoyaltyReceivers,
 		UpdatableData:    denom.UpdatableData,
+		MaxSupply:        denom.MaxSupply,
+		MintingClosed:    denom.MintingClosed,
+		Revocable:        denom.Revocable,
+		Frozen:           denom.Frozen,
+		IndexedTraits:    denom.IndexedTraits,
+
+		DataHistoryRetention: denom.DataHistoryRetention,
 	}
 	data, err := codectypes.NewAnyWithValue(denomMetadata)
 	if err != nil {
@@ -105,6 +124,8 @@ func (k Keeper) TransferDenomOwner(
 	if err != nil {
 		return err
 	}
+	// minter grants are made by the creator and do not carry over to the new creator
+	k.DeleteMinters(ctx, denomID)
 	k.emitTransferONFTDenomEvent(ctx, denomID, denom.Symbol, sender, recipient)
 	return nil
 }
@@ -118,6 +139,9 @@ func (k Keeper) UpdateDenom(ctx sdk.Context, msg *types.MsgUpdateDenom) error {
 	if err != nil {
 		return err
 	}
+	if err := validateDenomManageable(msg.Id); err != nil {
+		return err
+	}
 
 	// authorize
 	if msg.Sender != denom.Creator {
@@ -135,6 +159,13 @@ func (k Keeper) UpdateDenom(ctx sdk.Context, msg *types.MsgUpdateDenom) error {
 		Data:             denom.Data,
 		RoyaltyReceivers: denom.RoyaltyReceivers,
 		UpdatableData:    denom.UpdatableData,
+		MaxSupply:        denom.MaxSupply,
+		MintingClosed:    denom.MintingClosed,
+		Revocable:        denom.Revocable,
+		Frozen:           denom.Frozen,
+		IndexedTraits:    denom.IndexedTraits,
+
+		DataHistoryRetention: denom.DataHistoryRetention,
 	}
 	if msg.PreviewURI != types.DoNotModify {
 		denomMetadata.PreviewUri = msg.PreviewURI
@@ -183,6 +214,13 @@ func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []types.Denom, err error) {
 			Uri:              class.Uri,
 			UriHash:          class.UriHash,
 			RoyaltyReceivers: denomMetadata.RoyaltyReceivers,
+			MaxSupply:        denomMetadata.MaxSupply,
+			MintingClosed:    denomMetadata.MintingClosed,
+			Revocable:        denomMetadata.Revocable,
+			Frozen:           denomMetadata.Frozen,
+			IndexedTraits:    denomMetadata.IndexedTraits,
+
+			DataHistoryRetention: denomMetadata.DataHistoryRetention,
 		})
 	}
 	return denoms, nil
@@ -193,6 +231,9 @@ func (k Keeper) AuthorizeDenomCreator(ctx sdk.Context, id string, creator sdk.Ac
 	if err != nil {
 		return err
 	}
+	if err := validateDenomManageable(id); err != nil {
+		return err
+	}
 
 	if creator.String() != denom.Creator {
 		return errorsmod.Wrap(types.ErrUnauthorized, creator.String())
@@ -200,16 +241,22 @@ func (k Keeper) AuthorizeDenomCreator(ctx sdk.Context, id string, creator sdk.Ac
 	return nil
 }
 
+// HasPermissionToMint returns true if sender is the denom creator
+// or an active minter of the denom
 func (k Keeper) HasPermissionToMint(ctx sdk.Context, denomID string, sender sdk.AccAddress) bool {
 	denom, err := k.GetDenomInfo(ctx, denomID)
 	if err != nil {
 		return false
 	}
 
+	if denom.MintingClosed {
+		return false
+	}
 	if sender.String() == denom.Creator {
 		return true
 	}
-	return false
+	minter, found := k.GetMinter(ctx, denomID, sender)
+	return found && minter.IsActive(ctx.BlockTime())
 }
 
 func (k Keeper) HasPermissionToUpdateData(ctx sdk.Context, denomID string, sender sdk.AccAddress) bool {
@@ -248,9 +295,218 @@ func (k Keeper) GetDenomInfo(ctx sdk.Context, denomID string) (*types.Denom, err
 		Data:             denomMetadata.Data,
 		RoyaltyReceivers: denomMetadata.RoyaltyReceivers,
 		UpdatableData:    denomMetadata.UpdatableData,
+		MaxSupply:        denomMetadata.MaxSupply,
+		MintingClosed:    denomMetadata.MintingClosed,
+		Revocable:        denomMetadata.Revocable,
+		Frozen:           denomMetadata.Frozen,
+		IndexedTraits:    denomMetadata.IndexedTraits,
+
+		DataHistoryRetention: denomMetadata.DataHistoryRetention,
 	}, nil
 }
 
+// CloseMinting permanently disables minting of new nfts under the denom
+func (k Keeper) CloseMinting(ctx sdk.Context, denomID string, sender sdk.AccAddress) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if err := validateDenomManageable(denomID); err != nil {
+		return err
+	}
+
+	// authorize
+	if sender.String() != denom.Creator {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to close minting of denom %s", sender,
+			denomID,
+		)
+	}
+	if denom.MintingClosed {
+		return errorsmod.Wrapf(types.ErrMintingClosed, "minting is already closed for denom %s", denomID)
+	}
+
+	denomMetadata := &types.DenomMetadata{
+		Creator:          denom.Creator,
+		Schema:           denom.Schema,
+		PreviewUri:       denom.PreviewURI,
+		Data:             denom.Data,
+		RoyaltyReceivers: denom.RoyaltyReceivers,
+		UpdatableData:    denom.UpdatableData,
+		MaxSupply:        denom.MaxSupply,
+		MintingClosed:    true,
+		Revocable:        denom.Revocable,
+		Frozen:           denom.Frozen,
+		IndexedTraits:    denom.IndexedTraits,
+
+		DataHistoryRetention: denom.DataHistoryRetention,
+	}
+	data, err := codectypes.NewAnyWithValue(denomMetadata)
+	if err != nil {
+		return err
+	}
+	class := nft.Class{
+		Id:          denom.Id,
+		Name:        denom.Name,
+		Symbol:      denom.Symbol,
+		Description: denom.Description,
+		Uri:         denom.Uri,
+		UriHash:     denom.UriHash,
+		Data:        data,
+	}
+	if err := k.nk.UpdateClass(ctx, class); err != nil {
+		return err
+	}
+	k.emitCloseMintingEvent(ctx, denomID, sender.String(), k.GetTotalSupply(ctx, denomID))
+	return nil
+}
+
+// FreezeDenom blocks transfers, listings and ics-721 sends of all nfts of the denom
+func (k Keeper) FreezeDenom(ctx sdk.Context, denomID string, sender sdk.AccAddress) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if err := validateDenomManageable(denomID); err != nil {
+		return err
+	}
+
+	// authorize
+	if sender.String() != denom.Creator {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to freeze denom %s", sender,
+			denomID,
+		)
+	}
+	if denom.Frozen {
+		return errorsmod.Wrapf(types.ErrDenomFrozen, "denom %s is already frozen", denomID)
+	}
+	if err := k.setDenomFrozen(ctx, denom, true); err != nil {
+		return err
+	}
+	k.emitFreezeDenomEvent(ctx, denomID, sender.String())
+	return nil
+}
+
+// UnfreezeDenom lifts the freeze of the denom
+func (k Keeper) UnfreezeDenom(ctx sdk.Context, denomID string, sender sdk.AccAddress) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if err := validateDenomManageable(denomID); err != nil {
+		return err
+	}
+
+	// authorize
+	if sender.String() != denom.Creator {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to unfreeze denom %s", sender,
+			denomID,
+		)
+	}
+	if !denom.Frozen {
+		return errorsmod.Wrapf(types.ErrDenomNotFrozen, "denom %s is not frozen", denomID)
+	}
+	if err := k.setDenomFrozen(ctx, denom, false); err != nil {
+		return err
+	}
+	k.emitUnfreezeDenomEvent(ctx, denomID, sender.String())
+	return nil
+}
+
+func (k Keeper) setDenomFrozen(ctx sdk.Context, denom *types.Denom, frozen bool) error {
+	denom.Frozen = frozen
+	return k.setDenomMetadata(ctx, denom)
+}
+
+// UpdateDataHistoryRetention sets the number of prior data versions retained per onft of the denom,
+// versions beyond a lowered retention are pruned on the next data update of each onft
+func (k Keeper) UpdateDataHistoryRetention(ctx sdk.Context, denomID string, retention uint64, sender sdk.AccAddress) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if err := validateDenomManageable(denomID); err != nil {
+		return err
+	}
+
+	// authorize
+	if sender.String() != denom.Creator {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to update data history retention of denom %s", sender,
+			denomID,
+		)
+	}
+	if err := k.validateDataHistoryRetention(ctx, retention); err != nil {
+		return err
+	}
+	denom.DataHistoryRetention = retention
+	if err := k.setDenomMetadata(ctx, denom); err != nil {
+		return err
+	}
+	k.emitUpdateDataHistoryRetentionEvent(ctx, denomID, retention, sender.String())
+	return nil
+}
+
+// validateDataHistoryRetention checks the data history retention of a denom is within the module max
+func (k Keeper) validateDataHistoryRetention(ctx sdk.Context, retention uint64) error {
+	maxRetention := k.GetParams(ctx).MaxDataHistoryRetention
+	if retention > maxRetention {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHistoryRetention,
+			"data history retention %d exceeds max %d", retention, maxRetention,
+		)
+	}
+	return nil
+}
+
+// validateDenomManageable returns an error for ibc voucher denoms, their settings are
+// owned by the ics721 module and can't be changed by the creator msgs
+func validateDenomManageable(denomID string) error {
+	if types.IsIBCDenom(denomID) {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "ibc denom %s can't be managed", denomID)
+	}
+	return nil
+}
+
+// setDenomMetadata stores the metadata fields of the denom in its class
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denom *types.Denom) error {
+	denomMetadata := &types.DenomMetadata{
+		Creator:          denom.Creator,
+		Schema:           denom.Schema,
+		PreviewUri:       denom.PreviewURI,
+		Data:             denom.Data,
+		RoyaltyReceivers: denom.RoyaltyReceivers,
+		UpdatableData:    denom.UpdatableData,
+		MaxSupply:        denom.MaxSupply,
+		MintingClosed:    denom.MintingClosed,
+		Revocable:        denom.Revocable,
+		Frozen:           denom.Frozen,
+		IndexedTraits:    denom.IndexedTraits,
+
+		DataHistoryRetention: denom.DataHistoryRetention,
+	}
+	data, err := codectypes.NewAnyWithValue(denomMetadata)
+	if err != nil {
+		return err
+	}
+	class := nft.Class{
+		Id:          denom.Id,
+		Name:        denom.Name,
+		Symbol:      denom.Symbol,
+		Description: denom.Description,
+		Uri:         denom.Uri,
+		UriHash:     denom.UriHash,
+		Data:        data,
+	}
+	return k.nk.UpdateClass(ctx, class)
+}
+
 // PurgeDenom deletes the denom if no nfts in it
 func (k Keeper) PurgeDenom(
 	ctx sdk.Context,
@@ -261,6 +517,9 @@ func (k Keeper) PurgeDenom(
 	if err != nil {
 		return err
 	}
+	if err := validateDenomManageable(denomID); err != nil {
+		return err
+	}
 
 	// authorize
 	if sender.String() != denom.Creator {
@@ -279,6 +538,7 @@ func (k Keeper) PurgeDenom(
 	}
 	// delete the denom
 	k.DeleteDenomFromStore(ctx, denomID)
+	k.DeleteMinters(ctx, denomID)
 
 	k.emitPurgeONFTDenomEvent(ctx, denomID)
 	return nil
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// CreateEditions sets the max number of editions that can be printed from a master onft,
+// the cap can only be set once by the holder of the master
+func (k Keeper) CreateEditions(ctx sdk.Context, denomID, masterID string, maxEditions uint64, sender sdk.AccAddress) error {
+	if !k.HasONFT(ctx, denomID, masterID) {
+		return errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", masterID)
+	}
+	if err := k.Authorize(ctx, denomID, masterID, sender); err != nil {
+		return err
+	}
+	if _, found := k.GetEdition(ctx, denomID, masterID); found {
+		return errorsmod.Wrapf(types.ErrInvalidEdition, "nft %s is an edition and can not be a master", masterID)
+	}
+	if _, found := k.GetEditionSet(ctx, denomID, masterID); found {
+		return errorsmod.Wrapf(types.ErrInvalidEdition, "editions of master %s already exist", masterID)
+	}
+	if err := types.ValidateMaxEditions(maxEditions); err != nil {
+		return err
+	}
+
+	editionSet := types.EditionSet{
+		DenomId:     denomID,
+		MasterId:    masterID,
+		MaxEditions: maxEditions,
+	}
+	k.SetEditionSet(ctx, editionSet)
+	k.emitCreateEditionsEvent(ctx, editionSet, sender.String())
+	return nil
+}
+
+// PrintEdition mints the next numbered edition of a master onft to the recipient,
+// the edition inherits the media, data and royalties of the master
+func (k Keeper) PrintEdition(
+	ctx sdk.Context,
+	denomID,
+	masterID,
+	onftID string,
+	sender,
+	recipient sdk.AccAddress,
+) (uint64, error) {
+	editionSet, found := k.GetEditionSet(ctx, denomID, masterID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrInvalidEdition, "no editions exist for master %s", masterID)
+	}
+	if err := k.Authorize(ctx, denomID, masterID, sender); err != nil {
+		return 0, err
+	}
+	if editionSet.Printed >= editionSet.MaxEditions {
+		return 0, errorsmod.Wrapf(
+			types.ErrMaxEditionsReached,
+			"master %s reached its max editions %d", masterID, editionSet.MaxEditions,
+		)
+	}
+	if k.HasONFT(ctx, denomID, onftID) {
+		return 0, errorsmod.Wrapf(
+			types.ErrONFTAlreadyExists,
+			"ONFT with id %s already exists in collection %s", onftID, denomID,
+		)
+	}
+	master, found := k.nk.GetNFT(ctx, denomID, masterID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", masterID)
+	}
+	masterMetadata, err := types.UnmarshalNFTMetadata(k.cdc, master.Data.GetValue())
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.MintONFT(ctx,
+		denomID,
+		onftID,
+		masterMetadata.Name,
+		masterMetadata.Description,
+		master.Uri,
+		master.UriHash,
+		masterMetadata.PreviewURI,
+		masterMetadata.Data,
+		ctx.BlockTime(),
+		masterMetadata.Transferable,
+		masterMetadata.Extensible,
+		masterMetadata.Nsfw,
+		masterMetadata.RoyaltyShare,
+		masterMetadata.RoyaltyReceivers,
+		nil,
+		recipient,
+	); err != nil {
+		return 0, err
+	}
+
+	editionSet.Printed++
+	k.SetEditionSet(ctx, editionSet)
+	edition := types.Edition{
+		DenomId:  denomID,
+		OnftId:   onftID,
+		MasterId: masterID,
+		Number:   editionSet.Printed,
+	}
+	k.SetEdition(ctx, edition)
+	k.emitPrintEditionEvent(ctx, edition, editionSet.MaxEditions, recipient.String())
+	return edition.Number, nil
+}
+
+func (k Keeper) SetEditionSet(ctx sdk.Context, editionSet types.EditionSet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&editionSet)
+	store.Set(types.KeyEditionSet(editionSet.DenomId, editionSet.MasterId), bz)
+}
+
+// GetEditionSet returns the edition cap and the printed count of a master onft
+func (k Keeper) GetEditionSet(ctx sdk.Context, denomID, masterID string) (editionSet types.EditionSet, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyEditionSet(denomID, masterID))
+	if bz == nil {
+		return editionSet, false
+	}
+	k.cdc.MustUnmarshal(bz, &editionSet)
+	return editionSet, true
+}
+
+// GetAllEditionSets returns the edition sets of all master onfts
+func (k Keeper) GetAllEditionSets(ctx sdk.Context) (editionSets []types.EditionSet) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixEditionSet)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var editionSet types.EditionSet
+		k.cdc.MustUnmarshal(iterator.Value(), &editionSet)
+		editionSets = append(editionSets, editionSet)
+	}
+	return editionSets
+}
+
+func (k Keeper) SetEdition(ctx sdk.Context, edition types.Edition) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&edition)
+	store.Set(types.KeyEdition(edition.DenomId, edition.OnftId), bz)
+	store.Set(types.KeyMasterEdition(edition.DenomId, edition.MasterId, edition.Number), []byte(edition.OnftId))
+}
+
+// GetEdition returns the edition of a printed onft
+func (k Keeper) GetEdition(ctx sdk.Context, denomID, onftID string) (edition types.Edition, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyEdition(denomID, onftID))
+	if bz == nil {
+		return edition, false
+	}
+	k.cdc.MustUnmarshal(bz, &edition)
+	return edition, true
+}
+
+// DeleteEdition removes the edition of a burned print, the number of the
+// edition is not reissued
+func (k Keeper) DeleteEdition(ctx sdk.Context, denomID, onftID string) {
+	edition, found := k.GetEdition(ctx, denomID, onftID)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyEdition(denomID, onftID))
+	store.Delete(types.KeyMasterEdition(denomID, edition.MasterId, edition.Number))
+}
+
+// GetEditions returns the existing editions of a master onft ordered by number
+func (k Keeper) GetEditions(ctx sdk.Context, denomID, masterID string) (editions []types.Edition) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyMasterEditionsPrefix(denomID, masterID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if edition, found := k.GetEdition(ctx, denomID, string(iterator.Value())); found {
+			editions = append(editions, edition)
+		}
+	}
+	return editions
+}
+
+// GetAllEditions returns the editions of all printed onfts
+func (k Keeper) GetAllEditions(ctx sdk.Context) (editions []types.Edition) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixEdition)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var edition types.Edition
+		k.cdc.MustUnmarshal(iterator.Value(), &edition)
+		editions = append(editions, edition)
+	}
+	return editions
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	onfttypes "github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -85,3 +88,302 @@ func (k Keeper) emitBurnONFTEvent(ctx sdk.Context, nftId, denomId, owner string)
 		),
 	)
 }
+
+func (k Keeper) emitUpdateONFTRoyaltyReceiversEvent(
+	ctx sdk.Context,
+	nftId, denomId string,
+	royaltyReceivers []*onfttypes.WeightedAddress,
+) {
+	receivers := make([]string, 0, len(royaltyReceivers))
+	for _, receiver := range royaltyReceivers {
+		receivers = append(receivers, fmt.Sprintf("%s:%s", receiver.Address, receiver.Weight.String()))
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeUpdateONFTRoyaltyReceivers,
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyRoyaltyReceivers, strings.Join(receivers, ",")),
+		),
+	)
+}
+
+func (k Keeper) emitGrantMinterEvent(ctx sdk.Context, minter onfttypes.Minter, sender string) {
+	expiry := ""
+	if minter.Expiry != nil {
+		expiry = minter.Expiry.String()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeGrantMinter,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, minter.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMinter, minter.Address),
+			sdk.NewAttribute(onfttypes.AttributeKeyQuota, fmt.Sprintf("%d", minter.Quota)),
+			sdk.NewAttribute(onfttypes.AttributeKeyExpiry, expiry),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitRevokeMinterEvent(ctx sdk.Context, denomId, minter, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRevokeMinter,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMinter, minter),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitCloseMintingEvent(ctx sdk.Context, denomId, sender string, totalSupply uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCloseMinting,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+			sdk.NewAttribute(onfttypes.AttributeKeyTotalSupply, fmt.Sprintf("%d", totalSupply)),
+		),
+	)
+}
+
+func (k Keeper) emitApproveONFTEvent(ctx sdk.Context, denomId, nftId, owner, spender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeApproveONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeySpender, spender),
+		),
+	)
+}
+
+func (k Keeper) emitRevokeONFTEvent(ctx sdk.Context, denomId, nftId, owner, spender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRevokeONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeySpender, spender),
+		),
+	)
+}
+
+func (k Keeper) emitApproveAllEvent(ctx sdk.Context, owner, operator string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeApproveAll,
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyOperator, operator),
+		),
+	)
+}
+
+func (k Keeper) emitRevokeAllEvent(ctx sdk.Context, owner, operator string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRevokeAll,
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyOperator, operator),
+		),
+	)
+}
+
+func (k Keeper) emitSetONFTUserEvent(ctx sdk.Context, denomId, nftId, owner, user, expiry string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeSetONFTUser,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyUser, user),
+			sdk.NewAttribute(onfttypes.AttributeKeyExpiry, expiry),
+		),
+	)
+}
+
+func (k Keeper) emitRedeemMintVoucherEvent(ctx sdk.Context, denomId, nftId, signer, recipient string, nonce uint64, price sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRedeemMintVoucher,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySigner, signer),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(onfttypes.AttributeKeyNonce, fmt.Sprintf("%d", nonce)),
+			sdk.NewAttribute(onfttypes.AttributeKeyPrice, price.String()),
+		),
+	)
+}
+
+func (k Keeper) emitSetLaunchpadEvent(ctx sdk.Context, denomId, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeSetLaunchpad,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitLaunchpadMintEvent(ctx sdk.Context, denomId, nftId string, phaseId uint64, recipient string, price sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeLaunchpadMint,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyPhaseID, fmt.Sprintf("%d", phaseId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(onfttypes.AttributeKeyPrice, price.String()),
+		),
+	)
+}
+
+func (k Keeper) emitFractionalizeEvent(ctx sdk.Context, vault onfttypes.Vault) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeFractionalize,
+			sdk.NewAttribute(onfttypes.AttributeKeyVaultID, fmt.Sprintf("%d", vault.Id)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, vault.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, vault.OnftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyFractionDenom, vault.FractionDenom),
+			sdk.NewAttribute(onfttypes.AttributeKeySupply, vault.Supply.String()),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, vault.Depositor),
+		),
+	)
+}
+
+func (k Keeper) emitRedeemVaultEvent(ctx sdk.Context, vault onfttypes.Vault, recipient string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRedeemVault,
+			sdk.NewAttribute(onfttypes.AttributeKeyVaultID, fmt.Sprintf("%d", vault.Id)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, vault.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, vault.OnftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
+		),
+	)
+}
+
+func (k Keeper) emitBuyoutVaultEvent(ctx sdk.Context, vault onfttypes.Vault) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeBuyoutVault,
+			sdk.NewAttribute(onfttypes.AttributeKeyVaultID, fmt.Sprintf("%d", vault.Id)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, vault.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, vault.OnftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, vault.Buyer),
+			sdk.NewAttribute(onfttypes.AttributeKeyPrice, vault.BuyoutPrice.String()),
+		),
+	)
+}
+
+func (k Keeper) emitClaimBuyoutEvent(ctx sdk.Context, vaultId uint64, sender string, burned, payout sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeClaimBuyout,
+			sdk.NewAttribute(onfttypes.AttributeKeyVaultID, fmt.Sprintf("%d", vaultId)),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+			sdk.NewAttribute(onfttypes.AttributeKeySupply, burned.String()),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, payout.String()),
+		),
+	)
+}
+
+func (k Keeper) emitRevocationEvent(ctx sdk.Context, revocation onfttypes.Revocation) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRevocation,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, revocation.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, revocation.OnftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyHolder, revocation.Holder),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, revocation.Issuer),
+			sdk.NewAttribute(onfttypes.AttributeKeyReason, revocation.Reason),
+			sdk.NewAttribute(onfttypes.AttributeKeyReclaimed, fmt.Sprintf("%t", revocation.Reclaimed)),
+		),
+	)
+}
+
+func (k Keeper) emitFreezeDenomEvent(ctx sdk.Context, denomId, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeFreezeDenom,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitUpdateDataHistoryRetentionEvent(ctx sdk.Context, denomId string, retention uint64, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeUpdateDataHistoryRetention,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyRetention, fmt.Sprint(retention)),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitUnfreezeDenomEvent(ctx sdk.Context, denomId, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeUnfreezeDenom,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitNestONFTEvent(ctx sdk.Context, nesting onfttypes.Nesting, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeNestONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, nesting.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nesting.OnftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyParentDenomID, nesting.ParentDenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyParentNFTID, nesting.ParentId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitUnnestONFTEvent(ctx sdk.Context, nesting onfttypes.Nesting, recipient string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeUnnestONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, nesting.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nesting.OnftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyParentDenomID, nesting.ParentDenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyParentNFTID, nesting.ParentId),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
+		),
+	)
+}
+
+func (k Keeper) emitCreateEditionsEvent(ctx sdk.Context, editionSet onfttypes.EditionSet, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCreateEditions,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, editionSet.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMasterID, editionSet.MasterId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMaxEditions, fmt.Sprintf("%d", editionSet.MaxEditions)),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitPrintEditionEvent(ctx sdk.Context, edition onfttypes.Edition, maxEditions uint64, recipient string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypePrintEdition,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, edition.DenomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, edition.OnftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMasterID, edition.MasterId),
+			sdk.NewAttribute(onfttypes.AttributeKeyEditionNumber, fmt.Sprintf("%d/%d", edition.Number, maxEditions)),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
+		),
+	)
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft"
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesisImportNonConformingONFTs() {
+	creator := suite.TestAccs[0]
+	schema := `{"type": "object", "required": ["level"], "properties": {"level": {"type": "integer"}}}`
+	createMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "test denom", schema,
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 1, false, true)
+	createMsg.Id = defaultDenomId
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+
+	mintMsg := types.NewMsgMintONFT(defaultDenomId, creator.String(), creator.String(),
+		types.Metadata{Name: "onft1", MediaURI: "ipfs://onft1"},
+		`{"level": 1, "attributes": [{"trait_type": "color", "value": "red"}]}`,
+		true, true, false, sdkmath.LegacyZeroDec(), nil, nil)
+	mintMsg.Id = "onft1"
+	_, err = suite.msgServer.MintONFT(suite.Ctx, mintMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.ONFTKeeper.CloseMinting(suite.Ctx, defaultDenomId, creator))
+
+	genState := onft.ExportGenesis(suite.Ctx, suite.App.ONFTKeeper)
+	suite.Require().Len(genState.Collections, 1)
+
+	// onft1 no longer matches the schema and onft2 is above the max supply
+	collection := &genState.Collections[0]
+	collection.ONFTs[0].Data = `{"attributes": [{"trait_type": "color", "value": "blue"}]}`
+	onft2 := collection.ONFTs[0]
+	onft2.Id = "onft2"
+	onft2.Data = "not json"
+	collection.ONFTs = append(collection.ONFTs, onft2)
+
+	suite.SetupTest()
+	suite.Require().NotPanics(func() {
+		onft.InitGenesis(suite.Ctx, suite.App.ONFTKeeper, *genState)
+	})
+
+	exported := onft.ExportGenesis(suite.Ctx, suite.App.ONFTKeeper)
+	suite.Require().Equal(genState.Collections, exported.Collections)
+
+	// traits of the imported onfts are indexed where they can be parsed
+	resp, err := suite.App.ONFTKeeper.ONFTsByTrait(suite.Ctx, &types.QueryONFTsByTraitRequest{
+		DenomId: defaultDenomId, TraitType: "color", Value: "blue",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.ONFTs, 1)
+}
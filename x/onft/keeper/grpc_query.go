@@ -9,7 +9,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 )
@@ -91,13 +93,15 @@ func (k Keeper) Collection(c context.Context, request *types.QueryCollectionRequ
 				UriHash:     _nft.UriHash,
 				PreviewURI:  nftMetadata.PreviewURI,
 			},
-			Owner:        owner.String(),
-			Data:         nftMetadata.Data,
-			Transferable: nftMetadata.Transferable,
-			Extensible:   nftMetadata.Extensible,
-			CreatedAt:    nftMetadata.CreatedAt,
-			Nsfw:         nftMetadata.Nsfw,
-			RoyaltyShare: nftMetadata.RoyaltyShare,
+			Owner:               owner.String(),
+			Data:                nftMetadata.Data,
+			Transferable:        nftMetadata.Transferable,
+			Extensible:          nftMetadata.Extensible,
+			CreatedAt:           nftMetadata.CreatedAt,
+			Nsfw:                nftMetadata.Nsfw,
+			RoyaltyShare:        nftMetadata.RoyaltyShare,
+			RoyaltyReceivers:    nftMetadata.RoyaltyReceivers,
+			TransferLockedUntil: nftMetadata.TransferLockedUntil,
 		})
 	}
 
@@ -149,13 +153,15 @@ func (k Keeper) IBCCollection(c context.Context, request *types.QueryIBCCollecti
 				UriHash:     _nft.UriHash,
 				PreviewURI:  nftMetadata.PreviewURI,
 			},
-			Owner:        owner.String(),
-			Data:         nftMetadata.Data,
-			Transferable: nftMetadata.Transferable,
-			Extensible:   nftMetadata.Extensible,
-			CreatedAt:    nftMetadata.CreatedAt,
-			Nsfw:         nftMetadata.Nsfw,
-			RoyaltyShare: nftMetadata.RoyaltyShare,
+			Owner:               owner.String(),
+			Data:                nftMetadata.Data,
+			Transferable:        nftMetadata.Transferable,
+			Extensible:          nftMetadata.Extensible,
+			CreatedAt:           nftMetadata.CreatedAt,
+			Nsfw:                nftMetadata.Nsfw,
+			RoyaltyShare:        nftMetadata.RoyaltyShare,
+			RoyaltyReceivers:    nftMetadata.RoyaltyReceivers,
+			TransferLockedUntil: nftMetadata.TransferLockedUntil,
 		})
 	}
 
@@ -355,6 +361,414 @@ func (k Keeper) OwnerIBCDenomONFTs(
 	return response, nil
 }
 
+// Minters queries the minters of a denom
+func (k Keeper) Minters(c context.Context, request *types.QueryMintersRequest) (*types.QueryMintersResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasDenom(ctx, request.DenomId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDenom, "denom ID %s not exists", request.DenomId)
+	}
+
+	var minters []types.Minter
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyMinterPrefix(request.DenomId))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var minter types.Minter
+		if err := k.cdc.Unmarshal(value, &minter); err != nil {
+			return err
+		}
+		minters = append(minters, minter)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintersResponse{
+		Minters:    minters,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Approvals queries the unexpired approvals of an onft
+func (k Keeper) Approvals(c context.Context, request *types.QueryApprovalsRequest) (*types.QueryApprovalsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasONFT(ctx, request.DenomId, request.OnftId) {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.OnftId, request.DenomId)
+	}
+
+	var approvals []types.Approval
+	for _, approval := range k.GetApprovals(ctx, request.DenomId, request.OnftId) {
+		if !approval.IsExpired(ctx.BlockTime()) {
+			approvals = append(approvals, approval)
+		}
+	}
+	return &types.QueryApprovalsResponse{Approvals: approvals}, nil
+}
+
+// Operators queries the operator approvals of an owner
+func (k Keeper) Operators(c context.Context, request *types.QueryOperatorsRequest) (*types.QueryOperatorsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	owner, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", request.Owner)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var operators []types.OperatorApproval
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOperatorPrefix(owner))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var operator types.OperatorApproval
+		if err := k.cdc.Unmarshal(value, &operator); err != nil {
+			return err
+		}
+		if !operator.IsExpired(ctx.BlockTime()) {
+			operators = append(operators, operator)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOperatorsResponse{
+		Operators:  operators,
+		Pagination: pageRes,
+	}, nil
+}
+
+// UserOf queries the current user of an onft
+func (k Keeper) UserOf(c context.Context, request *types.QueryUserOfRequest) (*types.QueryUserOfResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasONFT(ctx, request.DenomId, request.OnftId) {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.OnftId, request.DenomId)
+	}
+
+	user, found := k.GetONFTUser(ctx, request.DenomId, request.OnftId)
+	if !found || user.IsExpired(ctx.BlockTime()) {
+		return &types.QueryUserOfResponse{}, nil
+	}
+	return &types.QueryUserOfResponse{User: &user}, nil
+}
+
+// ONFTDataHistory queries the prior data versions of an onft
+func (k Keeper) ONFTDataHistory(
+	c context.Context,
+	request *types.QueryONFTDataHistoryRequest,
+) (*types.QueryONFTDataHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasONFT(ctx, request.DenomId, request.OnftId) {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.OnftId, request.DenomId)
+	}
+
+	var versions []types.ONFTDataVersion
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyDataHistoryPrefix(request.DenomId, request.OnftId))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var version types.ONFTDataVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryONFTDataHistoryResponse{
+		Versions:   versions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ONFTHistory queries the ownership history of an onft
+func (k Keeper) ONFTHistory(
+	c context.Context,
+	request *types.QueryONFTHistoryRequest,
+) (*types.QueryONFTHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasONFT(ctx, request.DenomId, request.OnftId) {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.OnftId, request.DenomId)
+	}
+
+	var history []types.OwnershipRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOwnershipHistoryPrefix(request.DenomId, request.OnftId))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var record types.OwnershipRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		history = append(history, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryONFTHistoryResponse{
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ONFTRevocations queries the revocations of a denom, optionally of a single onft
+func (k Keeper) ONFTRevocations(
+	c context.Context,
+	request *types.QueryONFTRevocationsRequest,
+) (*types.QueryONFTRevocationsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasDenom(ctx, request.DenomId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDenom, "denom ID %s not exists", request.DenomId)
+	}
+
+	var revocations []types.Revocation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRevocationPrefix(request.DenomId, request.OnftId))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var revocation types.Revocation
+		if err := k.cdc.Unmarshal(value, &revocation); err != nil {
+			return err
+		}
+		revocations = append(revocations, revocation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryONFTRevocationsResponse{
+		Revocations: revocations,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// Children queries the onfts nested directly in an onft
+func (k Keeper) Children(
+	c context.Context,
+	request *types.QueryChildrenRequest,
+) (*types.QueryChildrenResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasONFT(ctx, request.DenomId, request.OnftId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", request.OnftId)
+	}
+
+	var children []types.Nesting
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyNestChildrenPrefix(request.DenomId, request.OnftId))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var nesting types.Nesting
+		if err := k.cdc.Unmarshal(value, &nesting); err != nil {
+			return err
+		}
+		children = append(children, nesting)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChildrenResponse{
+		Children:   children,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Editions queries the edition cap and the existing editions of a master onft
+func (k Keeper) Editions(
+	c context.Context,
+	request *types.QueryEditionsRequest,
+) (*types.QueryEditionsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	editionSet, found := k.GetEditionSet(ctx, request.DenomId, request.MasterId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no editions exist for master %s", request.MasterId)
+	}
+
+	var editions []types.Edition
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyMasterEditionsPrefix(request.DenomId, request.MasterId))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		edition, found := k.GetEdition(ctx, request.DenomId, string(value))
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidEdition, "edition %s not found", value)
+		}
+		editions = append(editions, edition)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEditionsResponse{
+		EditionSet: editionSet,
+		Editions:   editions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ONFTsByTrait queries the onfts of a denom with a trait value
+func (k Keeper) ONFTsByTrait(
+	c context.Context,
+	request *types.QueryONFTsByTraitRequest,
+) (*types.QueryONFTsByTraitResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.validateIndexedTraits(ctx, request.DenomId); err != nil {
+		return nil, err
+	}
+
+	var onfts []types.ONFT
+	trait := types.Trait{TraitType: request.TraitType, Value: request.Value}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTraitONFTsPrefix(request.DenomId, trait))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		onft, err := k.GetONFT(ctx, request.DenomId, string(value))
+		if err != nil {
+			return err
+		}
+		onfts = append(onfts, onft.(types.ONFT))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryONFTsByTraitResponse{
+		ONFTs:      onfts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TraitSummary queries the number of onfts of a denom with each trait value
+func (k Keeper) TraitSummary(
+	c context.Context,
+	request *types.QueryTraitSummaryRequest,
+) (*types.QueryTraitSummaryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.validateIndexedTraits(ctx, request.DenomId); err != nil {
+		return nil, err
+	}
+
+	var traits []types.TraitCount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTraitCountPrefix(request.DenomId))
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var traitCount types.TraitCount
+		if err := k.cdc.Unmarshal(value, &traitCount); err != nil {
+			return err
+		}
+		traits = append(traits, traitCount)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraitSummaryResponse{
+		Traits:      traits,
+		TotalSupply: k.GetTotalSupply(ctx, request.DenomId),
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) validateIndexedTraits(ctx sdk.Context, denomID string) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if !denom.IndexedTraits {
+		return status.Errorf(codes.FailedPrecondition, "traits of denom %s are not indexed", denomID)
+	}
+	return nil
+}
+
+// Launchpad queries the launchpad of a denom and the mints of an optional address
+func (k Keeper) Launchpad(c context.Context, request *types.QueryLaunchpadRequest) (*types.QueryLaunchpadResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	launchpad, found := k.GetLaunchpad(ctx, request.DenomId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "launchpad not found for denom %s", request.DenomId)
+	}
+	response := &types.QueryLaunchpadResponse{Launchpad: &launchpad}
+	if len(request.Address) > 0 {
+		addr, err := sdk.AccAddressFromBech32(request.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", request.Address)
+		}
+		response.WalletMints = k.GetLaunchpadWalletMints(ctx, request.DenomId, addr)
+	}
+	return response, nil
+}
+
+// Vault queries a fractionalization vault by id
+func (k Keeper) Vault(c context.Context, request *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vault, found := k.GetVault(ctx, request.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vault %d not found", request.Id)
+	}
+	return &types.QueryVaultResponse{Vault: &vault}, nil
+}
+
+// Vaults queries all fractionalization vaults
+func (k Keeper) Vaults(c context.Context, request *types.QueryVaultsRequest) (*types.QueryVaultsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var vaults []types.Vault
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixVault)
+	pageRes, err := query.Paginate(store, shapePageRequest(request.Pagination), func(_ []byte, value []byte) error {
+		var vault types.Vault
+		if err := k.cdc.Unmarshal(value, &vault); err != nil {
+			return err
+		}
+		vaults = append(vaults, vault)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultsResponse{
+		Vaults:     vaults,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params queries params of oNFT module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
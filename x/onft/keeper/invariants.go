@@ -10,6 +10,8 @@ import (
 // RegisterInvariants registers all supply invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nesting", NestingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "editions", EditionsInvariant(k))
 }
 
 // SupplyInvariant checks that the total amount of NFTs on collections matches the total amount owned by addresses
@@ -47,3 +49,54 @@ func SupplyInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// NestingInvariant checks that every nested NFT is owned by the nest address of an existing parent
+func NestingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, nesting := range k.GetAllNestings(ctx) {
+			nestAddr := types.NestAddress(nesting.ParentDenomId, nesting.ParentId)
+			owner := k.nk.GetOwner(ctx, nesting.DenomId, nesting.OnftId)
+			if !k.HasONFT(ctx, nesting.ParentDenomId, nesting.ParentId) || !nestAddr.Equals(owner) {
+				count++
+				msg += fmt.Sprintf(
+					"\tnested NFT %s/%s of parent %s/%s is owned by %s\n",
+					nesting.DenomId, nesting.OnftId, nesting.ParentDenomId, nesting.ParentId, owner,
+				)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "nesting",
+			fmt.Sprintf("%d NFT nesting invariants found\n%s", count, msg),
+		), broken
+	}
+}
+
+// EditionsInvariant checks that no master NFT has more editions than its cap
+func EditionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, editionSet := range k.GetAllEditionSets(ctx) {
+			editions := uint64(len(k.GetEditions(ctx, editionSet.DenomId, editionSet.MasterId)))
+			if editionSet.Printed > editionSet.MaxEditions || editions > editionSet.Printed {
+				count++
+				msg += fmt.Sprintf(
+					"\tmaster NFT %s/%s has %d editions and %d printed of max %d\n",
+					editionSet.DenomId, editionSet.MasterId, editions, editionSet.Printed, editionSet.MaxEditions,
+				)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "editions",
+			fmt.Sprintf("%d NFT edition invariants found\n%s", count, msg),
+		), broken
+	}
+}
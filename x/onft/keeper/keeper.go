@@ -25,6 +25,7 @@ type Keeper struct {
 	accountKeeper      types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	distributionKeeper types.DistributionKeeper
+	tokenFactoryKeeper types.TokenFactoryKeeper
 	nk                 nftkeeper.Keeper
 	authority          string
 }
@@ -35,6 +36,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistributionKeeper,
+	tokenFactoryKeeper types.TokenFactoryKeeper,
 	authority string,
 ) Keeper {
 	// ensure oNFT module account is set
@@ -48,6 +50,7 @@ func NewKeeper(
 		accountKeeper:      accountKeeper,
 		bankKeeper:         bankKeeper,
 		distributionKeeper: distrKeeper,
+		tokenFactoryKeeper: tokenFactoryKeeper,
 		nk:                 nftkeeper.NewKeeper(runtime.NewKVStoreService(storeKey), cdc, accountKeeper, bankKeeper),
 		authority:          authority,
 	}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/OmniFlix/omniflixhub/v6/app/apptesting"
 	"github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
 	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
@@ -22,6 +24,8 @@ func TestKeeperTestSuite(t *testing.T) {
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
 	// Fund every TestAcc with some tokens
 	fundAccsAmount := sdk.NewCoins(
 		sdk.NewCoin(types.DefaultDenomCreationFee.Denom, types.DefaultDenomCreationFee.Amount.MulRaw(100)),
@@ -32,3 +36,51 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
 	suite.msgServer = keeper.NewMsgServerImpl(suite.App.ONFTKeeper)
 }
+
+var (
+	defaultDenomId     = "onftdenomtest001"
+	defaultDenomSymbol = "test"
+	defaultONFTData    = "{}"
+)
+
+func (suite *KeeperTestSuite) createDefaultDenom(creator sdk.AccAddress) {
+	msg := types.NewMsgCreateDenom(
+		defaultDenomSymbol,
+		"test denom",
+		"{}",
+		"test description",
+		"ipfs://testuri",
+		"",
+		"ipfs://testpreviewuri",
+		"",
+		creator.String(),
+		types.DefaultDenomCreationFee,
+		nil,
+		false,
+		0,
+		false,
+		false,
+	)
+	msg.Id = defaultDenomId
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) mintONFT(denomId, id string, sender, recipient sdk.AccAddress) {
+	msg := types.NewMsgMintONFT(
+		denomId,
+		sender.String(),
+		recipient.String(),
+		types.Metadata{Name: id, MediaURI: "ipfs://" + id},
+		defaultONFTData,
+		true,
+		true,
+		false,
+		sdkmath.LegacyZeroDec(),
+		nil,
+		nil,
+	)
+	msg.Id = id
+	_, err := suite.msgServer.MintONFT(suite.Ctx, msg)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// SetLaunchpad sets the launchpad of a denom, only the denom creator is allowed to set it.
+// Mint counters of the launchpad and of the phases with existing ids are preserved.
+func (k Keeper) SetLaunchpad(ctx sdk.Context, launchpad types.Launchpad, sender sdk.AccAddress) error {
+	if err := k.AuthorizeDenomCreator(ctx, launchpad.DenomId, sender); err != nil {
+		return err
+	}
+	denom, err := k.GetDenomInfo(ctx, launchpad.DenomId)
+	if err != nil {
+		return err
+	}
+	if denom.MintingClosed {
+		return errorsmod.Wrapf(types.ErrMintingClosed, "minting is closed for denom %s", launchpad.DenomId)
+	}
+	if err := k.ValidateRoyaltyReceiverAddresses(launchpad.SplitShares); err != nil {
+		return err
+	}
+
+	launchpad.Minted = 0
+	existing, found := k.GetLaunchpad(ctx, launchpad.DenomId)
+	if found {
+		launchpad.Minted = existing.Minted
+	}
+	for i := range launchpad.Phases {
+		phase := &launchpad.Phases[i]
+		phase.Minted = 0
+		if existingPhase, ok := existing.GetPhase(phase.Id); found && ok {
+			phase.Minted = existingPhase.Minted
+		}
+		if phase.Supply != 0 && phase.Minted > phase.Supply {
+			return errorsmod.Wrapf(
+				types.ErrInvalidLaunchpad,
+				"supply %d of phase %d is less than minted count %d", phase.Supply, phase.Id, phase.Minted,
+			)
+		}
+	}
+
+	k.SetLaunchpadState(ctx, launchpad)
+	k.emitSetLaunchpadEvent(ctx, launchpad.DenomId, sender.String())
+	return nil
+}
+
+// LaunchpadMint mints the next oNFT of the launchpad to the buyer in an active mint phase
+// and distributes the phase price to the split shares of the launchpad
+func (k Keeper) LaunchpadMint(
+	ctx sdk.Context,
+	denomID string,
+	phaseID uint64,
+	proof [][]byte,
+	buyer sdk.AccAddress,
+) (string, error) {
+	launchpad, found := k.GetLaunchpad(ctx, denomID)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrInvalidLaunchpad, "launchpad not found for denom %s", denomID)
+	}
+	phase, found := launchpad.GetPhase(phaseID)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrInvalidLaunchpad, "phase %d not found for denom %s", phaseID, denomID)
+	}
+	if !phase.IsActive(ctx.BlockTime()) {
+		return "", errorsmod.Wrapf(
+			types.ErrMintPhaseNotActive,
+			"phase %d is active from %s to %s", phaseID, phase.StartTime.String(), phase.EndTime.String(),
+		)
+	}
+	if phase.HasAllowlist() && !types.VerifyMerkleProof(phase.MerkleRoot, types.AllowlistLeaf(buyer), proof) {
+		return "", errorsmod.Wrapf(types.ErrNotAllowlisted, "%s is not allowed to mint in phase %d", buyer.String(), phaseID)
+	}
+	if phase.Supply != 0 && phase.Minted >= phase.Supply {
+		return "", errorsmod.Wrapf(types.ErrPhaseSupplyReached, "all %d onfts of phase %d are minted", phase.Supply, phaseID)
+	}
+	walletMinted := k.GetLaunchpadWalletMinted(ctx, denomID, phaseID, buyer)
+	if phase.PerWalletLimit != 0 && walletMinted >= phase.PerWalletLimit {
+		return "", errorsmod.Wrapf(
+			types.ErrWalletLimitReached,
+			"%s minted %d onfts of phase %d", buyer.String(), walletMinted, phaseID,
+		)
+	}
+
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return "", err
+	}
+	creator, err := sdk.AccAddressFromBech32(denom.Creator)
+	if err != nil {
+		return "", err
+	}
+	if phase.Price.IsPositive() {
+		splitShares := launchpad.SplitShares
+		if len(splitShares) == 0 {
+			splitShares = denom.RoyaltyReceivers
+		}
+		if err := k.distributeMintProceeds(ctx, buyer, phase.Price, splitShares, creator); err != nil {
+			return "", err
+		}
+	}
+
+	tokenNumber := launchpad.Minted + 1
+	for k.HasONFT(ctx, denomID, fmt.Sprintf("%d", tokenNumber)) {
+		tokenNumber++
+	}
+	onftID := fmt.Sprintf("%d", tokenNumber)
+	metadata := launchpad.TokenMetadata(tokenNumber)
+	if err := k.MintONFT(ctx,
+		denomID,
+		onftID,
+		metadata.Name,
+		metadata.Description,
+		metadata.MediaURI,
+		metadata.UriHash,
+		metadata.PreviewURI,
+		launchpad.Data,
+		ctx.BlockTime(),
+		launchpad.Transferable,
+		launchpad.Extensible,
+		launchpad.Nsfw,
+		launchpad.RoyaltyShare,
+		nil,
+		nil,
+		buyer,
+	); err != nil {
+		return "", err
+	}
+
+	launchpad.Minted = tokenNumber
+	phase.Minted++
+	k.SetLaunchpadState(ctx, launchpad)
+	k.SetLaunchpadWalletMint(ctx, types.LaunchpadWalletMint{
+		DenomId: denomID,
+		PhaseId: phaseID,
+		Address: buyer.String(),
+		Minted:  walletMinted + 1,
+	})
+
+	k.emitLaunchpadMintEvent(ctx, denomID, onftID, phaseID, buyer.String(), phase.Price)
+	return onftID, nil
+}
+
+// distributeMintProceeds sends the price from the buyer to the split shares,
+// the remainder after truncation goes to the denom creator
+func (k Keeper) distributeMintProceeds(
+	ctx sdk.Context,
+	buyer sdk.AccAddress,
+	price sdk.Coin,
+	splitShares []*types.WeightedAddress,
+	creator sdk.AccAddress,
+) error {
+	remaining := price
+	for _, share := range splitShares {
+		shareAmount := sdkmath.LegacyNewDecFromInt(price.Amount).Mul(share.Weight).TruncateInt()
+		if !shareAmount.IsPositive() {
+			continue
+		}
+		shareAddr, err := sdk.AccAddressFromBech32(share.Address)
+		if err != nil {
+			return err
+		}
+		shareCoin := sdk.NewCoin(price.Denom, shareAmount)
+		if err := k.bankKeeper.SendCoins(ctx, buyer, shareAddr, sdk.NewCoins(shareCoin)); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(shareCoin)
+	}
+	if remaining.IsPositive() {
+		return k.bankKeeper.SendCoins(ctx, buyer, creator, sdk.NewCoins(remaining))
+	}
+	return nil
+}
+
+// SetLaunchpadState stores the launchpad of a denom
+func (k Keeper) SetLaunchpadState(ctx sdk.Context, launchpad types.Launchpad) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&launchpad)
+	store.Set(types.KeyLaunchpad(launchpad.DenomId), bz)
+}
+
+func (k Keeper) GetLaunchpad(ctx sdk.Context, denomID string) (launchpad types.Launchpad, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLaunchpad(denomID))
+	if bz == nil {
+		return launchpad, false
+	}
+	k.cdc.MustUnmarshal(bz, &launchpad)
+	return launchpad, true
+}
+
+// GetAllLaunchpads returns launchpads of all denoms
+func (k Keeper) GetAllLaunchpads(ctx sdk.Context) (launchpads []types.Launchpad) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixLaunchpad)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var launchpad types.Launchpad
+		k.cdc.MustUnmarshal(iterator.Value(), &launchpad)
+		launchpads = append(launchpads, launchpad)
+	}
+	return launchpads
+}
+
+func (k Keeper) SetLaunchpadWalletMint(ctx sdk.Context, walletMint types.LaunchpadWalletMint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&walletMint)
+	store.Set(types.KeyLaunchpadWalletMint(walletMint.DenomId, walletMint.PhaseId, walletMint.GetAddress()), bz)
+}
+
+// GetLaunchpadWalletMinted returns the number of mints of an address in a mint phase
+func (k Keeper) GetLaunchpadWalletMinted(ctx sdk.Context, denomID string, phaseID uint64, addr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLaunchpadWalletMint(denomID, phaseID, addr))
+	if bz == nil {
+		return 0
+	}
+	var walletMint types.LaunchpadWalletMint
+	k.cdc.MustUnmarshal(bz, &walletMint)
+	return walletMint.Minted
+}
+
+// GetLaunchpadWalletMints returns the mints of an address in all phases of a launchpad
+func (k Keeper) GetLaunchpadWalletMints(ctx sdk.Context, denomID string, addr sdk.AccAddress) (walletMints []types.LaunchpadWalletMint) {
+	launchpad, found := k.GetLaunchpad(ctx, denomID)
+	if !found {
+		return nil
+	}
+	for _, phase := range launchpad.Phases {
+		walletMints = append(walletMints, types.LaunchpadWalletMint{
+			DenomId: denomID,
+			PhaseId: phase.Id,
+			Address: addr.String(),
+			Minted:  k.GetLaunchpadWalletMinted(ctx, denomID, phase.Id, addr),
+		})
+	}
+	return walletMints
+}
+
+// GetAllLaunchpadWalletMints returns the wallet mints of all launchpads
+func (k Keeper) GetAllLaunchpadWalletMints(ctx sdk.Context) (walletMints []types.LaunchpadWalletMint) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixLaunchpadWalletMint)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var walletMint types.LaunchpadWalletMint
+		k.cdc.MustUnmarshal(iterator.Value(), &walletMint)
+		walletMints = append(walletMints, walletMint)
+	}
+	return walletMints
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/OmniFlix/omniflixhub/v6/x/onft/exported"
 	v2 "github.com/OmniFlix/omniflixhub/v6/x/onft/migrations/v2"
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -29,3 +30,32 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc, m.keeper)
 }
+
+// Migrate2to3 migrates the onft module state from the consensus version 2 to
+// version 3. It sets the default max data history retention and ownership
+// history retention params and the default data history retention of the
+// existing denoms.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.MaxDataHistoryRetention == 0 {
+		params.MaxDataHistoryRetention = types.DefaultMaxDataHistoryRetention
+	}
+	if params.OwnershipHistoryRetention == 0 {
+		params.OwnershipHistoryRetention = types.DefaultOwnershipHistoryRetention
+	}
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	denoms, err := m.keeper.GetDenoms(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range denoms {
+		denoms[i].DataHistoryRetention = types.DefaultDataHistoryRetention
+		if err := m.keeper.setDenomMetadata(ctx, &denoms[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	creator := suite.TestAccs[0]
+	params := suite.App.ONFTKeeper.GetParams(suite.Ctx)
+	params.MaxDataHistoryRetention = 0
+	params.OwnershipHistoryRetention = 0
+	suite.Require().NoError(suite.App.ONFTKeeper.SetParams(suite.Ctx, params))
+
+	// denoms created before the migration have no retention set
+	createMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "test denom", "{}",
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, false)
+	createMsg.Id = defaultDenomId
+	createMsg.DataHistoryRetention = 0
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+
+	migrator := keeper.NewMigrator(suite.App.ONFTKeeper, nil)
+	suite.Require().NoError(migrator.Migrate2to3(suite.Ctx))
+
+	params = suite.App.ONFTKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal(types.DefaultMaxDataHistoryRetention, params.MaxDataHistoryRetention)
+	suite.Require().Equal(types.DefaultOwnershipHistoryRetention, params.OwnershipHistoryRetention)
+	denom, err := suite.App.ONFTKeeper.GetDenomInfo(suite.Ctx, defaultDenomId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultDataHistoryRetention, denom.DataHistoryRetention)
+	suite.Require().Equal(creator.String(), denom.Creator)
+}
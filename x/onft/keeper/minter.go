@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// GrantMinter allows minter to mint oNFTs under the denom on behalf of the denom creator.
+// Granting the role again to an existing minter updates the quota and expiry,
+// oNFTs already minted by the minter are counted against the new quota.
+func (k Keeper) GrantMinter(
+	ctx sdk.Context,
+	denomID string,
+	minterAddr sdk.AccAddress,
+	quota uint64,
+	expiry *time.Time,
+	sender sdk.AccAddress,
+) error {
+	if err := k.AuthorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+	if expiry != nil && !expiry.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidMinter,
+			"expiry %s must be after current block time", expiry.String(),
+		)
+	}
+
+	minter := types.NewMinter(denomID, minterAddr, quota, expiry)
+	if existing, found := k.GetMinter(ctx, denomID, minterAddr); found {
+		minter.Minted = existing.Minted
+	}
+	k.SetMinter(ctx, minter)
+
+	k.emitGrantMinterEvent(ctx, minter, sender.String())
+	return nil
+}
+
+// RevokeMinter removes the minter role of an address on the denom
+func (k Keeper) RevokeMinter(ctx sdk.Context, denomID string, minterAddr, sender sdk.AccAddress) error {
+	if err := k.AuthorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+	if _, found := k.GetMinter(ctx, denomID, minterAddr); !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidMinter,
+			"%s is not a minter of denom %s", minterAddr.String(), denomID,
+		)
+	}
+	k.DeleteMinter(ctx, denomID, minterAddr)
+
+	k.emitRevokeMinterEvent(ctx, denomID, minterAddr.String(), sender.String())
+	return nil
+}
+
+// UseMintQuota records count mints against the quota of sender if sender is a
+// minter of the denom. Mints by the denom creator are not limited.
+func (k Keeper) UseMintQuota(ctx sdk.Context, denomID string, sender sdk.AccAddress, count uint64) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if sender.String() == denom.Creator {
+		return nil
+	}
+	minter, found := k.GetMinter(ctx, denomID, sender)
+	if !found || minter.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to mint nft under denom %s",
+			sender.String(),
+			denomID,
+		)
+	}
+	if !minter.HasQuota(count) {
+		return errorsmod.Wrapf(
+			types.ErrMintQuotaExceeded,
+			"minter %s can mint %d more nfts under denom %s",
+			sender.String(),
+			minter.Quota-minter.Minted,
+			denomID,
+		)
+	}
+	minter.Minted += count
+	k.SetMinter(ctx, minter)
+	return nil
+}
+
+func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&minter)
+	store.Set(types.KeyMinter(minter.DenomId, minter.GetAddress()), bz)
+}
+
+func (k Keeper) GetMinter(ctx sdk.Context, denomID string, address sdk.AccAddress) (minter types.Minter, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMinter(denomID, address))
+	if bz == nil {
+		return minter, false
+	}
+	k.cdc.MustUnmarshal(bz, &minter)
+	return minter, true
+}
+
+func (k Keeper) DeleteMinter(ctx sdk.Context, denomID string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMinter(denomID, address))
+}
+
+// GetMinters returns all minters of a denom
+func (k Keeper) GetMinters(ctx sdk.Context, denomID string) (minters []types.Minter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyMinterPrefix(denomID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var minter types.Minter
+		k.cdc.MustUnmarshal(iterator.Value(), &minter)
+		minters = append(minters, minter)
+	}
+	return minters
+}
+
+// GetAllMinters returns minters of all denoms
+func (k Keeper) GetAllMinters(ctx sdk.Context) (minters []types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixMinter)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var minter types.Minter
+		k.cdc.MustUnmarshal(iterator.Value(), &minter)
+		minters = append(minters, minter)
+	}
+	return minters
+}
+
+// DeleteMinters removes all minters of a denom
+func (k Keeper) DeleteMinters(ctx sdk.Context, denomID string) {
+	for _, minter := range k.GetMinters(ctx, denomID) {
+		k.DeleteMinter(ctx, denomID, minter.GetAddress())
+	}
+}
@@ -52,6 +52,13 @@ func (m msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom)
 			return nil, err
 		}
 	}
+	if err := m.Keeper.validateDataHistoryRetention(ctx, msg.DataHistoryRetention); err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(uint64(len(msg.Schema))*types.SchemaValidationGasPerByte, "onft schema compilation")
+	if _, err := types.CompileDataSchema(msg.Schema); err != nil {
+		return nil, err
+	}
 
 	denomCreationFee := m.Keeper.GetDenomCreationFee(ctx)
 	if !msg.CreationFee.Equal(denomCreationFee) {
@@ -95,6 +102,10 @@ func (m msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom)
 		msg.Data,
 		msg.RoyaltyReceivers,
 		msg.UpdatableData,
+		msg.MaxSupply,
+		msg.Revocable,
+		msg.IndexedTraits,
+		msg.DataHistoryRetention,
 	); err != nil {
 		return nil, err
 	}
@@ -167,6 +178,14 @@ func (m msgServer) MintONFT(goCtx context.Context, msg *types.MsgMintONFT) (*typ
 			types.ErrONFTAlreadyExists,
 			"ONFT with id %s already exists in collection %s", msg.Id, msg.DenomId)
 	}
+	if msg.RoyaltyReceivers != nil {
+		if err := m.Keeper.ValidateRoyaltyReceiverAddresses(msg.RoyaltyReceivers); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.Keeper.UseMintQuota(ctx, msg.DenomId, sender, 1); err != nil {
+		return nil, err
+	}
 	if err := m.Keeper.MintONFT(ctx,
 		msg.DenomId,
 		msg.Id,
@@ -181,6 +200,8 @@ func (m msgServer) MintONFT(goCtx context.Context, msg *types.MsgMintONFT) (*typ
 		msg.Extensible,
 		msg.Nsfw,
 		msg.RoyaltyShare,
+		msg.RoyaltyReceivers,
+		msg.TransferLockedUntil,
 		recipient,
 	); err != nil {
 		return nil, err
@@ -206,13 +227,39 @@ func (m msgServer) UpdateONFTData(goCtx context.Context, msg *types.MsgUpdateONF
 		)
 	}
 
-	if err := m.Keeper.UpdateONFTData(ctx, msg.DenomId, msg.Id, msg.Data); err != nil {
+	if err := m.Keeper.UpdateONFTData(ctx, msg.DenomId, msg.Id, msg.Data, sender); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateONFTDataResponse{}, nil
 }
 
+func (m msgServer) UpdateONFTRoyaltyReceivers(
+	goCtx context.Context,
+	msg *types.MsgUpdateONFTRoyaltyReceivers,
+) (*types.MsgUpdateONFTRoyaltyReceiversResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.ValidateRoyaltyReceiverAddresses(msg.RoyaltyReceivers); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.UpdateONFTRoyaltyReceivers(
+		ctx,
+		msg.DenomId,
+		msg.Id,
+		msg.RoyaltyReceivers,
+		sender,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateONFTRoyaltyReceiversResponse{}, nil
+}
+
 func (m msgServer) TransferONFT(goCtx context.Context,
 	msg *types.MsgTransferONFT,
 ) (*types.MsgTransferONFTResponse, error) {
@@ -227,8 +274,13 @@ func (m msgServer) TransferONFT(goCtx context.Context,
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	// sender can be the owner, an approved spender of the onft or an operator of the owner
+	owner, err := m.Keeper.AuthorizeSpender(ctx, msg.DenomId, msg.Id, sender)
+	if err != nil {
+		return nil, err
+	}
 	if err := m.Keeper.TransferOwnership(ctx, msg.DenomId, msg.Id,
-		sender,
+		owner,
 		recipient,
 	); err != nil {
 		return nil, err
@@ -268,3 +320,499 @@ func (m msgServer) PurgeDenom(goCtx context.Context,
 
 	return &types.MsgPurgeDenomResponse{}, nil
 }
+
+func (m msgServer) BatchMintONFT(goCtx context.Context, msg *types.MsgBatchMintONFT) (*types.MsgBatchMintONFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.HasPermissionToMint(ctx, msg.DenomId, sender) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to mint nft under denom %s",
+			sender.String(),
+			msg.DenomId,
+		)
+	}
+	if err := m.Keeper.UseMintQuota(ctx, msg.DenomId, sender, uint64(len(msg.ONFTs))); err != nil {
+		return nil, err
+	}
+
+	for _, item := range msg.ONFTs {
+		recipient, err := sdk.AccAddressFromBech32(item.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		if m.Keeper.HasONFT(ctx, msg.DenomId, item.Id) {
+			return nil, errorsmod.Wrapf(
+				types.ErrONFTAlreadyExists,
+				"ONFT with id %s already exists in collection %s", item.Id, msg.DenomId)
+		}
+		if item.RoyaltyReceivers != nil {
+			if err := m.Keeper.ValidateRoyaltyReceiverAddresses(item.RoyaltyReceivers); err != nil {
+				return nil, err
+			}
+		}
+		if err := m.Keeper.MintONFT(ctx,
+			msg.DenomId,
+			item.Id,
+			item.Metadata.Name,
+			item.Metadata.Description,
+			item.Metadata.MediaURI,
+			item.Metadata.UriHash,
+			item.Metadata.PreviewURI,
+			item.Data,
+			ctx.BlockTime(),
+			item.Transferable,
+			item.Extensible,
+			item.Nsfw,
+			item.RoyaltyShare,
+			item.RoyaltyReceivers,
+			item.TransferLockedUntil,
+			recipient,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBatchMintONFTResponse{}, nil
+}
+
+func (m msgServer) GrantMinter(goCtx context.Context, msg *types.MsgGrantMinter) (*types.MsgGrantMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.GrantMinter(ctx, msg.DenomId, minter, msg.Quota, msg.Expiry, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantMinterResponse{}, nil
+}
+
+func (m msgServer) RevokeMinter(goCtx context.Context, msg *types.MsgRevokeMinter) (*types.MsgRevokeMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RevokeMinter(ctx, msg.DenomId, minter, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeMinterResponse{}, nil
+}
+
+func (m msgServer) CloseMinting(goCtx context.Context, msg *types.MsgCloseMinting) (*types.MsgCloseMintingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.CloseMinting(ctx, msg.DenomId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCloseMintingResponse{}, nil
+}
+
+func (m msgServer) BatchTransferONFT(goCtx context.Context,
+	msg *types.MsgBatchTransferONFT,
+) (*types.MsgBatchTransferONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, item := range msg.ONFTs {
+		recipient, err := sdk.AccAddressFromBech32(item.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := m.Keeper.AuthorizeSpender(ctx, item.DenomId, item.Id, sender)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.Keeper.TransferOwnership(ctx, item.DenomId, item.Id,
+			owner,
+			recipient,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBatchTransferONFTResponse{}, nil
+}
+
+func (m msgServer) BatchBurnONFT(goCtx context.Context,
+	msg *types.MsgBatchBurnONFT,
+) (*types.MsgBatchBurnONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, item := range msg.ONFTs {
+		if err := m.Keeper.BurnONFT(ctx, item.DenomId, item.Id, sender); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBatchBurnONFTResponse{}, nil
+}
+
+func (m msgServer) Approve(goCtx context.Context, msg *types.MsgApprove) (*types.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	spender, err := sdk.AccAddressFromBech32(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.Approve(ctx, msg.DenomId, msg.OnftId, spender, msg.Expiry, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveResponse{}, nil
+}
+
+func (m msgServer) Revoke(goCtx context.Context, msg *types.MsgRevoke) (*types.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	spender, err := sdk.AccAddressFromBech32(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.Revoke(ctx, msg.DenomId, msg.OnftId, spender, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeResponse{}, nil
+}
+
+func (m msgServer) ApproveAll(goCtx context.Context, msg *types.MsgApproveAll) (*types.MsgApproveAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.ApproveAll(ctx, sender, operator, msg.Expiry); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveAllResponse{}, nil
+}
+
+func (m msgServer) RevokeAll(goCtx context.Context, msg *types.MsgRevokeAll) (*types.MsgRevokeAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RevokeAll(ctx, sender, operator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAllResponse{}, nil
+}
+
+func (m msgServer) SetONFTUser(goCtx context.Context, msg *types.MsgSetONFTUser) (*types.MsgSetONFTUserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	var user sdk.AccAddress
+	if len(msg.User) > 0 {
+		user, err = sdk.AccAddressFromBech32(msg.User)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.Keeper.SetUser(ctx, msg.DenomId, msg.OnftId, user, msg.Expiry, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetONFTUserResponse{}, nil
+}
+
+func (m msgServer) RedeemMintVoucher(
+	goCtx context.Context,
+	msg *types.MsgRedeemMintVoucher,
+) (*types.MsgRedeemMintVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RedeemMintVoucher(ctx, msg.Voucher, msg.PubKey, msg.Signature, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemMintVoucherResponse{}, nil
+}
+
+func (m msgServer) SetLaunchpad(goCtx context.Context, msg *types.MsgSetLaunchpad) (*types.MsgSetLaunchpadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.SetLaunchpad(ctx, msg.Launchpad, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetLaunchpadResponse{}, nil
+}
+
+func (m msgServer) LaunchpadMint(goCtx context.Context, msg *types.MsgLaunchpadMint) (*types.MsgLaunchpadMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	onftID, err := m.Keeper.LaunchpadMint(ctx, msg.DenomId, msg.PhaseId, msg.Proof, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLaunchpadMintResponse{OnftId: onftID}, nil
+}
+
+func (m msgServer) Fractionalize(goCtx context.Context, msg *types.MsgFractionalize) (*types.MsgFractionalizeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := m.Keeper.Fractionalize(ctx, msg.DenomId, msg.OnftId, msg.Supply, msg.BuyoutPrice, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFractionalizeResponse{VaultId: vault.Id, FractionDenom: vault.FractionDenom}, nil
+}
+
+func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.MsgRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.Redeem(ctx, msg.VaultId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemResponse{}, nil
+}
+
+func (m msgServer) Buyout(goCtx context.Context, msg *types.MsgBuyout) (*types.MsgBuyoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.Buyout(ctx, msg.VaultId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyoutResponse{}, nil
+}
+
+func (m msgServer) ClaimBuyout(goCtx context.Context, msg *types.MsgClaimBuyout) (*types.MsgClaimBuyoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := m.Keeper.ClaimBuyout(ctx, msg.VaultId, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimBuyoutResponse{Amount: amount}, nil
+}
+
+func (m msgServer) RevokeONFT(goCtx context.Context, msg *types.MsgRevokeONFT) (*types.MsgRevokeONFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RevokeONFT(ctx, msg.DenomId, msg.Id, msg.Reason, msg.Reclaim, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeONFTResponse{}, nil
+}
+
+func (m msgServer) FreezeDenom(goCtx context.Context, msg *types.MsgFreezeDenom) (*types.MsgFreezeDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.FreezeDenom(ctx, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeDenomResponse{}, nil
+}
+
+func (m msgServer) UnfreezeDenom(goCtx context.Context, msg *types.MsgUnfreezeDenom) (*types.MsgUnfreezeDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UnfreezeDenom(ctx, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeDenomResponse{}, nil
+}
+
+func (m msgServer) NestONFT(goCtx context.Context, msg *types.MsgNestONFT) (*types.MsgNestONFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.NestONFT(ctx, msg.DenomId, msg.Id, msg.ParentDenomId, msg.ParentId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgNestONFTResponse{}, nil
+}
+
+func (m msgServer) UnnestONFT(goCtx context.Context, msg *types.MsgUnnestONFT) (*types.MsgUnnestONFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UnnestONFT(ctx, msg.DenomId, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnnestONFTResponse{}, nil
+}
+
+func (m msgServer) CreateEditions(goCtx context.Context, msg *types.MsgCreateEditions) (*types.MsgCreateEditionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.CreateEditions(ctx, msg.DenomId, msg.MasterId, msg.MaxEditions, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEditionsResponse{}, nil
+}
+
+func (m msgServer) PrintEdition(goCtx context.Context, msg *types.MsgPrintEdition) (*types.MsgPrintEditionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := m.Keeper.PrintEdition(ctx, msg.DenomId, msg.MasterId, msg.Id, sender, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPrintEditionResponse{Number: number}, nil
+}
+
+func (m msgServer) UpdateDataHistoryRetention(
+	goCtx context.Context,
+	msg *types.MsgUpdateDataHistoryRetention,
+) (*types.MsgUpdateDataHistoryRetentionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UpdateDataHistoryRetention(ctx, msg.Id, msg.DataHistoryRetention, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDataHistoryRetentionResponse{}, nil
+}
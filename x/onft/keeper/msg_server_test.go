@@ -0,0 +1,1295 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft"
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/keeper"
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+func (suite *KeeperTestSuite) batchMintItems(count int, recipient string) []types.MintONFTItem {
+	items := make([]types.MintONFTItem, 0, count)
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("onft%d", i)
+		items = append(items, types.MintONFTItem{
+			Id:           id,
+			Metadata:     types.Metadata{Name: id, MediaURI: "ipfs://" + id},
+			Data:         defaultONFTData,
+			Transferable: true,
+			Extensible:   true,
+			RoyaltyShare: sdkmath.LegacyZeroDec(),
+			Recipient:    recipient,
+		})
+	}
+	return items
+}
+
+func (suite *KeeperTestSuite) TestBatchMintONFT() {
+	creator := suite.TestAccs[0]
+	suite.createDefaultDenom(creator)
+
+	// unauthorized sender
+	msg := types.NewMsgBatchMintONFT(defaultDenomId, suite.TestAccs[1].String(),
+		suite.batchMintItems(3, suite.TestAccs[1].String()))
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err := suite.msgServer.BatchMintONFT(suite.Ctx, msg)
+	suite.Require().Error(err)
+
+	// valid batch
+	msg = types.NewMsgBatchMintONFT(defaultDenomId, creator.String(), suite.batchMintItems(3, suite.TestAccs[1].String()))
+	_, err = suite.msgServer.BatchMintONFT(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), suite.App.ONFTKeeper.GetTotalSupply(suite.Ctx, defaultDenomId))
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeMintONFT, 3)
+
+	// batch with an existing id is reverted as a whole
+	items := suite.batchMintItems(5, creator.String())
+	msg = types.NewMsgBatchMintONFT(defaultDenomId, creator.String(), items[2:])
+	_, err = suite.msgServer.BatchMintONFT(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrONFTAlreadyExists)
+
+	// duplicate ids within a batch
+	msg = types.NewMsgBatchMintONFT(defaultDenomId, creator.String(), append(items[3:], items[3]))
+	suite.Require().ErrorIs(msg.ValidateBasic(), types.ErrInvalidBatch)
+
+	// empty batch
+	msg = types.NewMsgBatchMintONFT(defaultDenomId, creator.String(), nil)
+	suite.Require().ErrorIs(msg.ValidateBasic(), types.ErrInvalidBatch)
+}
+
+func (suite *KeeperTestSuite) TestBatchTransferAndBurnONFT() {
+	creator := suite.TestAccs[0]
+	owner := suite.TestAccs[1]
+	suite.createDefaultDenom(creator)
+	_, err := suite.msgServer.BatchMintONFT(suite.Ctx,
+		types.NewMsgBatchMintONFT(defaultDenomId, creator.String(), suite.batchMintItems(3, owner.String())))
+	suite.Require().NoError(err)
+
+	transfers := []types.TransferONFTItem{
+		{Id: "onft0", DenomId: defaultDenomId, Recipient: suite.TestAccs[2].String()},
+		{Id: "onft1", DenomId: defaultDenomId, Recipient: suite.TestAccs[2].String()},
+	}
+
+	// only the owner can transfer
+	_, err = suite.msgServer.BatchTransferONFT(suite.Ctx, types.NewMsgBatchTransferONFT(creator.String(), transfers))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.BatchTransferONFT(suite.Ctx, types.NewMsgBatchTransferONFT(owner.String(), transfers))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.App.ONFTKeeper.GetBalance(suite.Ctx, defaultDenomId, suite.TestAccs[2]))
+
+	burns := []types.BurnONFTItem{
+		{Id: "onft0", DenomId: defaultDenomId},
+		{Id: "onft2", DenomId: defaultDenomId},
+	}
+	// onft0 is no longer owned by owner, whole batch must fail
+	_, err = suite.msgServer.BatchBurnONFT(suite.Ctx, types.NewMsgBatchBurnONFT(owner.String(), burns))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.BatchBurnONFT(suite.Ctx, types.NewMsgBatchBurnONFT(owner.String(), burns[1:]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.App.ONFTKeeper.GetTotalSupply(suite.Ctx, defaultDenomId))
+}
+
+func (suite *KeeperTestSuite) TestGrantAndRevokeMinter() {
+	creator := suite.TestAccs[0]
+	minter := suite.TestAccs[1]
+	suite.createDefaultDenom(creator)
+
+	// only the denom creator can grant
+	_, err := suite.msgServer.GrantMinter(suite.Ctx,
+		types.NewMsgGrantMinter(defaultDenomId, suite.TestAccs[2].String(), 0, nil, minter.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// expiry in the past
+	past := suite.Ctx.BlockTime().Add(-time.Hour)
+	_, err = suite.msgServer.GrantMinter(suite.Ctx,
+		types.NewMsgGrantMinter(defaultDenomId, minter.String(), 2, &past, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidMinter)
+
+	_, err = suite.msgServer.GrantMinter(suite.Ctx,
+		types.NewMsgGrantMinter(defaultDenomId, minter.String(), 2, nil, creator.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeGrantMinter, 1)
+	suite.Require().True(suite.App.ONFTKeeper.HasPermissionToMint(suite.Ctx, defaultDenomId, minter))
+
+	// minter mints up to its quota
+	suite.mintONFT(defaultDenomId, "onft1", minter, minter)
+	_, err = suite.msgServer.BatchMintONFT(suite.Ctx,
+		types.NewMsgBatchMintONFT(defaultDenomId, minter.String(), suite.batchMintItems(2, minter.String())))
+	suite.Require().ErrorIs(err, types.ErrMintQuotaExceeded)
+	suite.mintONFT(defaultDenomId, "onft2", minter, minter)
+	suite.Require().False(suite.App.ONFTKeeper.HasPermissionToMint(suite.Ctx, defaultDenomId, minter))
+
+	// mints by the creator are not counted
+	suite.mintONFT(defaultDenomId, "onft3", creator, creator)
+
+	resp, err := suite.App.ONFTKeeper.Minters(suite.Ctx, &types.QueryMintersRequest{DenomId: defaultDenomId})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Minters, 1)
+	suite.Require().Equal(minter.String(), resp.Minters[0].Address)
+	suite.Require().Equal(uint64(2), resp.Minters[0].Minted)
+
+	// re-granting keeps the minted count
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+	_, err = suite.msgServer.GrantMinter(suite.Ctx,
+		types.NewMsgGrantMinter(defaultDenomId, minter.String(), 3, &expiry, creator.String()))
+	suite.Require().NoError(err)
+	suite.mintONFT(defaultDenomId, "onft4", minter, minter)
+
+	// expired minter
+	suite.Ctx = suite.Ctx.WithBlockTime(expiry)
+	suite.Require().False(suite.App.ONFTKeeper.HasPermissionToMint(suite.Ctx, defaultDenomId, minter))
+
+	_, err = suite.msgServer.RevokeMinter(suite.Ctx,
+		types.NewMsgRevokeMinter(defaultDenomId, minter.String(), creator.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeRevokeMinter, 1)
+	_, found := suite.App.ONFTKeeper.GetMinter(suite.Ctx, defaultDenomId, minter)
+	suite.Require().False(found)
+
+	_, err = suite.msgServer.RevokeMinter(suite.Ctx,
+		types.NewMsgRevokeMinter(defaultDenomId, minter.String(), creator.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidMinter)
+}
+
+func (suite *KeeperTestSuite) TestTransferDenomClearsMinters() {
+	creator := suite.TestAccs[0]
+	minter := suite.TestAccs[1]
+	newCreator := suite.TestAccs[2]
+	suite.createDefaultDenom(creator)
+
+	_, err := suite.msgServer.GrantMinter(suite.Ctx,
+		types.NewMsgGrantMinter(defaultDenomId, minter.String(), 0, nil, creator.String()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.ONFTKeeper.HasPermissionToMint(suite.Ctx, defaultDenomId, minter))
+
+	_, err = suite.msgServer.TransferDenom(suite.Ctx,
+		types.NewMsgTransferDenom(defaultDenomId, creator.String(), newCreator.String()))
+	suite.Require().NoError(err)
+
+	// grants of the previous creator are cleared
+	suite.Require().False(suite.App.ONFTKeeper.HasPermissionToMint(suite.Ctx, defaultDenomId, minter))
+	suite.Require().Empty(suite.App.ONFTKeeper.GetMinters(suite.Ctx, defaultDenomId))
+	suite.Require().True(suite.App.ONFTKeeper.HasPermissionToMint(suite.Ctx, defaultDenomId, newCreator))
+}
+
+func (suite *KeeperTestSuite) TestMaxSupplyAndCloseMinting() {
+	creator := suite.TestAccs[0]
+	msg := types.NewMsgCreateDenom(
+		defaultDenomSymbol,
+		"capped denom",
+		"{}",
+		"",
+		"",
+		"",
+		"",
+		"",
+		creator.String(),
+		types.DefaultDenomCreationFee,
+		nil,
+		false,
+		2,
+		false,
+		false,
+	)
+	msg.Id = defaultDenomId
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	denom, err := suite.App.ONFTKeeper.GetDenomInfo(suite.Ctx, defaultDenomId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), denom.MaxSupply)
+
+	// failed batch is reverted by the tx, use a cached context
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = suite.msgServer.BatchMintONFT(cacheCtx,
+		types.NewMsgBatchMintONFT(defaultDenomId, creator.String(), suite.batchMintItems(3, creator.String())))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyReached)
+	suite.mintONFT(defaultDenomId, "onft1", creator, creator)
+
+	// only the creator can close minting
+	_, err = suite.msgServer.CloseMinting(suite.Ctx, types.NewMsgCloseMinting(defaultDenomId, suite.TestAccs[1].String()))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.CloseMinting(suite.Ctx, types.NewMsgCloseMinting(defaultDenomId, creator.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeCloseMinting, 1)
+
+	resp, err := suite.App.ONFTKeeper.Denom(suite.Ctx, &types.QueryDenomRequest{DenomId: defaultDenomId})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.Denom.MintingClosed)
+	suite.Require().Equal(uint64(2), resp.Denom.MaxSupply)
+
+	suite.Require().False(suite.App.ONFTKeeper.HasPermissionToMint(suite.Ctx, defaultDenomId, creator))
+	err = suite.App.ONFTKeeper.MintONFT(suite.Ctx, defaultDenomId, "onft2", "onft2", "", "ipfs://onft2", "", "",
+		defaultONFTData, suite.Ctx.BlockTime(), true, true, false, sdkmath.LegacyZeroDec(), nil, nil, creator)
+	suite.Require().ErrorIs(err, types.ErrMintingClosed)
+
+	_, err = suite.msgServer.CloseMinting(suite.Ctx, types.NewMsgCloseMinting(defaultDenomId, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrMintingClosed)
+}
+
+func (suite *KeeperTestSuite) TestApproveAndTransferONFT() {
+	creator := suite.TestAccs[0]
+	owner := suite.TestAccs[1]
+	spender := suite.TestAccs[2]
+	suite.createDefaultDenom(creator)
+	suite.mintONFT(defaultDenomId, "onft1", creator, owner)
+
+	// spender can't transfer without approval
+	transferMsg := types.NewMsgTransferONFT("onft1", defaultDenomId, spender.String(), spender.String())
+	_, err := suite.msgServer.TransferONFT(suite.Ctx, transferMsg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// only the owner or an operator can approve
+	_, err = suite.msgServer.Approve(suite.Ctx,
+		types.NewMsgApprove(defaultDenomId, "onft1", spender.String(), nil, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.Approve(suite.Ctx,
+		types.NewMsgApprove(defaultDenomId, "onft1", spender.String(), nil, owner.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeApproveONFT, 1)
+
+	resp, err := suite.App.ONFTKeeper.Approvals(suite.Ctx,
+		&types.QueryApprovalsRequest{DenomId: defaultDenomId, OnftId: "onft1"})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Approvals, 1)
+
+	_, err = suite.msgServer.TransferONFT(suite.Ctx, transferMsg)
+	suite.Require().NoError(err)
+	suite.Require().True(spender.Equals(suite.App.ONFTKeeper.NFTkeeper().GetOwner(suite.Ctx, defaultDenomId, "onft1")))
+
+	// approvals are cleared on transfer
+	resp, err = suite.App.ONFTKeeper.Approvals(suite.Ctx,
+		&types.QueryApprovalsRequest{DenomId: defaultDenomId, OnftId: "onft1"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Approvals)
+
+	// expired approval
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+	_, err = suite.msgServer.Approve(suite.Ctx,
+		types.NewMsgApprove(defaultDenomId, "onft1", owner.String(), &expiry, spender.String()))
+	suite.Require().NoError(err)
+	expiredCtx := suite.Ctx.WithBlockTime(expiry)
+	_, err = suite.msgServer.TransferONFT(expiredCtx,
+		types.NewMsgTransferONFT("onft1", defaultDenomId, owner.String(), owner.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.Revoke(suite.Ctx,
+		types.NewMsgRevoke(defaultDenomId, "onft1", owner.String(), spender.String()))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.ONFTKeeper.IsApprovedSpender(suite.Ctx, defaultDenomId, "onft1", owner))
+}
+
+func (suite *KeeperTestSuite) TestApproveAllAndTransferONFT() {
+	creator := suite.TestAccs[0]
+	owner := suite.TestAccs[1]
+	operator := suite.TestAccs[2]
+	suite.createDefaultDenom(creator)
+	suite.mintONFT(defaultDenomId, "onft1", creator, owner)
+	suite.mintONFT(defaultDenomId, "onft2", creator, owner)
+
+	_, err := suite.msgServer.ApproveAll(suite.Ctx, types.NewMsgApproveAll(operator.String(), nil, owner.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeApproveAll, 1)
+
+	resp, err := suite.App.ONFTKeeper.Operators(suite.Ctx, &types.QueryOperatorsRequest{Owner: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Operators, 1)
+	suite.Require().Equal(operator.String(), resp.Operators[0].Operator)
+
+	// operator can approve spenders and transfer any onft of the owner
+	_, err = suite.msgServer.Approve(suite.Ctx,
+		types.NewMsgApprove(defaultDenomId, "onft2", creator.String(), nil, operator.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.BatchTransferONFT(suite.Ctx, types.NewMsgBatchTransferONFT(operator.String(),
+		[]types.TransferONFTItem{
+			{Id: "onft1", DenomId: defaultDenomId, Recipient: operator.String()},
+		}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.App.ONFTKeeper.GetBalance(suite.Ctx, defaultDenomId, operator))
+
+	_, err = suite.msgServer.RevokeAll(suite.Ctx, types.NewMsgRevokeAll(operator.String(), owner.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.TransferONFT(suite.Ctx,
+		types.NewMsgTransferONFT("onft2", defaultDenomId, operator.String(), operator.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.RevokeAll(suite.Ctx, types.NewMsgRevokeAll(operator.String(), owner.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidApproval)
+}
+
+func (suite *KeeperTestSuite) TestONFTRoyaltyReceivers() {
+	creator := suite.TestAccs[0]
+	owner := suite.TestAccs[1]
+	suite.createDefaultDenom(creator)
+
+	royaltyReceivers := []*types.WeightedAddress{
+		{Address: owner.String(), Weight: sdkmath.LegacyNewDecWithPrec(5, 1)},
+		{Address: suite.TestAccs[2].String(), Weight: sdkmath.LegacyNewDecWithPrec(5, 1)},
+	}
+	msg := types.NewMsgMintONFT(
+		defaultDenomId,
+		creator.String(),
+		owner.String(),
+		types.Metadata{Name: "onft1", MediaURI: "ipfs://onft1"},
+		defaultONFTData,
+		true,
+		true,
+		false,
+		sdkmath.LegacyNewDecWithPrec(1, 2),
+		royaltyReceivers,
+		nil,
+	)
+	msg.Id = "onft1"
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err := suite.msgServer.MintONFT(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	onft, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, "onft1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(royaltyReceivers, onft.(types.ONFT).RoyaltyReceivers)
+
+	// receivers must sum up to 1
+	invalidMsg := types.NewMsgUpdateONFTRoyaltyReceivers(defaultDenomId, "onft1",
+		royaltyReceivers[:1], creator.String())
+	suite.Require().ErrorIs(invalidMsg.ValidateBasic(), types.ErrInvalidRoyaltyReceivers)
+
+	// only denom creator can update the royalty receivers
+	updated := []*types.WeightedAddress{{Address: creator.String(), Weight: sdkmath.LegacyOneDec()}}
+	_, err = suite.msgServer.UpdateONFTRoyaltyReceivers(suite.Ctx,
+		types.NewMsgUpdateONFTRoyaltyReceivers(defaultDenomId, "onft1", updated, owner.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.UpdateONFTRoyaltyReceivers(suite.Ctx,
+		types.NewMsgUpdateONFTRoyaltyReceivers(defaultDenomId, "onft1", updated, creator.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeUpdateONFTRoyaltyReceivers, 1)
+
+	onft, err = suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, "onft1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(updated, onft.(types.ONFT).RoyaltyReceivers)
+	suite.Require().Equal(defaultONFTData, onft.GetData())
+
+	// clearing the receivers falls back to the denom royalty receivers
+	_, err = suite.msgServer.UpdateONFTRoyaltyReceivers(suite.Ctx,
+		types.NewMsgUpdateONFTRoyaltyReceivers(defaultDenomId, "onft1", nil, creator.String()))
+	suite.Require().NoError(err)
+	onft, err = suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, "onft1")
+	suite.Require().NoError(err)
+	suite.Require().Empty(onft.(types.ONFT).RoyaltyReceivers)
+}
+
+func (suite *KeeperTestSuite) TestSetONFTUser() {
+	creator := suite.TestAccs[0]
+	owner := suite.TestAccs[1]
+	user := suite.TestAccs[2]
+	suite.createDefaultDenom(creator)
+	suite.mintONFT(defaultDenomId, "onft1", creator, owner)
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+
+	// only the owner or an operator can set the user
+	_, err := suite.msgServer.SetONFTUser(suite.Ctx,
+		types.NewMsgSetONFTUser(defaultDenomId, "onft1", user.String(), expiry, user.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetONFTUser(suite.Ctx,
+		types.NewMsgSetONFTUser(defaultDenomId, "onft1", user.String(), suite.Ctx.BlockTime(), owner.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidONFTUser)
+
+	_, err = suite.msgServer.SetONFTUser(suite.Ctx,
+		types.NewMsgSetONFTUser(defaultDenomId, "onft1", user.String(), expiry, owner.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeSetONFTUser, 1)
+
+	resp, err := suite.App.ONFTKeeper.UserOf(suite.Ctx,
+		&types.QueryUserOfRequest{DenomId: defaultDenomId, OnftId: "onft1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(user.String(), resp.User.User)
+	suite.Require().True(suite.App.ONFTKeeper.IsOwnerOrUser(suite.Ctx, defaultDenomId, "onft1", user))
+
+	// user role lapses once the block time passes the expiry
+	lapsedCtx := suite.Ctx.WithBlockTime(expiry)
+	resp, err = suite.App.ONFTKeeper.UserOf(lapsedCtx,
+		&types.QueryUserOfRequest{DenomId: defaultDenomId, OnftId: "onft1"})
+	suite.Require().NoError(err)
+	suite.Require().Nil(resp.User)
+	suite.Require().False(suite.App.ONFTKeeper.IsOwnerOrUser(lapsedCtx, defaultDenomId, "onft1", user))
+
+	// empty user removes the current user
+	_, err = suite.msgServer.SetONFTUser(suite.Ctx,
+		types.NewMsgSetONFTUser(defaultDenomId, "onft1", "", time.Time{}, owner.String()))
+	suite.Require().NoError(err)
+	_, found := suite.App.ONFTKeeper.GetCurrentUser(suite.Ctx, defaultDenomId, "onft1")
+	suite.Require().False(found)
+
+	// transfer clears the user
+	_, err = suite.msgServer.SetONFTUser(suite.Ctx,
+		types.NewMsgSetONFTUser(defaultDenomId, "onft1", user.String(), expiry, owner.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.TransferONFT(suite.Ctx,
+		types.NewMsgTransferONFT("onft1", defaultDenomId, owner.String(), creator.String()))
+	suite.Require().NoError(err)
+	_, found = suite.App.ONFTKeeper.GetCurrentUser(suite.Ctx, defaultDenomId, "onft1")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestDataSchemaValidation() {
+	creator := suite.TestAccs[0]
+	schema := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "test",
+		"type": "object",
+		"required": ["level", "attributes"],
+		"properties": {
+			"level": {"type": "integer", "minimum": 1, "maximum": 100},
+			"score": {"type": "number"},
+			"rarity": {"enum": ["common", "rare"]},
+			"attributes": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"required": ["trait_type", "value"],
+					"properties": {"trait_type": {"type": "string", "minLength": 1}}
+				}
+			}
+		},
+		"additionalProperties": false
+	}`
+
+	// schemas using unsupported keywords are rejected
+	invalidSchemaMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "test denom", `{"$ref": "#/definitions/a"}`,
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, false)
+	suite.Require().ErrorIs(invalidSchemaMsg.ValidateBasic(), types.ErrInvalidSchema)
+	invalidSchemaMsg = types.NewMsgCreateDenom(defaultDenomSymbol, "test denom", `{"type": "object", "oneOf": []}`,
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, false)
+	invalidSchemaMsg.Id = defaultDenomId
+	suite.Require().ErrorIs(invalidSchemaMsg.ValidateBasic(), types.ErrInvalidSchema)
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, invalidSchemaMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidSchema)
+
+	createMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "test denom", schema,
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, false)
+	createMsg.Id = defaultDenomId
+	suite.Require().NoError(createMsg.ValidateBasic())
+	_, err = suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+
+	mint := func(id, data string) error {
+		msg := types.NewMsgMintONFT(defaultDenomId, creator.String(), creator.String(),
+			types.Metadata{Name: id, MediaURI: "ipfs://" + id}, data, true, true, false, sdkmath.LegacyZeroDec(), nil, nil)
+		msg.Id = id
+		_, err := suite.msgServer.MintONFT(suite.Ctx, msg)
+		return err
+	}
+
+	validData := `{"level": 10, "rarity": "rare", "attributes": [{"trait_type": "color", "value": "red"}]}`
+	gasBefore := suite.Ctx.GasMeter().GasConsumed()
+	suite.Require().NoError(mint("onft1", validData))
+	suite.Require().Greater(suite.Ctx.GasMeter().GasConsumed()-gasBefore, uint64(len(schema)))
+
+	for _, tc := range []struct {
+		data string
+		path string
+	}{
+		{`{"attributes": []}`, `$: missing required property "level"`},
+		{`{"level": 1.5, "attributes": []}`, "$.level: expected integer, got number"},
+		{`{"level": 101, "attributes": []}`, "$.level: value must be <= 100"},
+		{`{"level": 1, "rarity": "epic", "attributes": []}`, "$.rarity: value must be one of the enum values"},
+		{`{"level": 1, "attributes": [{"trait_type": "color", "value": 1}, {"trait_type": ""}]}`, `$.attributes[1]: missing required property "value"`},
+		{`{"level": 1, "attributes": [], "extra": true}`, "$.extra: value is not allowed"},
+		// numbers are bounded before they are converted
+		{`{"level": 1, "attributes": [], "score": 1e1000000}`, "$.score: invalid number 1e1000000"},
+		{`{"level": 1e1000000, "attributes": []}`, "$.level: expected integer, got number"},
+		{`{"level": 1, "attributes": [], "rarity": "` + strings.Repeat("a", types.MaxSchemaDataLen) + `"}`, "exceeds max length"},
+	} {
+		err := mint("onft2", tc.data)
+		suite.Require().ErrorIs(err, types.ErrInvalidData, tc.data)
+		suite.Require().Contains(err.Error(), tc.path)
+	}
+
+	// update data is validated against the schema as well
+	_, err = suite.msgServer.UpdateONFTData(suite.Ctx,
+		types.NewMsgUpdateONFTData(defaultDenomId, "onft1", `{"level": 0, "attributes": []}`, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidData)
+	suite.Require().Contains(err.Error(), "$.level: value must be >= 1")
+
+	_, err = suite.msgServer.UpdateONFTData(suite.Ctx,
+		types.NewMsgUpdateONFTData(defaultDenomId, "onft1", `{"level": 2, "attributes": []}`, creator.String()))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestONFTDataHistory() {
+	creator := suite.TestAccs[0]
+	createMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "test denom", "{}",
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, false)
+	createMsg.Id = defaultDenomId
+	createMsg.DataHistoryRetention = 2
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+	suite.mintONFT(defaultDenomId, "onft1", creator, creator)
+
+	for _, data := range []string{`{"level": 1}`, `{"level": 1}`, `{"level": 2}`, `{"level": 3}`} {
+		_, err = suite.msgServer.UpdateONFTData(suite.Ctx,
+			types.NewMsgUpdateONFTData(defaultDenomId, "onft1", data, creator.String()))
+		suite.Require().NoError(err)
+	}
+
+	// unchanged data is not recorded and versions beyond retention are pruned
+	resp, err := suite.queryClient.ONFTDataHistory(suite.Ctx, &types.QueryONFTDataHistoryRequest{
+		DenomId: defaultDenomId,
+		OnftId:  "onft1",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Versions, 2)
+	suite.Require().Equal(uint64(2), resp.Versions[0].Version)
+	suite.Require().Equal(`{"level": 1}`, resp.Versions[0].Data)
+	suite.Require().Equal(uint64(3), resp.Versions[1].Version)
+	suite.Require().Equal(`{"level": 2}`, resp.Versions[1].Data)
+	suite.Require().Equal(creator.String(), resp.Versions[1].Updater)
+	suite.Require().Equal(suite.Ctx.BlockHeight(), resp.Versions[1].Height)
+
+	_, err = suite.msgServer.BurnONFT(suite.Ctx, types.NewMsgBurnONFT(defaultDenomId, "onft1", creator.String()))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.ONFTKeeper.GetDataHistory(suite.Ctx, defaultDenomId, "onft1"))
+}
+
+func (suite *KeeperTestSuite) TestUpdateDataHistoryRetention() {
+	creator := suite.TestAccs[0]
+	params := suite.App.ONFTKeeper.GetParams(suite.Ctx)
+	params.MaxDataHistoryRetention = 3
+	suite.Require().NoError(suite.App.ONFTKeeper.SetParams(suite.Ctx, params))
+
+	// retention above the module max is rejected
+	createMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "test denom", "{}",
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, false)
+	createMsg.Id = defaultDenomId
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidHistoryRetention)
+
+	createMsg.DataHistoryRetention = 3
+	_, err = suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+	suite.mintONFT(defaultDenomId, "onft1", creator, creator)
+
+	_, err = suite.msgServer.UpdateDataHistoryRetention(suite.Ctx,
+		types.NewMsgUpdateDataHistoryRetention(defaultDenomId, 1, suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.UpdateDataHistoryRetention(suite.Ctx,
+		types.NewMsgUpdateDataHistoryRetention(defaultDenomId, 4, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidHistoryRetention)
+	_, err = suite.msgServer.UpdateDataHistoryRetention(suite.Ctx,
+		types.NewMsgUpdateDataHistoryRetention(defaultDenomId, 1, creator.String()))
+	suite.Require().NoError(err)
+
+	denom, err := suite.App.ONFTKeeper.GetDenomInfo(suite.Ctx, defaultDenomId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), denom.DataHistoryRetention)
+
+	for _, data := range []string{`{"level": 1}`, `{"level": 2}`, `{"level": 3}`} {
+		_, err = suite.msgServer.UpdateONFTData(suite.Ctx,
+			types.NewMsgUpdateONFTData(defaultDenomId, "onft1", data, creator.String()))
+		suite.Require().NoError(err)
+	}
+	suite.Require().Len(suite.App.ONFTKeeper.GetDataHistory(suite.Ctx, defaultDenomId, "onft1"), 1)
+
+	// a zero module max disables the history of all denoms
+	params.MaxDataHistoryRetention = 0
+	suite.Require().NoError(suite.App.ONFTKeeper.SetParams(suite.Ctx, params))
+	_, err = suite.msgServer.UpdateONFTData(suite.Ctx,
+		types.NewMsgUpdateONFTData(defaultDenomId, "onft1", `{"level": 4}`, creator.String()))
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.App.ONFTKeeper.GetDataHistory(suite.Ctx, defaultDenomId, "onft1"), 1)
+}
+
+func (suite *KeeperTestSuite) TestRedeemMintVoucher() {
+	creator := suite.TestAccs[0]
+	redeemer := suite.TestAccs[1]
+	signerKey := secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(signerKey.PubKey().Address())
+	price := sdk.NewInt64Coin("uflix", 5_000_000)
+
+	suite.createDefaultDenom(creator)
+	_, err := suite.msgServer.GrantMinter(suite.Ctx,
+		types.NewMsgGrantMinter(defaultDenomId, signer.String(), 1, nil, creator.String()))
+	suite.Require().NoError(err)
+	suite.FundAcc(redeemer, sdk.NewCoins(sdk.NewInt64Coin("uflix", 10_000_000)))
+
+	newVoucher := func(id string, nonce uint64) types.MintVoucher {
+		return types.MintVoucher{
+			DenomId:      defaultDenomId,
+			Id:           id,
+			Metadata:     types.Metadata{Name: id, MediaURI: "ipfs://" + id},
+			Data:         defaultONFTData,
+			Transferable: true,
+			Extensible:   true,
+			RoyaltyShare: sdkmath.LegacyZeroDec(),
+			Price:        price,
+			Expiry:       suite.Ctx.BlockTime().Add(time.Hour),
+			Nonce:        nonce,
+			Signer:       signer.String(),
+		}
+	}
+	redeem := func(voucher types.MintVoucher, chainID string) error {
+		signature, err := signerKey.Sign(voucher.GetSignBytes(chainID))
+		suite.Require().NoError(err)
+		msg := types.NewMsgRedeemMintVoucher(voucher, signerKey.PubKey().Bytes(), signature, redeemer.String())
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		ctx, write := suite.Ctx.CacheContext()
+		if _, err := suite.msgServer.RedeemMintVoucher(ctx, msg); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+
+	// vouchers signed for another chain or tampered after signing are rejected
+	suite.Require().ErrorIs(redeem(newVoucher("onft1", 1), "other-chain"), types.ErrInvalidVoucher)
+	voucher := newVoucher("onft1", 1)
+	signature, err := signerKey.Sign(voucher.GetSignBytes(suite.Ctx.ChainID()))
+	suite.Require().NoError(err)
+	voucher.Price = sdk.NewInt64Coin("uflix", 1)
+	gasBefore := suite.Ctx.GasMeter().GasConsumed()
+	_, err = suite.msgServer.RedeemMintVoucher(suite.Ctx,
+		types.NewMsgRedeemMintVoucher(voucher, signerKey.PubKey().Bytes(), signature, redeemer.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVoucher)
+	// the signature check is charged like a tx signature verification
+	suite.Require().GreaterOrEqual(suite.Ctx.GasMeter().GasConsumed()-gasBefore,
+		suite.App.AccountKeeper.GetParams(suite.Ctx).SigVerifyCostSecp256k1)
+
+	expired := newVoucher("onft1", 1)
+	expired.Expiry = suite.Ctx.BlockTime()
+	suite.Require().ErrorIs(redeem(expired, suite.Ctx.ChainID()), types.ErrInvalidVoucher)
+
+	creatorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, "uflix")
+	suite.Require().NoError(redeem(newVoucher("onft1", 1), suite.Ctx.ChainID()))
+	onft, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, "onft1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(redeemer, onft.GetOwner())
+	suite.Require().Equal(
+		creatorBalance.Add(price),
+		suite.App.BankKeeper.GetBalance(suite.Ctx, creator, "uflix"),
+	)
+
+	// nonces can not be replayed and redeemed vouchers use the minter quota
+	suite.Require().ErrorIs(redeem(newVoucher("onft2", 1), suite.Ctx.ChainID()), types.ErrInvalidVoucher)
+	suite.Require().ErrorIs(redeem(newVoucher("onft2", 2), suite.Ctx.ChainID()), sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestLaunchpadMint() {
+	creator := suite.TestAccs[0]
+	buyer := suite.TestAccs[1]
+	allowlisted := suite.TestAccs[2]
+	splitReceiver := sdk.AccAddress([]byte("launchpad_split_addr"))
+	suite.createDefaultDenom(creator)
+	suite.FundAcc(buyer, sdk.NewCoins(sdk.NewInt64Coin("uflix", 10_000_000)))
+	suite.FundAcc(allowlisted, sdk.NewCoins(sdk.NewInt64Coin("uflix", 10_000_000)))
+
+	// allowlist merkle tree of the buyer and another address
+	buyerLeaf := types.AllowlistLeaf(buyer)
+	otherLeaf := types.AllowlistLeaf(allowlisted)
+	root := sha256.Sum256(append(append([]byte{}, otherLeaf...), buyerLeaf...))
+	if bytes.Compare(buyerLeaf, otherLeaf) < 0 {
+		root = sha256.Sum256(append(append([]byte{}, buyerLeaf...), otherLeaf...))
+	}
+
+	now := suite.Ctx.BlockTime()
+	launchpad := types.Launchpad{
+		DenomId:      defaultDenomId,
+		Name:         "drop",
+		MediaURI:     "ipfs://drop/",
+		Data:         defaultONFTData,
+		Transferable: true,
+		Extensible:   true,
+		RoyaltyShare: sdkmath.LegacyZeroDec(),
+		SplitShares: []*types.WeightedAddress{
+			{Address: splitReceiver.String(), Weight: sdkmath.LegacyNewDecWithPrec(4, 1)},
+			{Address: creator.String(), Weight: sdkmath.LegacyNewDecWithPrec(6, 1)},
+		},
+		Phases: []types.MintPhase{
+			{
+				Id:             1,
+				StartTime:      now,
+				EndTime:        now.Add(time.Hour),
+				Price:          sdk.NewInt64Coin("uflix", 1_000_000),
+				MerkleRoot:     root[:],
+				PerWalletLimit: 1,
+				Supply:         2,
+			},
+			{
+				Id:        2,
+				StartTime: now.Add(time.Hour),
+				EndTime:   now.Add(2 * time.Hour),
+				Price:     sdk.NewInt64Coin("uflix", 2_000_000),
+			},
+		},
+	}
+	_, err := suite.msgServer.SetLaunchpad(suite.Ctx, types.NewMsgSetLaunchpad(launchpad, buyer.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	msg := types.NewMsgSetLaunchpad(launchpad, creator.String())
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err = suite.msgServer.SetLaunchpad(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	mint := func(phaseID uint64, proof [][]byte, sender sdk.AccAddress) (string, error) {
+		ctx, write := suite.Ctx.CacheContext()
+		resp, err := suite.msgServer.LaunchpadMint(ctx, types.NewMsgLaunchpadMint(defaultDenomId, phaseID, proof, sender.String()))
+		if err != nil {
+			return "", err
+		}
+		write()
+		return resp.OnftId, nil
+	}
+
+	_, err = mint(1, [][]byte{buyerLeaf}, buyer)
+	suite.Require().ErrorIs(err, types.ErrNotAllowlisted)
+	_, err = mint(2, nil, buyer)
+	suite.Require().ErrorIs(err, types.ErrMintPhaseNotActive)
+
+	creatorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, "uflix")
+	onftID, err := mint(1, [][]byte{otherLeaf}, buyer)
+	suite.Require().NoError(err)
+	suite.Require().Equal("1", onftID)
+	onft, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, onftID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(buyer, onft.GetOwner())
+	suite.Require().Equal("drop #1", onft.GetName())
+	suite.Require().Equal("ipfs://drop/1", onft.GetMediaURI())
+	suite.Require().Equal(int64(400_000), suite.App.BankKeeper.GetBalance(suite.Ctx, splitReceiver, "uflix").Amount.Int64())
+	suite.Require().Equal(
+		creatorBalance.AddAmount(sdkmath.NewInt(600_000)),
+		suite.App.BankKeeper.GetBalance(suite.Ctx, creator, "uflix"),
+	)
+
+	_, err = mint(1, [][]byte{otherLeaf}, buyer)
+	suite.Require().ErrorIs(err, types.ErrWalletLimitReached)
+
+	// public phase mints skip the ids minted outside of the launchpad
+	suite.mintONFT(defaultDenomId, "2", creator, creator)
+	suite.Ctx = suite.Ctx.WithBlockTime(now.Add(time.Hour))
+	onftID, err = mint(2, nil, allowlisted)
+	suite.Require().NoError(err)
+	suite.Require().Equal("3", onftID)
+
+	resp, err := suite.queryClient.Launchpad(suite.Ctx, &types.QueryLaunchpadRequest{
+		DenomId: defaultDenomId,
+		Address: buyer.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), resp.Launchpad.Minted)
+	suite.Require().Equal(uint64(1), resp.Launchpad.Phases[0].Minted)
+	suite.Require().Equal(uint64(1), resp.WalletMints[0].Minted)
+
+	// updating the launchpad keeps the mint counters of existing phases
+	launchpad.Phases[0].Supply = 1
+	_, err = suite.msgServer.SetLaunchpad(suite.Ctx, types.NewMsgSetLaunchpad(launchpad, creator.String()))
+	suite.Require().NoError(err)
+	updated, _ := suite.App.ONFTKeeper.GetLaunchpad(suite.Ctx, defaultDenomId)
+	suite.Require().Equal(uint64(1), updated.Phases[0].Minted)
+	suite.Require().Equal(uint64(3), updated.Minted)
+}
+
+func (suite *KeeperTestSuite) TestFractionalizeONFT() {
+	depositor := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	buyer := suite.TestAccs[2]
+	suite.createDefaultDenom(depositor)
+	suite.mintONFT(defaultDenomId, "onft1", depositor, depositor)
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	tfFee := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx).DenomCreationFee
+	suite.FundAcc(depositor, tfFee.Add(tfFee...))
+	suite.FundAcc(buyer, sdk.NewCoins(sdk.NewInt64Coin("uflix", 1_000_000)))
+
+	fractionalize := func(sender sdk.AccAddress) (*types.MsgFractionalizeResponse, error) {
+		ctx, write := suite.Ctx.CacheContext()
+		msg := types.NewMsgFractionalize(
+			defaultDenomId, "onft1", sdkmath.NewInt(100), sdk.NewInt64Coin("uflix", 1_000_000), sender.String(),
+		)
+		suite.Require().NoError(msg.ValidateBasic())
+		resp, err := suite.msgServer.Fractionalize(ctx, msg)
+		if err == nil {
+			write()
+		}
+		return resp, err
+	}
+	send := func(from, to sdk.AccAddress, coin sdk.Coin) {
+		suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, from, to, sdk.NewCoins(coin)))
+	}
+	ownerOf := func() string {
+		onft, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, "onft1")
+		suite.Require().NoError(err)
+		return onft.GetOwner().String()
+	}
+
+	// only the owner can fractionalize
+	_, err := fractionalize(holder)
+	suite.Require().Error(err)
+
+	resp, err := fractionalize(depositor)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), resp.VaultId)
+	suite.Require().Equal(moduleAddr.String(), ownerOf())
+	suite.Require().Equal(int64(100), suite.App.BankKeeper.GetBalance(suite.Ctx, depositor, resp.FractionDenom).Amount.Int64())
+	metadata, found := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, resp.FractionDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.VaultSubdenom(1), metadata.Symbol)
+
+	// redeem requires the full supply
+	send(depositor, holder, sdk.NewInt64Coin(resp.FractionDenom, 40))
+	_, err = suite.msgServer.Redeem(suite.Ctx, types.NewMsgRedeem(resp.VaultId, depositor.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVault)
+	send(holder, depositor, sdk.NewInt64Coin(resp.FractionDenom, 40))
+	_, err = suite.msgServer.Redeem(suite.Ctx, types.NewMsgRedeem(resp.VaultId, depositor.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(depositor.String(), ownerOf())
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, depositor, resp.FractionDenom).IsZero())
+	vaultResp, err := suite.queryClient.Vault(suite.Ctx, &types.QueryVaultRequest{Id: resp.VaultId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.VaultStatus_VAULT_STATUS_REDEEMED, vaultResp.Vault.Status)
+
+	// buyout pays the fraction holders pro-rata
+	resp, err = fractionalize(depositor)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), resp.VaultId)
+	send(depositor, holder, sdk.NewInt64Coin(resp.FractionDenom, 30))
+
+	_, err = suite.msgServer.ClaimBuyout(suite.Ctx, types.NewMsgClaimBuyout(resp.VaultId, holder.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVault)
+	_, err = suite.msgServer.Buyout(suite.Ctx, types.NewMsgBuyout(resp.VaultId, buyer.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(buyer.String(), ownerOf())
+	_, err = suite.msgServer.Redeem(suite.Ctx, types.NewMsgRedeem(resp.VaultId, depositor.String()))
+	suite.Require().ErrorIs(err, types.ErrVaultNotActive)
+	_, err = suite.msgServer.Buyout(suite.Ctx, types.NewMsgBuyout(resp.VaultId, buyer.String()))
+	suite.Require().ErrorIs(err, types.ErrVaultNotActive)
+
+	holderBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, holder, "uflix")
+	claimResp, err := suite.msgServer.ClaimBuyout(suite.Ctx, types.NewMsgClaimBuyout(resp.VaultId, holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("uflix", 300_000), claimResp.Amount)
+	suite.Require().Equal(holderBalance.AddAmount(sdkmath.NewInt(300_000)), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, "uflix"))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, holder, resp.FractionDenom).IsZero())
+
+	claimResp, err = suite.msgServer.ClaimBuyout(suite.Ctx, types.NewMsgClaimBuyout(resp.VaultId, depositor.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("uflix", 700_000), claimResp.Amount)
+	_, err = suite.msgServer.ClaimBuyout(suite.Ctx, types.NewMsgClaimBuyout(resp.VaultId, depositor.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVault)
+
+	vaultResp, err = suite.queryClient.Vault(suite.Ctx, &types.QueryVaultRequest{Id: resp.VaultId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.VaultStatus_VAULT_STATUS_BOUGHT_OUT, vaultResp.Vault.Status)
+	suite.Require().Equal(buyer.String(), vaultResp.Vault.Buyer)
+	suite.Require().True(vaultResp.Vault.Proceeds.IsZero())
+	suite.Require().True(vaultResp.Vault.Unclaimed.IsZero())
+
+	vaultsResp, err := suite.queryClient.Vaults(suite.Ctx, &types.QueryVaultsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(vaultsResp.Vaults, 2)
+}
+
+func (suite *KeeperTestSuite) TestRevokeONFT() {
+	creator := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	suite.createDefaultDenom(creator)
+	suite.mintONFT(defaultDenomId, "onft0", creator, holder)
+
+	revocableDenomId := "onftdenomrevocable"
+	createMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "credentials", "{}",
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, false, 0, true, false)
+	createMsg.Id = revocableDenomId
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+	for _, id := range []string{"onft1", "onft2"} {
+		err = suite.App.ONFTKeeper.MintONFT(suite.Ctx, revocableDenomId, id, id, "", "ipfs://"+id, "", "",
+			defaultONFTData, suite.Ctx.BlockTime(), false, true, false, sdkmath.LegacyZeroDec(), nil, nil, holder)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().ErrorIs(types.NewMsgRevokeONFT(revocableDenomId, "onft1", " ", false, creator.String()).ValidateBasic(),
+		types.ErrInvalidRevocation)
+
+	// denoms are not revocable by default
+	_, err = suite.msgServer.RevokeONFT(suite.Ctx, types.NewMsgRevokeONFT(defaultDenomId, "onft0", "expired", false, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrNotRevocable)
+
+	// only the denom creator can revoke
+	_, err = suite.msgServer.RevokeONFT(suite.Ctx, types.NewMsgRevokeONFT(revocableDenomId, "onft1", "expired", false, holder.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.RevokeONFT(suite.Ctx, types.NewMsgRevokeONFT(revocableDenomId, "onft1", "expired", false, creator.String()))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.ONFTKeeper.HasONFT(suite.Ctx, revocableDenomId, "onft1"))
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeRevocation, 1)
+
+	// reclaim bypasses the transferable flag
+	_, err = suite.msgServer.RevokeONFT(suite.Ctx, types.NewMsgRevokeONFT(revocableDenomId, "onft2", "issued-in-error", true, creator.String()))
+	suite.Require().NoError(err)
+	onft, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, revocableDenomId, "onft2")
+	suite.Require().NoError(err)
+	suite.Require().Equal(creator, onft.GetOwner())
+	_, err = suite.msgServer.RevokeONFT(suite.Ctx, types.NewMsgRevokeONFT(revocableDenomId, "onft2", "expired", true, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidRevocation)
+
+	resp, err := suite.queryClient.ONFTRevocations(suite.Ctx, &types.QueryONFTRevocationsRequest{DenomId: revocableDenomId})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Revocations, 2)
+
+	resp, err = suite.queryClient.ONFTRevocations(suite.Ctx, &types.QueryONFTRevocationsRequest{DenomId: revocableDenomId, OnftId: "onft2"})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Revocations, 1)
+	revocation := resp.Revocations[0]
+	suite.Require().Equal(uint64(1), revocation.Sequence)
+	suite.Require().Equal(holder.String(), revocation.Holder)
+	suite.Require().Equal(creator.String(), revocation.Issuer)
+	suite.Require().Equal("issued-in-error", revocation.Reason)
+	suite.Require().True(revocation.Reclaimed)
+}
+
+func (suite *KeeperTestSuite) TestTransferLockAndFreezeDenom() {
+	creator := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.createDefaultDenom(creator)
+
+	lockedUntil := suite.Ctx.BlockTime().Add(time.Hour).UTC()
+	msg := types.NewMsgMintONFT(defaultDenomId, creator.String(), holder.String(),
+		types.Metadata{Name: "onft1", MediaURI: "ipfs://onft1"}, defaultONFTData, true, true, false,
+		sdkmath.LegacyZeroDec(), nil, &lockedUntil)
+	msg.Id = "onft1"
+	_, err := suite.msgServer.MintONFT(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	suite.mintONFT(defaultDenomId, "onft2", creator, holder)
+
+	onftResp, err := suite.queryClient.ONFT(suite.Ctx, &types.QueryONFTRequest{DenomId: defaultDenomId, Id: "onft1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(lockedUntil, *onftResp.ONFT.TransferLockedUntil)
+
+	transfer := func(ctx sdk.Context, id string) error {
+		_, err := suite.msgServer.TransferONFT(ctx,
+			types.NewMsgTransferONFT(id, defaultDenomId, holder.String(), creator.String()))
+		return err
+	}
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	suite.Require().ErrorIs(transfer(cacheCtx, "onft1"), types.ErrTransferLocked)
+	cacheCtx, _ = suite.Ctx.WithBlockTime(lockedUntil).CacheContext()
+	suite.Require().NoError(transfer(cacheCtx, "onft1"))
+
+	// escrow an nft in the module account before the denom is frozen
+	suite.Require().NoError(suite.App.ONFTKeeper.TransferOwnership(suite.Ctx, defaultDenomId, "onft2", holder, moduleAddr))
+
+	// only the denom creator can freeze
+	_, err = suite.msgServer.FreezeDenom(suite.Ctx, types.NewMsgFreezeDenom(defaultDenomId, holder.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.FreezeDenom(suite.Ctx, types.NewMsgFreezeDenom(defaultDenomId, creator.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeFreezeDenom, 1)
+	_, err = suite.msgServer.FreezeDenom(suite.Ctx, types.NewMsgFreezeDenom(defaultDenomId, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrDenomFrozen)
+
+	denomResp, err := suite.queryClient.Denom(suite.Ctx, &types.QueryDenomRequest{DenomId: defaultDenomId})
+	suite.Require().NoError(err)
+	suite.Require().True(denomResp.Denom.Frozen)
+
+	ctx := suite.Ctx.WithBlockTime(lockedUntil)
+	suite.Require().ErrorIs(transfer(ctx, "onft1"), types.ErrDenomFrozen)
+	// escrowed nfts can still be returned by the module
+	suite.Require().NoError(suite.App.ONFTKeeper.TransferOwnership(ctx, defaultDenomId, "onft2", moduleAddr, holder))
+
+	_, err = suite.msgServer.UnfreezeDenom(ctx, types.NewMsgUnfreezeDenom(defaultDenomId, creator.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.UnfreezeDenom(ctx, types.NewMsgUnfreezeDenom(defaultDenomId, creator.String()))
+	suite.Require().ErrorIs(err, types.ErrDenomNotFrozen)
+	suite.Require().NoError(transfer(ctx, "onft1"))
+	suite.Require().NoError(transfer(ctx, "onft2"))
+}
+
+func (suite *KeeperTestSuite) TestNestONFT() {
+	creator := suite.TestAccs[0]
+	owner := suite.TestAccs[1]
+	other := suite.TestAccs[2]
+	suite.createDefaultDenom(creator)
+	for i := 0; i < 8; i++ {
+		suite.mintONFT(defaultDenomId, fmt.Sprintf("onft%d", i), creator, owner)
+	}
+	nest := func(id, parentId string, sender sdk.AccAddress) error {
+		msg := types.NewMsgNestONFT(defaultDenomId, id, defaultDenomId, parentId, sender.String())
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := suite.msgServer.NestONFT(suite.Ctx, msg)
+		return err
+	}
+	ownerOf := func(id string) sdk.AccAddress {
+		onft, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, id)
+		suite.Require().NoError(err)
+		return onft.GetOwner()
+	}
+
+	suite.Require().ErrorIs(nest("onft1", "onft1", owner), types.ErrInvalidNesting)
+	suite.Require().Error(nest("onft1", "onft0", other))
+
+	suite.Require().NoError(nest("onft1", "onft0", owner))
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeNestONFT, 1)
+	suite.Require().Equal(types.NestAddress(defaultDenomId, "onft0"), ownerOf("onft1"))
+	resp, err := suite.queryClient.Children(suite.Ctx, &types.QueryChildrenRequest{DenomId: defaultDenomId, OnftId: "onft0"})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Children, 1)
+	suite.Require().Equal("onft1", resp.Children[0].OnftId)
+
+	// a parent can't be nested in its own children
+	suite.Require().ErrorIs(nest("onft0", "onft1", owner), types.ErrInvalidNesting)
+	// nested nfts can't be transferred or parents with children burned directly
+	_, err = suite.msgServer.TransferONFT(suite.Ctx,
+		types.NewMsgTransferONFT("onft1", defaultDenomId, owner.String(), other.String()))
+	suite.Require().Error(err)
+	_, err = suite.msgServer.BurnONFT(suite.Ctx, types.NewMsgBurnONFT(defaultDenomId, "onft0", owner.String()))
+	suite.Require().ErrorIs(err, types.ErrHasChildren)
+
+	// children move with the parent
+	_, err = suite.msgServer.TransferONFT(suite.Ctx,
+		types.NewMsgTransferONFT("onft0", defaultDenomId, owner.String(), other.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(other, suite.App.ONFTKeeper.GetRootOwner(suite.Ctx, defaultDenomId, "onft1"))
+	_, err = suite.msgServer.UnnestONFT(suite.Ctx, types.NewMsgUnnestONFT(defaultDenomId, "onft1", owner.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.UnnestONFT(suite.Ctx, types.NewMsgUnnestONFT(defaultDenomId, "onft1", other.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(other, ownerOf("onft1"))
+	_, err = suite.msgServer.UnnestONFT(suite.Ctx, types.NewMsgUnnestONFT(defaultDenomId, "onft1", other.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidNesting)
+
+	// nest onft3..onft7 in a chain under onft2
+	for i := 3; i < 3+types.MaxNestingDepth; i++ {
+		suite.Require().NoError(nest(fmt.Sprintf("onft%d", i), fmt.Sprintf("onft%d", i-1), owner))
+	}
+	suite.Require().Equal(owner, suite.App.ONFTKeeper.GetRootOwner(suite.Ctx, defaultDenomId, "onft7"))
+	suite.Require().ErrorIs(nest("onft2", "onft0", other), types.ErrUnauthorized)
+	_, err = suite.msgServer.TransferONFT(suite.Ctx,
+		types.NewMsgTransferONFT("onft0", defaultDenomId, other.String(), owner.String()))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(nest("onft2", "onft0", owner), types.ErrMaxNestingDepth)
+
+	_, broken := keeper.NestingInvariant(suite.App.ONFTKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+
+	genesis := onft.ExportGenesis(suite.Ctx, suite.App.ONFTKeeper)
+	suite.Require().Len(genesis.Nestings, types.MaxNestingDepth)
+}
+
+func (suite *KeeperTestSuite) TestEditions() {
+	creator := suite.TestAccs[0]
+	owner := suite.TestAccs[1]
+	other := suite.TestAccs[2]
+	suite.createDefaultDenom(creator)
+	suite.mintONFT(defaultDenomId, "master", creator, owner)
+	printEdition := func(id string, sender sdk.AccAddress) (uint64, error) {
+		msg := types.NewMsgPrintEdition(defaultDenomId, "master", id, other.String(), sender.String())
+		if err := msg.ValidateBasic(); err != nil {
+			return 0, err
+		}
+		resp, err := suite.msgServer.PrintEdition(suite.Ctx, msg)
+		if err != nil {
+			return 0, err
+		}
+		return resp.Number, nil
+	}
+
+	_, err := printEdition("print1", owner)
+	suite.Require().ErrorIs(err, types.ErrInvalidEdition)
+	_, err = suite.msgServer.CreateEditions(suite.Ctx,
+		types.NewMsgCreateEditions(defaultDenomId, "master", 2, other.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.CreateEditions(suite.Ctx,
+		types.NewMsgCreateEditions(defaultDenomId, "master", 2, owner.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypeCreateEditions, 1)
+	// the cap can only be set once
+	_, err = suite.msgServer.CreateEditions(suite.Ctx,
+		types.NewMsgCreateEditions(defaultDenomId, "master", 5, owner.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidEdition)
+
+	_, err = printEdition("print1", other)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = printEdition("master", owner)
+	suite.Require().ErrorIs(err, types.ErrInvalidEdition)
+	number, err := printEdition("print1", owner)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), number)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypePrintEdition, 1)
+
+	// prints inherit the media and royalties of the master
+	master, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, "master")
+	suite.Require().NoError(err)
+	edition, err := suite.App.ONFTKeeper.GetONFT(suite.Ctx, defaultDenomId, "print1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(other, edition.GetOwner())
+	suite.Require().Equal(master.GetMediaURI(), edition.GetMediaURI())
+	suite.Require().Equal(master.GetName(), edition.GetName())
+	suite.Require().Equal(master.GetRoyaltyShare(), edition.GetRoyaltyShare())
+
+	// a print can't be the master of other editions
+	_, err = suite.msgServer.CreateEditions(suite.Ctx,
+		types.NewMsgCreateEditions(defaultDenomId, "print1", 2, other.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidEdition)
+
+	number, err = printEdition("print2", owner)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), number)
+	_, err = printEdition("print3", owner)
+	suite.Require().ErrorIs(err, types.ErrMaxEditionsReached)
+
+	// burned editions are removed but their numbers are not reissued
+	_, err = suite.msgServer.BurnONFT(suite.Ctx, types.NewMsgBurnONFT(defaultDenomId, "print1", other.String()))
+	suite.Require().NoError(err)
+	_, err = printEdition("print3", owner)
+	suite.Require().ErrorIs(err, types.ErrMaxEditionsReached)
+
+	resp, err := suite.queryClient.Editions(suite.Ctx, &types.QueryEditionsRequest{DenomId: defaultDenomId, MasterId: "master"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), resp.EditionSet.Printed)
+	suite.Require().Len(resp.Editions, 1)
+	suite.Require().Equal("print2", resp.Editions[0].OnftId)
+	suite.Require().Equal(uint64(2), resp.Editions[0].Number)
+
+	_, broken := keeper.EditionsInvariant(suite.App.ONFTKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+
+	genesis := onft.ExportGenesis(suite.Ctx, suite.App.ONFTKeeper)
+	suite.Require().Len(genesis.EditionSets, 1)
+	suite.Require().Len(genesis.Editions, 1)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+}
+
+func (suite *KeeperTestSuite) TestTraitIndex() {
+	creator := suite.TestAccs[0]
+	createMsg := types.NewMsgCreateDenom(defaultDenomSymbol, "traits", "",
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, true)
+	createMsg.Id = defaultDenomId
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+
+	mint := func(id, data string) error {
+		msg := types.NewMsgMintONFT(defaultDenomId, creator.String(), creator.String(),
+			types.Metadata{Name: id, MediaURI: "ipfs://" + id}, data, true, true, false, sdkmath.LegacyZeroDec(), nil, nil)
+		msg.Id = id
+		_, err := suite.msgServer.MintONFT(suite.Ctx, msg)
+		return err
+	}
+	byTrait := func(traitType, value string) []string {
+		resp, err := suite.queryClient.ONFTsByTrait(suite.Ctx, &types.QueryONFTsByTraitRequest{
+			DenomId: defaultDenomId, TraitType: traitType, Value: value,
+		})
+		suite.Require().NoError(err)
+		var ids []string
+		for _, onft := range resp.ONFTs {
+			ids = append(ids, onft.Id)
+		}
+		return ids
+	}
+
+	suite.Require().NoError(mint("onft1", `{"attributes": [{"trait_type": "color", "value": "red"}, {"trait_type": "level", "value": 5}]}`))
+	suite.Require().NoError(mint("onft2", `{"attributes": [{"trait_type": "color", "value": "red"}, {"trait_type": "color", "value": "red"}]}`))
+	suite.Require().NoError(mint("onft3", `{"attributes": [{"trait_type": "color", "value": "blue"}, {"value": "untyped"}]}`))
+	suite.Require().NoError(mint("onft4", "not json"))
+	tooLong := fmt.Sprintf(`{"attributes": [{"trait_type": "color", "value": "%s"}]}`, strings.Repeat("a", types.MaxTraitLen+1))
+	suite.Require().ErrorIs(mint("onft5", tooLong), types.ErrInvalidTraits)
+
+	suite.Require().Equal([]string{"onft1", "onft2"}, byTrait("color", "red"))
+	suite.Require().Equal([]string{"onft1"}, byTrait("level", "5"))
+	suite.Require().Empty(byTrait("color", "green"))
+
+	// the index follows data updates and burns
+	_, err = suite.msgServer.UpdateONFTData(suite.Ctx, types.NewMsgUpdateONFTData(defaultDenomId, "onft2",
+		`{"attributes": [{"trait_type": "color", "value": "blue"}]}`, creator.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"onft1"}, byTrait("color", "red"))
+	suite.Require().Equal([]string{"onft2", "onft3"}, byTrait("color", "blue"))
+	_, err = suite.msgServer.BurnONFT(suite.Ctx, types.NewMsgBurnONFT(defaultDenomId, "onft1", creator.String()))
+	suite.Require().NoError(err)
+	suite.Require().Empty(byTrait("color", "red"))
+
+	summary, err := suite.queryClient.TraitSummary(suite.Ctx, &types.QueryTraitSummaryRequest{DenomId: defaultDenomId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), summary.TotalSupply)
+	suite.Require().Equal([]types.TraitCount{{TraitType: "color", Value: "blue", Count: 2}}, summary.Traits)
+
+	// denoms that don't opt in are not indexed
+	createMsg = types.NewMsgCreateDenom("untraited", "untraited", "",
+		"", "", "", "", "", creator.String(), types.DefaultDenomCreationFee, nil, true, 0, false, false)
+	createMsg.Id = "onftdenomuntraited"
+	_, err = suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+	_, err = suite.queryClient.TraitSummary(suite.Ctx, &types.QueryTraitSummaryRequest{DenomId: createMsg.Id})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestONFTHistory() {
+	creator := suite.TestAccs[0]
+	first := suite.TestAccs[1]
+	second := suite.TestAccs[2]
+	params := suite.App.ONFTKeeper.GetParams(suite.Ctx)
+	params.OwnershipHistoryRetention = 2
+	suite.Require().NoError(suite.App.ONFTKeeper.SetParams(suite.Ctx, params))
+
+	suite.createDefaultDenom(creator)
+	suite.mintONFT(defaultDenomId, "onft1", creator, creator)
+
+	transfers := []struct {
+		from, to sdk.AccAddress
+	}{
+		{creator, first},
+		{first, second},
+		{second, creator},
+	}
+	for _, transfer := range transfers {
+		_, err := suite.msgServer.TransferONFT(suite.Ctx,
+			types.NewMsgTransferONFT("onft1", defaultDenomId, transfer.from.String(), transfer.to.String()))
+		suite.Require().NoError(err)
+	}
+
+	// records beyond retention are pruned
+	resp, err := suite.queryClient.ONFTHistory(suite.Ctx, &types.QueryONFTHistoryRequest{
+		DenomId: defaultDenomId,
+		OnftId:  "onft1",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.History, 2)
+	suite.Require().Equal(uint64(2), resp.History[0].Sequence)
+	suite.Require().Equal(first.String(), resp.History[0].From)
+	suite.Require().Equal(second.String(), resp.History[0].To)
+	suite.Require().Equal(uint64(3), resp.History[1].Sequence)
+	suite.Require().Equal(creator.String(), resp.History[1].To)
+	suite.Require().Equal(types.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_TRANSFER, resp.History[1].Cause)
+	suite.Require().Equal(suite.Ctx.BlockHeight(), resp.History[1].Height)
+
+	// transfers by an approved operator record the previous owner
+	_, err = suite.msgServer.Approve(suite.Ctx,
+		types.NewMsgApprove(defaultDenomId, "onft1", first.String(), nil, creator.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.TransferONFT(suite.Ctx,
+		types.NewMsgTransferONFT("onft1", defaultDenomId, first.String(), second.String()))
+	suite.Require().NoError(err)
+	history := suite.App.ONFTKeeper.GetOwnershipHistory(suite.Ctx, defaultDenomId, "onft1")
+	suite.Require().Len(history, 2)
+	suite.Require().Equal(creator.String(), history[1].From)
+	suite.Require().Equal(second.String(), history[1].To)
+
+	_, err = suite.msgServer.BurnONFT(suite.Ctx, types.NewMsgBurnONFT(defaultDenomId, "onft1", second.String()))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.ONFTKeeper.GetOwnershipHistory(suite.Ctx, defaultDenomId, "onft1"))
+}
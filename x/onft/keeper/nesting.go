@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// NestONFT transfers an onft of the sender to the nest address of a parent onft,
+// the sender must be the owner of the root of the parent
+func (k Keeper) NestONFT(ctx sdk.Context, denomID, onftID, parentDenomID, parentID string, sender sdk.AccAddress) error {
+	if !k.HasONFT(ctx, denomID, onftID) {
+		return errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", onftID)
+	}
+	if !k.HasONFT(ctx, parentDenomID, parentID) {
+		return errorsmod.Wrapf(types.ErrInvalidONFT, "parent nft ID %s not exists", parentID)
+	}
+	nesting := types.Nesting{
+		ParentDenomId: parentDenomID,
+		ParentId:      parentID,
+		DenomId:       denomID,
+		OnftId:        onftID,
+	}
+	if err := nesting.Validate(); err != nil {
+		return err
+	}
+	if err := k.Authorize(ctx, denomID, onftID, sender); err != nil {
+		return err
+	}
+	if !sender.Equals(k.GetRootOwner(ctx, parentDenomID, parentID)) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not the owner of parent nft %s", sender, parentID,
+		)
+	}
+
+	// the parent can't be nested in the onft or one of its children
+	depth := 1
+	current := nesting
+	for {
+		if current.ParentDenomId == denomID && current.ParentId == onftID {
+			return errorsmod.Wrapf(types.ErrInvalidNesting, "nft %s is an ancestor of parent nft %s", onftID, parentID)
+		}
+		parent, found := k.GetNesting(ctx, current.ParentDenomId, current.ParentId)
+		if !found {
+			break
+		}
+		depth++
+		current = parent
+	}
+	depth += k.nestingHeight(ctx, denomID, onftID)
+	if depth > types.MaxNestingDepth {
+		return errorsmod.Wrapf(
+			types.ErrMaxNestingDepth,
+			"nesting depth %d exceeds the max depth %d", depth, types.MaxNestingDepth,
+		)
+	}
+
+	if err := k.TransferOwnership(ctx, denomID, onftID, sender, types.NestAddress(parentDenomID, parentID)); err != nil {
+		return err
+	}
+	k.SetNesting(ctx, nesting)
+	k.emitNestONFTEvent(ctx, nesting, sender.String())
+	return nil
+}
+
+// UnnestONFT transfers a nested onft from the nest address of its parent to the sender,
+// the sender must be the owner of the root of the parent
+func (k Keeper) UnnestONFT(ctx sdk.Context, denomID, onftID string, sender sdk.AccAddress) error {
+	nesting, found := k.GetNesting(ctx, denomID, onftID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidNesting, "nft %s is not nested", onftID)
+	}
+	if !sender.Equals(k.GetRootOwner(ctx, nesting.ParentDenomId, nesting.ParentId)) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not the owner of parent nft %s", sender, nesting.ParentId,
+		)
+	}
+	nestAddr := types.NestAddress(nesting.ParentDenomId, nesting.ParentId)
+	if err := k.TransferOwnership(ctx, denomID, onftID, nestAddr, sender); err != nil {
+		return err
+	}
+	k.DeleteNesting(ctx, nesting)
+	k.emitUnnestONFTEvent(ctx, nesting, sender.String())
+	return nil
+}
+
+// GetRootOwner returns the owner of the top level parent of an onft,
+// or the owner of the onft if it is not nested
+func (k Keeper) GetRootOwner(ctx sdk.Context, denomID, onftID string) sdk.AccAddress {
+	for {
+		nesting, found := k.GetNesting(ctx, denomID, onftID)
+		if !found {
+			return k.nk.GetOwner(ctx, denomID, onftID)
+		}
+		denomID, onftID = nesting.ParentDenomId, nesting.ParentId
+	}
+}
+
+// nestingHeight returns the number of levels of onfts nested below an onft
+func (k Keeper) nestingHeight(ctx sdk.Context, denomID, onftID string) int {
+	height := 0
+	for _, child := range k.GetChildren(ctx, denomID, onftID) {
+		if h := k.nestingHeight(ctx, child.DenomId, child.OnftId) + 1; h > height {
+			height = h
+		}
+	}
+	return height
+}
+
+// HasChildren returns true if any onft is nested in the onft
+func (k Keeper) HasChildren(ctx sdk.Context, denomID, onftID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyNestChildrenPrefix(denomID, onftID))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+func (k Keeper) SetNesting(ctx sdk.Context, nesting types.Nesting) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&nesting)
+	store.Set(types.KeyNestParent(nesting.DenomId, nesting.OnftId), bz)
+	store.Set(types.KeyNestChild(nesting.ParentDenomId, nesting.ParentId, nesting.DenomId, nesting.OnftId), bz)
+}
+
+// GetNesting returns the nesting of an onft in its parent
+func (k Keeper) GetNesting(ctx sdk.Context, denomID, onftID string) (nesting types.Nesting, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNestParent(denomID, onftID))
+	if bz == nil {
+		return nesting, false
+	}
+	k.cdc.MustUnmarshal(bz, &nesting)
+	return nesting, true
+}
+
+func (k Keeper) DeleteNesting(ctx sdk.Context, nesting types.Nesting) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyNestParent(nesting.DenomId, nesting.OnftId))
+	store.Delete(types.KeyNestChild(nesting.ParentDenomId, nesting.ParentId, nesting.DenomId, nesting.OnftId))
+}
+
+// GetChildren returns the onfts nested directly in an onft
+func (k Keeper) GetChildren(ctx sdk.Context, denomID, onftID string) (children []types.Nesting) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyNestChildrenPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nesting types.Nesting
+		k.cdc.MustUnmarshal(iterator.Value(), &nesting)
+		children = append(children, nesting)
+	}
+	return children
+}
+
+// GetAllNestings returns the nestings of all onfts
+func (k Keeper) GetAllNestings(ctx sdk.Context) (nestings []types.Nesting) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixNestParent)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nesting types.Nesting
+		k.cdc.MustUnmarshal(iterator.Value(), &nesting)
+		nestings = append(nestings, nesting)
+	}
+	return nestings
+}
@@ -28,18 +28,71 @@ func (k Keeper) MintONFT(
 	extensible,
 	nsfw bool,
 	royaltyShare sdkmath.LegacyDec,
+	royaltyReceivers []*types.WeightedAddress,
+	transferLockedUntil *time.Time,
+	receiver sdk.AccAddress,
+) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if denom.MintingClosed {
+		return errorsmod.Wrapf(types.ErrMintingClosed, "minting is closed for denom %s", denomID)
+	}
+	if denom.MaxSupply > 0 && k.GetTotalSupply(ctx, denomID) >= denom.MaxSupply {
+		return errorsmod.Wrapf(
+			types.ErrMaxSupplyReached,
+			"denom %s reached its max supply %d", denomID, denom.MaxSupply,
+		)
+	}
+	if err := types.ValidateDataSchema(denom.Schema, nftData, ctx.GasMeter()); err != nil {
+		return err
+	}
+	var traits []types.Trait
+	if denom.IndexedTraits {
+		if traits, err = types.ParseTraits(nftData); err != nil {
+			return err
+		}
+	}
+	return k.mintONFT(ctx, denomID, nftID, name, description, mediaURI, uriHash, previewURI, nftData,
+		createdAt, transferable, extensible, nsfw, royaltyShare, royaltyReceivers, transferLockedUntil,
+		traits, receiver)
+}
+
+// mintONFT stores an onft and indexes its traits without checking the mint rules of the
+// denom or the data schema, genesis import uses it directly for onfts minted under earlier rules
+func (k Keeper) mintONFT(
+	ctx sdk.Context,
+	denomID,
+	nftID,
+	name,
+	description,
+	mediaURI,
+	uriHash,
+	previewURI,
+	nftData string,
+	createdAt time.Time,
+	transferable,
+	extensible,
+	nsfw bool,
+	royaltyShare sdkmath.LegacyDec,
+	royaltyReceivers []*types.WeightedAddress,
+	transferLockedUntil *time.Time,
+	traits []types.Trait,
 	receiver sdk.AccAddress,
 ) error {
 	nftMetadata := &types.ONFTMetadata{
-		Name:         name,
-		Description:  description,
-		PreviewURI:   previewURI,
-		Data:         nftData,
-		Transferable: transferable,
-		Extensible:   extensible,
-		Nsfw:         nsfw,
-		CreatedAt:    createdAt,
-		RoyaltyShare: royaltyShare,
+		Name:                name,
+		Description:         description,
+		PreviewURI:          previewURI,
+		Data:                nftData,
+		Transferable:        transferable,
+		Extensible:          extensible,
+		Nsfw:                nsfw,
+		CreatedAt:           createdAt,
+		RoyaltyShare:        royaltyShare,
+		RoyaltyReceivers:    royaltyReceivers,
+		TransferLockedUntil: transferLockedUntil,
 	}
 	data, err := codectypes.NewAnyWithValue(nftMetadata)
 	if err != nil {
@@ -55,11 +108,31 @@ func (k Keeper) MintONFT(
 	if err != nil {
 		return err
 	}
+	k.indexTraits(ctx, denomID, nftID, traits)
 	k.emitMintONFTEvent(ctx, nftID, denomID, mediaURI, receiver.String())
 	return nil
 }
 
 func (k Keeper) TransferOwnership(ctx sdk.Context, denomID, onftID string, srcOwner, dstOwner sdk.AccAddress) error {
+	return k.TransferOwnershipWithCause(
+		ctx,
+		denomID,
+		onftID,
+		srcOwner,
+		dstOwner,
+		types.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_TRANSFER,
+	)
+}
+
+// TransferOwnershipWithCause transfers an onft and records the cause in its ownership history
+func (k Keeper) TransferOwnershipWithCause(
+	ctx sdk.Context,
+	denomID,
+	onftID string,
+	srcOwner,
+	dstOwner sdk.AccAddress,
+	cause types.OwnershipChangeCause,
+) error {
 	if !k.nk.HasClass(ctx, denomID) {
 		return errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
 	}
@@ -80,10 +153,23 @@ func (k Keeper) TransferOwnership(ctx sdk.Context, denomID, onftID string, srcOw
 	if !onftMetadata.Transferable {
 		return errorsmod.Wrap(types.ErrNotTransferable, onft.GetId())
 	}
+	// returns from module escrow (delisting, cancelled or unsold auctions, ended campaigns)
+	// are not restricted so that escrowed nfts can always go back to their owners, sales
+	// and claims out of escrow are
+	if !k.isModuleAccount(ctx, srcOwner) || cause != types.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_TRANSFER {
+		if err := k.ValidateTransferRestrictions(ctx, denomID, onftID); err != nil {
+			return err
+		}
+	}
+	owner := k.nk.GetOwner(ctx, denomID, onftID)
 	err = k.nk.Transfer(ctx, denomID, onftID, dstOwner)
 	if err != nil {
 		return err
 	}
+	k.RecordOwnershipChange(ctx, denomID, onftID, owner, dstOwner, cause)
+	// approvals and the user role are only valid for the current owner
+	k.DeleteApprovals(ctx, denomID, onftID)
+	k.DeleteONFTUser(ctx, denomID, onftID)
 	k.emitTransferONFTEvent(ctx, onftID, denomID, srcOwner.String(), dstOwner.String())
 	return nil
 }
@@ -106,52 +192,102 @@ func (k Keeper) BurnONFT(
 	if err != nil {
 		return err
 	}
+	if k.HasChildren(ctx, denomID, onftID) {
+		return errorsmod.Wrapf(types.ErrHasChildren, "nft %s has nested nfts, unnest them before burning", onftID)
+	}
 
+	k.UnindexONFTTraits(ctx, denomID, onftID)
 	err = k.nk.Burn(ctx, denomID, onftID)
 	if err != nil {
 		return err
 	}
+	k.DeleteApprovals(ctx, denomID, onftID)
+	k.DeleteONFTUser(ctx, denomID, onftID)
+	k.DeleteDataHistory(ctx, denomID, onftID)
+	k.DeleteOwnershipHistory(ctx, denomID, onftID)
+	k.DeleteEdition(ctx, denomID, onftID)
 	k.emitBurnONFTEvent(ctx, onftID, denomID, owner.String())
 	return nil
 }
 
-func (k Keeper) UpdateONFTData(ctx sdk.Context, denomID, onftID, data string) error {
+func (k Keeper) UpdateONFTData(ctx sdk.Context, denomID, onftID, data string, updater sdk.AccAddress) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if err := types.ValidateDataSchema(denom.Schema, data, ctx.GasMeter()); err != nil {
+		return err
+	}
+	var traits []types.Trait
+	if denom.IndexedTraits {
+		if traits, err = types.ParseTraits(data); err != nil {
+			return err
+		}
+	}
+	var prevData string
+	if err := k.updateONFTMetadata(ctx, denomID, onftID, func(metadata *types.ONFTMetadata) {
+		prevData = metadata.Data
+		metadata.Data = data
+	}); err != nil {
+		return err
+	}
+	if prevData != data {
+		k.recordDataVersion(ctx, denom, onftID, prevData, updater)
+		if denom.IndexedTraits {
+			k.reindexTraits(ctx, denomID, onftID, prevData, traits)
+		}
+	}
+	return nil
+}
+
+// UpdateONFTRoyaltyReceivers updates the royalty receivers of an onft,
+// only the denom creator is allowed to update them
+func (k Keeper) UpdateONFTRoyaltyReceivers(
+	ctx sdk.Context,
+	denomID,
+	onftID string,
+	royaltyReceivers []*types.WeightedAddress,
+	sender sdk.AccAddress,
+) error {
+	if err := k.AuthorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+	if err := k.updateONFTMetadata(ctx, denomID, onftID, func(metadata *types.ONFTMetadata) {
+		metadata.RoyaltyReceivers = royaltyReceivers
+	}); err != nil {
+		return err
+	}
+	k.emitUpdateONFTRoyaltyReceiversEvent(ctx, onftID, denomID, royaltyReceivers)
+	return nil
+}
+
+// updateONFTMetadata applies update to the stored metadata of an onft
+func (k Keeper) updateONFTMetadata(
+	ctx sdk.Context,
+	denomID,
+	onftID string,
+	update func(metadata *types.ONFTMetadata),
+) error {
 	if !k.nk.HasClass(ctx, denomID) {
 		return errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
 	}
-	if !k.nk.HasNFT(ctx, denomID, onftID) {
+	_nft, exist := k.nk.GetNFT(ctx, denomID, onftID)
+	if !exist {
 		return errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", onftID)
 	}
-	_nft, err := k.GetONFT(ctx, denomID, onftID)
+	nftMetadata, err := types.UnmarshalNFTMetadata(k.cdc, _nft.Data.GetValue())
 	if err != nil {
 		return err
 	}
+	update(&nftMetadata)
 
-	nftMetadata := &types.ONFTMetadata{
-		Name:         _nft.GetName(),
-		Description:  _nft.GetDescription(),
-		PreviewURI:   _nft.GetPreviewURI(),
-		Data:         data,
-		Transferable: _nft.IsTransferable(),
-		Extensible:   _nft.IsExtensible(),
-		Nsfw:         _nft.IsNSFW(),
-		CreatedAt:    _nft.GetCreatedTime(),
-		RoyaltyShare: _nft.GetRoyaltyShare(),
-	}
-
-	newData, err := codectypes.NewAnyWithValue(nftMetadata)
+	newData, err := codectypes.NewAnyWithValue(&nftMetadata)
 	if err != nil {
 		return err
 	}
-	updatedNFT := nft.NFT{
-		ClassId: denomID,
-		Id:      onftID,
-		Uri:     _nft.GetMediaURI(),
-		UriHash: _nft.GetURIHash(),
-		Data:    newData,
-	}
+	_nft.Data = newData
 
-	return k.nk.Update(ctx, updatedNFT)
+	return k.nk.Update(ctx, _nft)
 }
 
 func (k Keeper) GetONFT(ctx sdk.Context, denomID, onftID string) (nft exported.ONFTI, err error) {
@@ -177,15 +313,17 @@ func (k Keeper) GetONFT(ctx sdk.Context, denomID, onftID string) (nft exported.O
 		UriHash:     onft.UriHash,
 	}
 	return types.ONFT{
-		Id:           onft.Id,
-		Metadata:     metadata,
-		Data:         nftMetadata.Data,
-		Owner:        owner.String(),
-		Transferable: nftMetadata.Transferable,
-		Extensible:   nftMetadata.Extensible,
-		Nsfw:         nftMetadata.Nsfw,
-		CreatedAt:    nftMetadata.CreatedAt,
-		RoyaltyShare: nftMetadata.RoyaltyShare,
+		Id:                  onft.Id,
+		Metadata:            metadata,
+		Data:                nftMetadata.Data,
+		Owner:               owner.String(),
+		Transferable:        nftMetadata.Transferable,
+		Extensible:          nftMetadata.Extensible,
+		Nsfw:                nftMetadata.Nsfw,
+		CreatedAt:           nftMetadata.CreatedAt,
+		RoyaltyShare:        nftMetadata.RoyaltyShare,
+		RoyaltyReceivers:    nftMetadata.RoyaltyReceivers,
+		TransferLockedUntil: nftMetadata.TransferLockedUntil,
 	}, nil
 }
 
@@ -207,15 +345,17 @@ func (k Keeper) GetONFTs(ctx sdk.Context, denomID string) (onfts []exported.ONFT
 			UriHash:     _nft.UriHash,
 		}
 		onfts = append(onfts, types.ONFT{
-			Id:           _nft.GetId(),
-			Metadata:     metadata,
-			Data:         nftMetadata.Data,
-			Owner:        owner.String(),
-			Transferable: nftMetadata.Transferable,
-			Extensible:   nftMetadata.Extensible,
-			Nsfw:         nftMetadata.Nsfw,
-			CreatedAt:    nftMetadata.CreatedAt,
-			RoyaltyShare: nftMetadata.RoyaltyShare,
+			Id:                  _nft.GetId(),
+			Metadata:            metadata,
+			Data:                nftMetadata.Data,
+			Owner:               owner.String(),
+			Transferable:        nftMetadata.Transferable,
+			Extensible:          nftMetadata.Extensible,
+			Nsfw:                nftMetadata.Nsfw,
+			CreatedAt:           nftMetadata.CreatedAt,
+			RoyaltyShare:        nftMetadata.RoyaltyShare,
+			RoyaltyReceivers:    nftMetadata.RoyaltyReceivers,
+			TransferLockedUntil: nftMetadata.TransferLockedUntil,
 		})
 	}
 	return onfts, nil
@@ -239,15 +379,17 @@ func (k Keeper) GetOwnerONFTs(ctx sdk.Context, denomID string, owner sdk.AccAddr
 			UriHash:     _nft.UriHash,
 		}
 		onfts = append(onfts, types.ONFT{
-			Id:           _nft.GetId(),
-			Metadata:     metadata,
-			Data:         nftMetadata.Data,
-			Owner:        owner.String(),
-			Transferable: nftMetadata.Transferable,
-			Extensible:   nftMetadata.Extensible,
-			Nsfw:         nftMetadata.Nsfw,
-			CreatedAt:    nftMetadata.CreatedAt,
-			RoyaltyShare: nftMetadata.RoyaltyShare,
+			Id:                  _nft.GetId(),
+			Metadata:            metadata,
+			Data:                nftMetadata.Data,
+			Owner:               owner.String(),
+			Transferable:        nftMetadata.Transferable,
+			Extensible:          nftMetadata.Extensible,
+			Nsfw:                nftMetadata.Nsfw,
+			CreatedAt:           nftMetadata.CreatedAt,
+			RoyaltyShare:        nftMetadata.RoyaltyShare,
+			RoyaltyReceivers:    nftMetadata.RoyaltyReceivers,
+			TransferLockedUntil: nftMetadata.TransferLockedUntil,
 		})
 	}
 	return onfts, nil
@@ -265,6 +407,47 @@ func (k Keeper) Authorize(
 	return nil
 }
 
+// ValidateTransferRestrictions returns an error if the denom of the nft is frozen
+// or the transfer lock of the nft has not passed
+func (k Keeper) ValidateTransferRestrictions(ctx sdk.Context, denomID, onftID string) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if denom.Frozen {
+		return errorsmod.Wrapf(types.ErrDenomFrozen, "denom %s is frozen", denomID)
+	}
+	onft, exist := k.nk.GetNFT(ctx, denomID, onftID)
+	if !exist {
+		return errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", onftID)
+	}
+	onftMetadata, err := types.UnmarshalNFTMetadata(k.cdc, onft.Data.GetValue())
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidONFTMetadata, "unable to parse nft metadata")
+	}
+	if onftMetadata.IsTransferLocked(ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			types.ErrTransferLocked,
+			"nft %s is locked until %s", onftID, onftMetadata.TransferLockedUntil.UTC().Format(time.RFC3339),
+		)
+	}
+	return nil
+}
+
+// isModuleAccount returns true if the address belongs to a module, the account
+// of a module may not exist in state until it is used
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if _, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return true
+	}
+	for _, perms := range k.accountKeeper.GetModulePermissions() {
+		if perms.GetAddress().Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func (k Keeper) HasONFT(ctx sdk.Context, denomID, onftID string) bool {
 	return k.nk.HasNFT(ctx, denomID, onftID)
 }
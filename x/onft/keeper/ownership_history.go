@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// RecordOwnershipChange stores a change of the owner of an onft as the next record of
+// its ownership history and prunes the records beyond the retention limit, from is nil
+// for onfts received over IBC
+func (k Keeper) RecordOwnershipChange(
+	ctx sdk.Context,
+	denomID,
+	onftID string,
+	from,
+	to sdk.AccAddress,
+	cause types.OwnershipChangeCause,
+) {
+	retention := k.GetParams(ctx).OwnershipHistoryRetention
+	if retention == 0 {
+		return
+	}
+	sequence := k.latestOwnershipSequence(ctx, denomID, onftID) + 1
+	k.SetOwnershipRecord(ctx, types.NewOwnershipRecord(
+		denomID,
+		onftID,
+		sequence,
+		from,
+		to,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		cause,
+	))
+	k.pruneOwnershipHistory(ctx, denomID, onftID, retention)
+}
+
+// latestOwnershipSequence returns the latest sequence in the ownership history of an onft, 0 if there is none
+func (k Keeper) latestOwnershipSequence(ctx sdk.Context, denomID, onftID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyOwnershipHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	var record types.OwnershipRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record.Sequence
+}
+
+// pruneOwnershipHistory deletes the oldest records of an onft ownership history beyond retention
+func (k Keeper) pruneOwnershipHistory(ctx sdk.Context, denomID, onftID string, retention uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyOwnershipHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	var (
+		kept  uint64
+		stale [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if kept < retention {
+			kept++
+			continue
+		}
+		stale = append(stale, iterator.Key())
+	}
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetOwnershipRecord(ctx sdk.Context, record types.OwnershipRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.KeyOwnershipRecord(record.DenomId, record.OnftId, record.Sequence), bz)
+}
+
+// GetOwnershipHistory returns the ownership history of an onft ordered by sequence
+func (k Keeper) GetOwnershipHistory(ctx sdk.Context, denomID, onftID string) (records []types.OwnershipRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyOwnershipHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.OwnershipRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// DeleteOwnershipHistory deletes the ownership history of an onft
+func (k Keeper) DeleteOwnershipHistory(ctx sdk.Context, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyOwnershipHistoryPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllOwnershipRecords returns the ownership history of all onfts
+func (k Keeper) GetAllOwnershipRecords(ctx sdk.Context) (records []types.OwnershipRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixOwnershipHistory)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.OwnershipRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// RevokeONFT burns an onft of a revocable denom held by any account, or transfers it
+// to the denom creator if reclaim is set, and records the revocation with its reason
+func (k Keeper) RevokeONFT(ctx sdk.Context, denomID, onftID, reason string, reclaim bool, sender sdk.AccAddress) error {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if err := validateDenomManageable(denomID); err != nil {
+		return err
+	}
+	if sender.String() != denom.Creator {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to revoke nfts of denom %s", sender,
+			denomID,
+		)
+	}
+	if !denom.Revocable {
+		return errorsmod.Wrapf(types.ErrNotRevocable, "denom %s is not revocable", denomID)
+	}
+	holder := k.nk.GetOwner(ctx, denomID, onftID)
+	if holder.Empty() {
+		return errorsmod.Wrapf(types.ErrInvalidONFT, "nft ID %s not exists", onftID)
+	}
+	// nfts escrowed by a module (listings, auctions, vaults, campaigns) are tracked in
+	// module state and must be released there before they can be revoked
+	if k.isModuleAccount(ctx, holder) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidRevocation,
+			"nft %s is held in escrow by module account %s", onftID, holder,
+		)
+	}
+	if reclaim && holder.Equals(sender) {
+		return errorsmod.Wrapf(types.ErrInvalidRevocation, "nft %s is already held by the denom creator", onftID)
+	}
+
+	if reclaim {
+		// revocation bypasses the transferable flag of the nft
+		if err := k.nk.Transfer(ctx, denomID, onftID, sender); err != nil {
+			return err
+		}
+		k.RecordOwnershipChange(
+			ctx,
+			denomID,
+			onftID,
+			holder,
+			sender,
+			types.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_REVOCATION,
+		)
+	} else {
+		if k.HasChildren(ctx, denomID, onftID) {
+			return errorsmod.Wrapf(types.ErrHasChildren, "nft %s has nested nfts, reclaim it instead", onftID)
+		}
+		k.UnindexONFTTraits(ctx, denomID, onftID)
+		if err := k.nk.Burn(ctx, denomID, onftID); err != nil {
+			return err
+		}
+		k.DeleteDataHistory(ctx, denomID, onftID)
+		k.DeleteOwnershipHistory(ctx, denomID, onftID)
+		k.DeleteEdition(ctx, denomID, onftID)
+	}
+	// a revoked nested onft is released from its parent
+	if nesting, found := k.GetNesting(ctx, denomID, onftID); found {
+		k.DeleteNesting(ctx, nesting)
+	}
+	k.DeleteApprovals(ctx, denomID, onftID)
+	k.DeleteONFTUser(ctx, denomID, onftID)
+
+	revocation := types.Revocation{
+		DenomId:   denomID,
+		OnftId:    onftID,
+		Sequence:  k.nextRevocationSequence(ctx, denomID, onftID),
+		Holder:    holder.String(),
+		Issuer:    sender.String(),
+		Reason:    reason,
+		Reclaimed: reclaim,
+		Height:    ctx.BlockHeight(),
+		RevokedAt: ctx.BlockTime(),
+	}
+	k.SetRevocation(ctx, revocation)
+	k.emitRevocationEvent(ctx, revocation)
+	return nil
+}
+
+// nextRevocationSequence returns the sequence of the next revocation of an onft,
+// a reclaimed onft can be transferred again and revoked more than once
+func (k Keeper) nextRevocationSequence(ctx sdk.Context, denomID, onftID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyRevocationPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+	var revocation types.Revocation
+	k.cdc.MustUnmarshal(iterator.Value(), &revocation)
+	return revocation.Sequence + 1
+}
+
+func (k Keeper) SetRevocation(ctx sdk.Context, revocation types.Revocation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&revocation)
+	store.Set(types.KeyRevocation(revocation.DenomId, revocation.OnftId, revocation.Sequence), bz)
+}
+
+// GetRevocations returns the revocations of a denom, or of a single onft if onftID is not empty
+func (k Keeper) GetRevocations(ctx sdk.Context, denomID, onftID string) (revocations []types.Revocation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyRevocationPrefix(denomID, onftID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revocation types.Revocation
+		k.cdc.MustUnmarshal(iterator.Value(), &revocation)
+		revocations = append(revocations, revocation)
+	}
+	return revocations
+}
+
+// GetAllRevocations returns the revocations of all denoms
+func (k Keeper) GetAllRevocations(ctx sdk.Context) (revocations []types.Revocation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixRevocation)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revocation types.Revocation
+		k.cdc.MustUnmarshal(iterator.Value(), &revocation)
+		revocations = append(revocations, revocation)
+	}
+	return revocations
+}
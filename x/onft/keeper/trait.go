@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// IndexONFTTraits indexes the traits of an onft if its denom has indexed traits
+func (k Keeper) IndexONFTTraits(ctx sdk.Context, denomID, onftID string) error {
+	data, indexed := k.indexedONFTData(ctx, denomID, onftID)
+	if !indexed {
+		return nil
+	}
+	traits, err := types.ParseTraits(data)
+	if err != nil {
+		return err
+	}
+	k.indexTraits(ctx, denomID, onftID, traits)
+	return nil
+}
+
+// UnindexONFTTraits removes the traits of an onft from the index of its denom,
+// it must be called before the onft is burned
+func (k Keeper) UnindexONFTTraits(ctx sdk.Context, denomID, onftID string) {
+	data, indexed := k.indexedONFTData(ctx, denomID, onftID)
+	if !indexed {
+		return
+	}
+	// the traits were validated when they were indexed
+	traits, _ := types.ParseTraits(data)
+	k.unindexTraits(ctx, denomID, onftID, traits)
+}
+
+// indexedONFTData returns the data of an onft and true if its denom has indexed traits
+func (k Keeper) indexedONFTData(ctx sdk.Context, denomID, onftID string) (string, bool) {
+	denom, err := k.GetDenomInfo(ctx, denomID)
+	if err != nil || !denom.IndexedTraits {
+		return "", false
+	}
+	_nft, exist := k.nk.GetNFT(ctx, denomID, onftID)
+	if !exist {
+		return "", false
+	}
+	nftMetadata, err := types.UnmarshalNFTMetadata(k.cdc, _nft.Data.GetValue())
+	if err != nil {
+		return "", false
+	}
+	return nftMetadata.Data, true
+}
+
+// reindexTraits replaces the indexed traits of the previous data of an onft with traits
+func (k Keeper) reindexTraits(ctx sdk.Context, denomID, onftID, prevData string, traits []types.Trait) {
+	prevTraits, _ := types.ParseTraits(prevData)
+	k.unindexTraits(ctx, denomID, onftID, prevTraits)
+	k.indexTraits(ctx, denomID, onftID, traits)
+}
+
+func (k Keeper) indexTraits(ctx sdk.Context, denomID, onftID string, traits []types.Trait) {
+	store := ctx.KVStore(k.storeKey)
+	for _, trait := range traits {
+		store.Set(types.KeyTraitONFT(denomID, trait, onftID), []byte(onftID))
+		traitCount := k.GetTraitCount(ctx, denomID, trait)
+		traitCount.Count++
+		k.setTraitCount(ctx, denomID, trait, traitCount)
+	}
+}
+
+func (k Keeper) unindexTraits(ctx sdk.Context, denomID, onftID string, traits []types.Trait) {
+	store := ctx.KVStore(k.storeKey)
+	for _, trait := range traits {
+		key := types.KeyTraitONFT(denomID, trait, onftID)
+		if !store.Has(key) {
+			continue
+		}
+		store.Delete(key)
+		traitCount := k.GetTraitCount(ctx, denomID, trait)
+		if traitCount.Count <= 1 {
+			store.Delete(types.KeyTraitCount(denomID, trait))
+			continue
+		}
+		traitCount.Count--
+		k.setTraitCount(ctx, denomID, trait, traitCount)
+	}
+}
+
+func (k Keeper) setTraitCount(ctx sdk.Context, denomID string, trait types.Trait, traitCount types.TraitCount) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&traitCount)
+	store.Set(types.KeyTraitCount(denomID, trait), bz)
+}
+
+// GetTraitCount returns the number of onfts of a denom with a trait value
+func (k Keeper) GetTraitCount(ctx sdk.Context, denomID string, trait types.Trait) types.TraitCount {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyTraitCount(denomID, trait))
+	if bz == nil {
+		return types.TraitCount{TraitType: trait.TraitType, Value: trait.Value}
+	}
+	var traitCount types.TraitCount
+	k.cdc.MustUnmarshal(bz, &traitCount)
+	return traitCount
+}
+
+// GetTraitCounts returns the trait counts of a denom
+func (k Keeper) GetTraitCounts(ctx sdk.Context, denomID string) (traitCounts []types.TraitCount) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyTraitCountPrefix(denomID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var traitCount types.TraitCount
+		k.cdc.MustUnmarshal(iterator.Value(), &traitCount)
+		traitCounts = append(traitCounts, traitCount)
+	}
+	return traitCounts
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// SetUser sets the user of the onft until expiry, an empty user removes the current user.
+// sender must be the owner of the onft or an approved operator of the owner.
+func (k Keeper) SetUser(
+	ctx sdk.Context,
+	denomID,
+	onftID string,
+	user sdk.AccAddress,
+	expiry time.Time,
+	sender sdk.AccAddress,
+) error {
+	owner, err := k.authorizeOwnerOrOperator(ctx, denomID, onftID, sender)
+	if err != nil {
+		return err
+	}
+	if user.Empty() {
+		k.DeleteONFTUser(ctx, denomID, onftID)
+		k.emitSetONFTUserEvent(ctx, denomID, onftID, owner.String(), "", "")
+		return nil
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidONFTUser,
+			"expiry %s must be after current block time", expiry.String(),
+		)
+	}
+
+	k.SetONFTUser(ctx, types.NewONFTUser(denomID, onftID, user, expiry))
+
+	k.emitSetONFTUserEvent(ctx, denomID, onftID, owner.String(), user.String(), expiry.String())
+	return nil
+}
+
+// GetCurrentUser returns the current user of the onft, user role lapses once
+// the block time passes the expiry
+func (k Keeper) GetCurrentUser(ctx sdk.Context, denomID, onftID string) (sdk.AccAddress, bool) {
+	user, found := k.GetONFTUser(ctx, denomID, onftID)
+	if !found || user.IsExpired(ctx.BlockTime()) {
+		return nil, false
+	}
+	return user.GetUser(), true
+}
+
+// IsOwnerOrUser returns true if addr is the owner or the current user of the onft
+func (k Keeper) IsOwnerOrUser(ctx sdk.Context, denomID, onftID string, addr sdk.AccAddress) bool {
+	if !k.nk.HasNFT(ctx, denomID, onftID) {
+		return false
+	}
+	if addr.Equals(k.nk.GetOwner(ctx, denomID, onftID)) {
+		return true
+	}
+	user, found := k.GetCurrentUser(ctx, denomID, onftID)
+	return found && addr.Equals(user)
+}
+
+func (k Keeper) SetONFTUser(ctx sdk.Context, user types.ONFTUser) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&user)
+	store.Set(types.KeyONFTUser(user.DenomId, user.OnftId), bz)
+}
+
+func (k Keeper) GetONFTUser(ctx sdk.Context, denomID, onftID string) (user types.ONFTUser, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyONFTUser(denomID, onftID))
+	if bz == nil {
+		return user, false
+	}
+	k.cdc.MustUnmarshal(bz, &user)
+	return user, true
+}
+
+func (k Keeper) DeleteONFTUser(ctx sdk.Context, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyONFTUser(denomID, onftID))
+}
+
+// GetAllONFTUsers returns users of all onfts
+func (k Keeper) GetAllONFTUsers(ctx sdk.Context) (users []types.ONFTUser) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixONFTUser)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var user types.ONFTUser
+		k.cdc.MustUnmarshal(iterator.Value(), &user)
+		users = append(users, user)
+	}
+	return users
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// Fractionalize locks an oNFT in the onft module account and mints the given supply of
+// a tokenfactory denom administered by the module account to the depositor.
+// The denom creation fee of the tokenfactory is paid by the depositor.
+func (k Keeper) Fractionalize(
+	ctx sdk.Context,
+	denomID, onftID string,
+	supply sdkmath.Int,
+	buyoutPrice sdk.Coin,
+	depositor sdk.AccAddress,
+) (types.Vault, error) {
+	onft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return types.Vault{}, err
+	}
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.TransferOwnership(ctx, denomID, onftID, depositor, moduleAddr); err != nil {
+		return types.Vault{}, err
+	}
+
+	vaultID := k.GetNextVaultID(ctx)
+	fractionDenom, err := k.tokenFactoryKeeper.CreateDenomWithPayer(
+		ctx, moduleAddr.String(), types.VaultSubdenom(vaultID), depositor.String(),
+	)
+	if err != nil {
+		return types.Vault{}, err
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: onft.GetDescription(),
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    fractionDenom,
+			Exponent: 0,
+		}},
+		Base:    fractionDenom,
+		Display: fractionDenom,
+		Name:    onft.GetName(),
+		Symbol:  types.VaultSubdenom(vaultID),
+		URI:     onft.GetMediaURI(),
+		URIHash: onft.GetURIHash(),
+	})
+	if err := k.tokenFactoryKeeper.MintTo(ctx, sdk.NewCoin(fractionDenom, supply), depositor.String()); err != nil {
+		return types.Vault{}, err
+	}
+
+	vault := types.Vault{
+		Id:            vaultID,
+		DenomId:       denomID,
+		OnftId:        onftID,
+		Depositor:     depositor.String(),
+		FractionDenom: fractionDenom,
+		Supply:        supply,
+		BuyoutPrice:   buyoutPrice,
+		Status:        types.VaultStatus_VAULT_STATUS_ACTIVE,
+		Proceeds:      sdk.Coin{Denom: buyoutPrice.Denom, Amount: sdkmath.ZeroInt()},
+		Unclaimed:     sdkmath.ZeroInt(),
+	}
+	k.SetVault(ctx, vault)
+	k.SetNextVaultID(ctx, vaultID+1)
+
+	k.emitFractionalizeEvent(ctx, vault)
+	return vault, nil
+}
+
+// Redeem burns the full fraction supply held by the sender and releases the oNFT to the sender
+func (k Keeper) Redeem(ctx sdk.Context, vaultID uint64, sender sdk.AccAddress) error {
+	vault, err := k.getActiveVault(ctx, vaultID)
+	if err != nil {
+		return err
+	}
+	balance := k.bankKeeper.GetBalance(ctx, sender, vault.FractionDenom)
+	if balance.Amount.LT(vault.Supply) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidVault,
+			"redeem requires the full supply %s%s, sender holds %s",
+			vault.Supply, vault.FractionDenom, balance.String(),
+		)
+	}
+	// the redeemer isn't necessarily the depositor, the transfer restrictions apply
+	if err := k.ValidateTransferRestrictions(ctx, vault.DenomId, vault.OnftId); err != nil {
+		return err
+	}
+	if err := k.tokenFactoryKeeper.BurnFrom(ctx, sdk.NewCoin(vault.FractionDenom, vault.Supply), sender.String()); err != nil {
+		return err
+	}
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.TransferOwnership(ctx, vault.DenomId, vault.OnftId, moduleAddr, sender); err != nil {
+		return err
+	}
+
+	vault.Status = types.VaultStatus_VAULT_STATUS_REDEEMED
+	k.SetVault(ctx, vault)
+
+	k.emitRedeemVaultEvent(ctx, vault, sender.String())
+	return nil
+}
+
+// Buyout transfers the oNFT of a vault to the bidder for the buyout price of the vault.
+// The price is escrowed in the onft module account and claimable by fraction holders pro-rata.
+func (k Keeper) Buyout(ctx sdk.Context, vaultID uint64, buyer sdk.AccAddress) error {
+	vault, err := k.getActiveVault(ctx, vaultID)
+	if err != nil {
+		return err
+	}
+	if !vault.HasBuyout() {
+		return errorsmod.Wrapf(types.ErrInvalidVault, "vault %d has no buyout price", vaultID)
+	}
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.SendCoins(ctx, buyer, moduleAddr, sdk.NewCoins(vault.BuyoutPrice)); err != nil {
+		return err
+	}
+	if err := k.TransferOwnershipWithCause(
+		ctx,
+		vault.DenomId,
+		vault.OnftId,
+		moduleAddr,
+		buyer,
+		types.OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_SALE,
+	); err != nil {
+		return err
+	}
+
+	vault.Status = types.VaultStatus_VAULT_STATUS_BOUGHT_OUT
+	vault.Buyer = buyer.String()
+	vault.Proceeds = vault.BuyoutPrice
+	vault.Unclaimed = vault.Supply
+	k.SetVault(ctx, vault)
+
+	k.emitBuyoutVaultEvent(ctx, vault)
+	return nil
+}
+
+// ClaimBuyout burns the fractions held by the sender and pays the pro-rata share
+// of the buyout proceeds of a bought out vault
+func (k Keeper) ClaimBuyout(ctx sdk.Context, vaultID uint64, sender sdk.AccAddress) (sdk.Coin, error) {
+	vault, found := k.GetVault(ctx, vaultID)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVault, "vault %d not found", vaultID)
+	}
+	if vault.Status != types.VaultStatus_VAULT_STATUS_BOUGHT_OUT {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVault, "vault %d is not bought out", vaultID)
+	}
+	balance := k.bankKeeper.GetBalance(ctx, sender, vault.FractionDenom)
+	if !balance.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVault, "sender holds no %s", vault.FractionDenom)
+	}
+
+	// the last claim receives the remaining proceeds so that no dust is left in escrow
+	payout := sdk.NewCoin(vault.Proceeds.Denom, vault.Proceeds.Amount.Mul(balance.Amount).Quo(vault.Unclaimed))
+	if balance.Amount.Equal(vault.Unclaimed) {
+		payout = vault.Proceeds
+	}
+	if err := k.tokenFactoryKeeper.BurnFrom(ctx, balance, sender.String()); err != nil {
+		return sdk.Coin{}, err
+	}
+	if payout.IsPositive() {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, sender, sdk.NewCoins(payout)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	vault.Proceeds = vault.Proceeds.Sub(payout)
+	vault.Unclaimed = vault.Unclaimed.Sub(balance.Amount)
+	k.SetVault(ctx, vault)
+
+	k.emitClaimBuyoutEvent(ctx, vault.Id, sender.String(), balance, payout)
+	return payout, nil
+}
+
+func (k Keeper) getActiveVault(ctx sdk.Context, vaultID uint64) (types.Vault, error) {
+	vault, found := k.GetVault(ctx, vaultID)
+	if !found {
+		return vault, errorsmod.Wrapf(types.ErrInvalidVault, "vault %d not found", vaultID)
+	}
+	if vault.Status != types.VaultStatus_VAULT_STATUS_ACTIVE {
+		return vault, errorsmod.Wrapf(types.ErrVaultNotActive, "vault %d is %s", vaultID, vault.Status.String())
+	}
+	return vault, nil
+}
+
+func (k Keeper) SetVault(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vault)
+	store.Set(types.KeyVault(vault.Id), bz)
+}
+
+func (k Keeper) GetVault(ctx sdk.Context, vaultID uint64) (vault types.Vault, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyVault(vaultID))
+	if bz == nil {
+		return vault, false
+	}
+	k.cdc.MustUnmarshal(bz, &vault)
+	return vault, true
+}
+
+// GetAllVaults returns all vaults
+func (k Keeper) GetAllVaults(ctx sdk.Context) (vaults []types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixVault)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vault types.Vault
+		k.cdc.MustUnmarshal(iterator.Value(), &vault)
+		vaults = append(vaults, vault)
+	}
+	return vaults
+}
+
+func (k Keeper) SetNextVaultID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextVaultIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNextVaultID returns the id of the next vault, vault ids start at 1
+func (k Keeper) GetNextVaultID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextVaultIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OmniFlix/omniflixhub/v6/x/onft/types"
+)
+
+// RedeemMintVoucher verifies a mint voucher signed by a denom minter, collects the
+// voucher price from the redeemer to the denom creator and mints the onft to the redeemer
+func (k Keeper) RedeemMintVoucher(
+	ctx sdk.Context,
+	voucher types.MintVoucher,
+	pubKey,
+	signature []byte,
+	redeemer sdk.AccAddress,
+) error {
+	signer := voucher.GetSigner()
+	if err := k.verifyVoucherSignature(ctx, voucher, pubKey, signature); err != nil {
+		return err
+	}
+	if voucher.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidVoucher, "voucher expired at %s", voucher.Expiry.String())
+	}
+	if k.HasVoucherNonce(ctx, voucher.DenomId, signer, voucher.Nonce) {
+		return errorsmod.Wrapf(types.ErrInvalidVoucher, "nonce %d of signer %s already redeemed", voucher.Nonce, voucher.Signer)
+	}
+	if !k.HasPermissionToMint(ctx, voucher.DenomId, signer) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to mint nft under denom %s",
+			voucher.Signer,
+			voucher.DenomId,
+		)
+	}
+	if k.HasONFT(ctx, voucher.DenomId, voucher.Id) {
+		return errorsmod.Wrapf(
+			types.ErrONFTAlreadyExists,
+			"ONFT with id %s already exists in collection %s", voucher.Id, voucher.DenomId)
+	}
+	if voucher.RoyaltyReceivers != nil {
+		if err := k.ValidateRoyaltyReceiverAddresses(voucher.RoyaltyReceivers); err != nil {
+			return err
+		}
+	}
+	if err := k.UseMintQuota(ctx, voucher.DenomId, signer, 1); err != nil {
+		return err
+	}
+	k.SetVoucherNonce(ctx, types.VoucherNonce{
+		DenomId: voucher.DenomId,
+		Signer:  voucher.Signer,
+		Nonce:   voucher.Nonce,
+	})
+
+	if voucher.Price.IsPositive() {
+		denom, err := k.GetDenomInfo(ctx, voucher.DenomId)
+		if err != nil {
+			return err
+		}
+		creator, err := sdk.AccAddressFromBech32(denom.Creator)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, redeemer, creator, sdk.NewCoins(voucher.Price)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.MintONFT(ctx,
+		voucher.DenomId,
+		voucher.Id,
+		voucher.Metadata.Name,
+		voucher.Metadata.Description,
+		voucher.Metadata.MediaURI,
+		voucher.Metadata.UriHash,
+		voucher.Metadata.PreviewURI,
+		voucher.Data,
+		ctx.BlockTime(),
+		voucher.Transferable,
+		voucher.Extensible,
+		voucher.Nsfw,
+		voucher.RoyaltyShare,
+		voucher.RoyaltyReceivers,
+		nil,
+		redeemer,
+	); err != nil {
+		return err
+	}
+
+	k.emitRedeemMintVoucherEvent(ctx, voucher.DenomId, voucher.Id, voucher.Signer, redeemer.String(), voucher.Nonce, voucher.Price)
+	return nil
+}
+
+// verifyVoucherSignature verifies the voucher is signed for the current chain by the
+// secp256k1 key of the voucher signer
+func (k Keeper) verifyVoucherSignature(ctx sdk.Context, voucher types.MintVoucher, pubKey, signature []byte) error {
+	if len(pubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(types.ErrInvalidVoucher, "invalid pub key length %d", len(pubKey))
+	}
+	// charged like the signature verification of a tx signer in the ante handler
+	ctx.GasMeter().ConsumeGas(k.accountKeeper.GetParams(ctx).SigVerifyCostSecp256k1, "onft voucher signature verification")
+	key := &secp256k1.PubKey{Key: pubKey}
+	if !sdk.AccAddress(key.Address()).Equals(voucher.GetSigner()) {
+		return errorsmod.Wrapf(types.ErrInvalidVoucher, "pub key does not belong to signer %s", voucher.Signer)
+	}
+	if !key.VerifySignature(voucher.GetSignBytes(ctx.ChainID()), signature) {
+		return errorsmod.Wrap(types.ErrInvalidVoucher, "invalid signature")
+	}
+	return nil
+}
+
+func (k Keeper) SetVoucherNonce(ctx sdk.Context, nonce types.VoucherNonce) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&nonce)
+	store.Set(types.KeyVoucherNonce(nonce.DenomId, nonce.GetSigner(), nonce.Nonce), bz)
+}
+
+func (k Keeper) HasVoucherNonce(ctx sdk.Context, denomID string, signer sdk.AccAddress, nonce uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyVoucherNonce(denomID, signer, nonce))
+}
+
+// GetAllVoucherNonces returns redeemed voucher nonces of all signers
+func (k Keeper) GetAllVoucherNonces(ctx sdk.Context) (nonces []types.VoucherNonce) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PrefixVoucherNonce)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nonce types.VoucherNonce
+		k.cdc.MustUnmarshal(iterator.Value(), &nonce)
+		nonces = append(nonces, nonce)
+	}
+	return nonces
+}
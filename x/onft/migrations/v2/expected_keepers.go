@@ -21,5 +21,9 @@ type NFTKeeper interface {
 		data string,
 		royaltyReceivers []*onfttypes.WeightedAddress,
 		updatableData bool,
+		maxSupply uint64,
+		revocable bool,
+		indexedTraits bool,
+		dataHistoryRetention uint64,
 	) error
 }
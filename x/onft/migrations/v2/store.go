@@ -60,6 +60,10 @@ func MigrateCollections(ctx sdk.Context,
 			denom.Data,
 			denom.RoyaltyReceivers,
 			denom.UpdatableData,
+			0,
+			false,
+			false,
+			0,
 		); err != nil {
 			return err
 		}
@@ -73,7 +73,25 @@ func RandomizedGenState(simState *module.SimulationState) {
 		}
 	}
 
-	nftGenesis := types.NewGenesisState(collections, types.DefaultParams())
+	nftGenesis := types.NewGenesisState(
+		collections,
+		types.DefaultParams(),
+		[]types.Minter{},
+		[]types.Approval{},
+		[]types.OperatorApproval{},
+		[]types.ONFTUser{},
+		[]types.ONFTDataVersion{},
+		[]types.VoucherNonce{},
+		[]types.Launchpad{},
+		[]types.LaunchpadWalletMint{},
+		[]types.Vault{},
+		1,
+		[]types.Revocation{},
+		[]types.Nesting{},
+		[]types.EditionSet{},
+		[]types.Edition{},
+		[]types.OwnershipRecord{},
+	)
 
 	bz, err := json.MarshalIndent(nftGenesis, "", " ")
 	if err != nil {
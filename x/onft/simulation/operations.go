@@ -142,6 +142,9 @@ func SimulateMsgCreateDenom(k keeper.Keeper, ak types.AccountKeeper, bk types.Ba
 			creationFee,
 			nil,
 			false,
+			0,
+			false,
+			false,
 		)
 		msg.Id = denomId
 		denom, _ := k.GetDenomInfo(ctx, msg.Id)
@@ -195,6 +198,8 @@ func SimulateMsgMintONFT(k keeper.Keeper, ak types.AccountKeeper, bk types.BankK
 			genRandomBool(r),
 			genRandomBool(r),
 			RandRoyaltyShare(r),
+			nil,
+			nil,
 		)
 		onftId := RandID(r, "onft", 10)
 		msg.Id = onftId
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewApproval(denomID, onftID string, spender sdk.AccAddress, expiry *time.Time) Approval {
+	return Approval{
+		DenomId: denomID,
+		OnftId:  onftID,
+		Spender: spender.String(),
+		Expiry:  expiry,
+	}
+}
+
+func (a Approval) GetSpender() sdk.AccAddress {
+	spender, _ := sdk.AccAddressFromBech32(a.Spender)
+	return spender
+}
+
+// IsExpired returns true if the approval has an expiry and it is not after the given time
+func (a Approval) IsExpired(blockTime time.Time) bool {
+	return a.Expiry != nil && !a.Expiry.After(blockTime)
+}
+
+func (a Approval) Validate() error {
+	if strings.TrimSpace(a.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(a.OnftId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing onft id")
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Spender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidApproval, "invalid spender address %s", a.Spender)
+	}
+	return nil
+}
+
+func NewOperatorApproval(owner, operator sdk.AccAddress, expiry *time.Time) OperatorApproval {
+	return OperatorApproval{
+		Owner:    owner.String(),
+		Operator: operator.String(),
+		Expiry:   expiry,
+	}
+}
+
+func (o OperatorApproval) GetOwner() sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(o.Owner)
+	return owner
+}
+
+func (o OperatorApproval) GetOperator() sdk.AccAddress {
+	operator, _ := sdk.AccAddressFromBech32(o.Operator)
+	return operator
+}
+
+// IsExpired returns true if the operator approval has an expiry and it is not after the given time
+func (o OperatorApproval) IsExpired(blockTime time.Time) bool {
+	return o.Expiry != nil && !o.Expiry.After(blockTime)
+}
+
+func (o OperatorApproval) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidApproval, "invalid owner address %s", o.Owner)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Operator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidApproval, "invalid operator address %s", o.Operator)
+	}
+	return nil
+}
@@ -1,11 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -17,12 +20,51 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 )
 
+// ICS-721 class and token data format
+//
+// The class and token data of ics721 packets is the base64 encoding of a json object. The
+// fields of a denom or an onft are media fields under the omniflix namespace, ex:
+//
+//	{
+//	  "omniflix:version": {"value": "v1"},
+//	  "omniflix:name": {"value": "Collection"},
+//	  "omniflix:max_supply": {"value": "100"},
+//	  "level": 1
+//	}
+//
+// The remaining keys of the object are the fields of the json object stored in the data of
+// the denom or the onft, data that isn't a json object is carried as a string in the
+// "omniflix:data" field. Numbers in the data keep their precision across transfers.
+//
+// Class fields: name, symbol, description, uri_hash, creator (hex encoded address), schema,
+// preview_uri, royalty_receivers, updatable_data, max_supply (decimal string), minting_closed,
+// revocable, frozen, indexed_traits and data_history_retention (decimal string).
+//
+// Token fields: name, description, uri_hash, preview_uri, created_at (RFC3339), transferable,
+// extensible, nsfw, royalty_share (decimal string), royalty_receivers and transfer_locked_until
+// (RFC3339).
+//
+// Voucher classes built from the class data are owned by the ics721 module: the creator is the
+// module address, minting is closed without a max supply and the revocable and frozen flags
+// of the source denom are not applied, so the source creator has no control over the vouchers.
+//
+// Data without "omniflix:version" is decoded as the legacy format, which doesn't carry the
+// denom flags nor the transferable flag and transfer lock of the token. Fields unknown to a
+// version are kept in the data. Class data without omniflix fields, ex: the collection data of
+// cw-ics721, is kept in the data and its "name" and "symbol" are used as the class name and symbol.
 const (
 	Namespace          = "omniflix:"
 	KeyMediaFieldValue = "value"
+
+	// ICS721DataVersion is the version of the ics721 class and token data format
+	ICS721DataVersion = "v1"
+
+	cwKeyName   = "name"
+	cwKeySymbol = "symbol"
 )
 
 var (
+	ClassKeyVersion          = fmt.Sprintf("%s%s", Namespace, "version")
 	ClassKeyName             = fmt.Sprintf("%s%s", Namespace, "name")
 	ClassKeySymbol           = fmt.Sprintf("%s%s", Namespace, "symbol")
 	ClassKeyDescription      = fmt.Sprintf("%s%s", Namespace, "description")
@@ -31,14 +73,27 @@ var (
 	ClassKeySchema           = fmt.Sprintf("%s%s", Namespace, "schema")
 	ClassKeyPreviewURI       = fmt.Sprintf("%s%s", Namespace, "preview_uri")
 	ClassKeyRoyaltyReceivers = fmt.Sprintf("%s%s", Namespace, "royalty_receivers")
+	ClassKeyData             = fmt.Sprintf("%s%s", Namespace, "data")
+	ClassKeyUpdatableData    = fmt.Sprintf("%s%s", Namespace, "updatable_data")
+	ClassKeyMaxSupply        = fmt.Sprintf("%s%s", Namespace, "max_supply")
+	ClassKeyMintingClosed    = fmt.Sprintf("%s%s", Namespace, "minting_closed")
+	ClassKeyRevocable        = fmt.Sprintf("%s%s", Namespace, "revocable")
+	ClassKeyFrozen           = fmt.Sprintf("%s%s", Namespace, "frozen")
+	ClassKeyIndexedTraits    = fmt.Sprintf("%s%s", Namespace, "indexed_traits")
+	ClassKeyHistoryRetention = fmt.Sprintf("%s%s", Namespace, "data_history_retention")
+	nftKeyVersion            = fmt.Sprintf("%s%s", Namespace, "version")
 	nftKeyName               = fmt.Sprintf("%s%s", Namespace, "name")
 	nftKeyURIHash            = fmt.Sprintf("%s%s", Namespace, "uri_hash")
 	nftKeyPreviewURI         = fmt.Sprintf("%s%s", Namespace, "preview_uri")
 	nftKeyDescription        = fmt.Sprintf("%s%s", Namespace, "description")
 	nftKeyCreatedAt          = fmt.Sprintf("%s%s", Namespace, "created_at")
+	nftKeyTransferable       = fmt.Sprintf("%s%s", Namespace, "transferable")
 	nftKeyExtensible         = fmt.Sprintf("%s%s", Namespace, "extensible")
 	nftKeyNSFW               = fmt.Sprintf("%s%s", Namespace, "nsfw")
 	nftKeyRoyaltyShare       = fmt.Sprintf("%s%s", Namespace, "royalty_share")
+	nftKeyRoyaltyReceivers   = fmt.Sprintf("%s%s", Namespace, "royalty_receivers")
+	nftKeyTransferLocked     = fmt.Sprintf("%s%s", Namespace, "transfer_locked_until")
+	nftKeyData               = fmt.Sprintf("%s%s", Namespace, "data")
 )
 
 type ClassBuilder struct {
@@ -75,16 +130,14 @@ func (cb ClassBuilder) BuildMetadata(class nft.Class) (string, error) {
 		return "", errors.New("unsupported classMetadata")
 	}
 
-	kvals := make(map[string]interface{})
-	if len(metadata.Data) > 0 {
-		err := json.Unmarshal([]byte(metadata.Data), &kvals)
-		if err != nil && IsIBCDenom(class.Id) {
+	kvals, isObject := unmarshalDataObject([]byte(metadata.Data))
+	if !isObject {
+		if IsIBCDenom(class.Id) {
 			// when classData is not a legal json, there is no need to parse the data
 			return base64.StdEncoding.EncodeToString([]byte(metadata.Data)), nil
 		}
-		// note: if metadata.Data is null, it may cause map to be redefined as nil
-		if kvals == nil {
-			kvals = make(map[string]interface{})
+		kvals = map[string]interface{}{
+			ClassKeyData: MediaField{Value: metadata.Data},
 		}
 	}
 	creator, err := sdk.AccAddressFromBech32(metadata.Creator)
@@ -93,6 +146,7 @@ func (cb ClassBuilder) BuildMetadata(class nft.Class) (string, error) {
 	}
 
 	hexCreator := hex.EncodeToString(creator)
+	kvals[ClassKeyVersion] = MediaField{Value: ICS721DataVersion}
 	kvals[ClassKeyName] = MediaField{Value: class.Name}
 	kvals[ClassKeySymbol] = MediaField{Value: class.Symbol}
 	kvals[ClassKeyDescription] = MediaField{Value: class.Description}
@@ -101,6 +155,13 @@ func (cb ClassBuilder) BuildMetadata(class nft.Class) (string, error) {
 	kvals[ClassKeySchema] = MediaField{Value: metadata.Schema}
 	kvals[ClassKeyPreviewURI] = MediaField{Value: metadata.PreviewUri}
 	kvals[ClassKeyRoyaltyReceivers] = MediaField{Value: metadata.RoyaltyReceivers}
+	kvals[ClassKeyUpdatableData] = MediaField{Value: metadata.UpdatableData}
+	kvals[ClassKeyMaxSupply] = MediaField{Value: strconv.FormatUint(metadata.MaxSupply, 10)}
+	kvals[ClassKeyMintingClosed] = MediaField{Value: metadata.MintingClosed}
+	kvals[ClassKeyRevocable] = MediaField{Value: metadata.Revocable}
+	kvals[ClassKeyFrozen] = MediaField{Value: metadata.Frozen}
+	kvals[ClassKeyIndexedTraits] = MediaField{Value: metadata.IndexedTraits}
+	kvals[ClassKeyHistoryRetention] = MediaField{Value: strconv.FormatUint(metadata.DataHistoryRetention, 10)}
 	data, err := json.Marshal(kvals)
 	if err != nil {
 		return "", err
@@ -126,14 +187,15 @@ func (cb ClassBuilder) Build(classID, classURI, classData string) (nft.Class, er
 		creator          = cb.getModuleAddress(ModuleName).String()
 	)
 
-	dataMap := make(map[string]interface{})
-	if err := json.Unmarshal(classDataBz, &dataMap); err != nil {
+	dataMap, isObject := unmarshalDataObject(classDataBz)
+	if !isObject {
 		denomMeta, err := codectypes.NewAnyWithValue(&DenomMetadata{
-			Creator:     creator,
-			Schema:      schema,
-			Description: description,
-			PreviewUri:  previewURI,
-			Data:        string(classDataBz),
+			Creator:       creator,
+			Schema:        schema,
+			Description:   description,
+			PreviewUri:    previewURI,
+			Data:          string(classDataBz),
+			MintingClosed: true,
 		})
 		if err != nil {
 			return nft.Class{}, err
@@ -148,89 +210,56 @@ func (cb ClassBuilder) Build(classID, classURI, classData string) (nft.Class, er
 			Data:        denomMeta,
 		}, nil
 	}
-	if v, ok := dataMap[ClassKeyName]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				name = vStr
-				delete(dataMap, ClassKeyName)
-			}
-		}
-	}
+	popString(dataMap, ClassKeyVersion)
 
-	if v, ok := dataMap[ClassKeySymbol]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				symbol = vStr
-				delete(dataMap, ClassKeySymbol)
-			}
-		}
+	if v, ok := popString(dataMap, ClassKeyName); ok {
+		name = v
+	} else if v, ok := dataMap[cwKeyName].(string); ok {
+		name = v
 	}
-
-	if v, ok := dataMap[ClassKeyDescription]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				description = vStr
-				delete(dataMap, ClassKeyDescription)
-			}
-		}
+	if v, ok := popString(dataMap, ClassKeySymbol); ok {
+		symbol = v
+	} else if v, ok := dataMap[cwKeySymbol].(string); ok {
+		symbol = v
 	}
-
-	if v, ok := dataMap[ClassKeyURIHash]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				uriHash = vStr
-				delete(dataMap, ClassKeyURIHash)
-			}
-		}
+	if v, ok := popString(dataMap, ClassKeyDescription); ok {
+		description = v
 	}
-
-	if v, ok := dataMap[ClassKeyCreator]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				creatorAcc, err := sdk.AccAddressFromHexUnsafe(vStr)
-				if err != nil {
-					return nft.Class{}, err
-				}
-				creator = creatorAcc.String()
-				delete(dataMap, ClassKeyCreator)
-			}
-		}
+	if v, ok := popString(dataMap, ClassKeyURIHash); ok {
+		uriHash = v
 	}
-
-	if v, ok := dataMap[ClassKeySchema]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				schema = vStr
-				delete(dataMap, ClassKeySchema)
-			}
-		}
+	// the voucher class is owned by the ics721 module, the source creator is dropped
+	popString(dataMap, ClassKeyCreator)
+	if v, ok := popString(dataMap, ClassKeySchema); ok {
+		schema = v
 	}
-
-	if v, ok := dataMap[ClassKeyPreviewURI]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				previewURI = vStr
-				delete(dataMap, ClassKeyPreviewURI)
-			}
-		}
+	if v, ok := popString(dataMap, ClassKeyPreviewURI); ok {
+		previewURI = v
 	}
-
-	if v, ok := dataMap[ClassKeyRoyaltyReceivers]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vAddrs, ok := vMap[KeyMediaFieldValue].([]*WeightedAddress); ok {
-				royaltyReceivers = vAddrs
-				delete(dataMap, ClassKeyRoyaltyReceivers)
-			}
+	if v, ok := mediaFieldValue(dataMap, ClassKeyRoyaltyReceivers); ok {
+		if vAddrs, ok := parseWeightedAddresses(v); ok {
+			royaltyReceivers = vAddrs
+			delete(dataMap, ClassKeyRoyaltyReceivers)
 		}
 	}
+	updatableData, _ := popBool(dataMap, ClassKeyUpdatableData)
+	// vouchers are only minted by the ics721 module, the supply settings and the
+	// revocable and frozen flags of the source denom are not applied
+	if _, err := popUint64(dataMap, ClassKeyMaxSupply); err != nil {
+		return nft.Class{}, err
+	}
+	popBool(dataMap, ClassKeyMintingClosed)
+	popBool(dataMap, ClassKeyRevocable)
+	popBool(dataMap, ClassKeyFrozen)
+	indexedTraits, _ := popBool(dataMap, ClassKeyIndexedTraits)
+	dataHistoryRetention, err := popUint64(dataMap, ClassKeyHistoryRetention)
+	if err != nil {
+		return nft.Class{}, err
+	}
 
-	data := ""
-	if len(dataMap) > 0 {
-		dataBz, err := json.Marshal(dataMap)
-		if err != nil {
-			return nft.Class{}, err
-		}
-		data = string(dataBz)
+	data, err := marshalData(dataMap, ClassKeyData)
+	if err != nil {
+		return nft.Class{}, err
 	}
 
 	denomMeta, err := codectypes.NewAnyWithValue(&DenomMetadata{
@@ -241,6 +270,11 @@ func (cb ClassBuilder) Build(classID, classURI, classData string) (nft.Class, er
 		Data:             data,
 		UriHash:          uriHash,
 		RoyaltyReceivers: royaltyReceivers,
+		UpdatableData:    updatableData,
+		MintingClosed:    true,
+		IndexedTraits:    indexedTraits,
+
+		DataHistoryRetention: dataHistoryRetention,
 	})
 	if err != nil {
 		return nft.Class{}, err
@@ -274,26 +308,32 @@ func (nb NFTBuilder) BuildMetadata(_nft nft.NFT) (string, error) {
 	if !ok {
 		return "", errors.New("unsupported classMetadata")
 	}
-	kvals := make(map[string]interface{})
-	if len(nftMetadata.Data) > 0 {
-		err := json.Unmarshal([]byte(nftMetadata.Data), &kvals)
-		if err != nil && IsIBCDenom(_nft.ClassId) {
+	kvals, isObject := unmarshalDataObject([]byte(nftMetadata.Data))
+	if !isObject {
+		if IsIBCDenom(_nft.ClassId) {
 			// when nftMetadata is not a legal json, there is no need to parse the data
 			return base64.StdEncoding.EncodeToString([]byte(nftMetadata.Data)), nil
 		}
-		// note: if nftMetadata.Data is null, it may cause map to be redefined as nil
-		if kvals == nil {
-			kvals = make(map[string]interface{})
+		kvals = map[string]interface{}{
+			nftKeyData: MediaField{Value: nftMetadata.Data},
 		}
 	}
+	kvals[nftKeyVersion] = MediaField{Value: ICS721DataVersion}
 	kvals[nftKeyName] = MediaField{Value: nftMetadata.Name}
 	kvals[nftKeyDescription] = MediaField{Value: nftMetadata.Description}
 	kvals[nftKeyPreviewURI] = MediaField{Value: nftMetadata.PreviewURI}
+	kvals[nftKeyTransferable] = MediaField{Value: nftMetadata.Transferable}
 	kvals[nftKeyExtensible] = MediaField{Value: nftMetadata.Extensible}
 	kvals[nftKeyNSFW] = MediaField{Value: nftMetadata.Nsfw}
 	kvals[nftKeyCreatedAt] = MediaField{Value: nftMetadata.CreatedAt}
 	kvals[nftKeyRoyaltyShare] = MediaField{Value: nftMetadata.RoyaltyShare}
 	kvals[nftKeyURIHash] = MediaField{Value: _nft.UriHash}
+	if len(nftMetadata.RoyaltyReceivers) > 0 {
+		kvals[nftKeyRoyaltyReceivers] = MediaField{Value: nftMetadata.RoyaltyReceivers}
+	}
+	if nftMetadata.TransferLockedUntil != nil {
+		kvals[nftKeyTransferLocked] = MediaField{Value: nftMetadata.TransferLockedUntil}
+	}
 	data, err := json.Marshal(kvals)
 	if err != nil {
 		return "", err
@@ -308,12 +348,13 @@ func (nb NFTBuilder) Build(classId, nftID, nftURI, nftData string) (nft.NFT, err
 		return nft.NFT{}, err
 	}
 
-	dataMap := make(map[string]interface{})
-	if err := json.Unmarshal(nftDataBz, &dataMap); err != nil {
+	dataMap, isObject := unmarshalDataObject(nftDataBz)
+	if !isObject {
 		metadata, err := codectypes.NewAnyWithValue(&ONFTMetadata{
 			Data:         string(nftDataBz),
 			Transferable: true,
 			Extensible:   true,
+			RoyaltyShare: sdkmath.LegacyZeroDec(),
 		})
 		if err != nil {
 			return nft.NFT{}, err
@@ -328,116 +369,78 @@ func (nb NFTBuilder) Build(classId, nftID, nftURI, nftData string) (nft.NFT, err
 	}
 
 	var (
-		name         string
-		description  string
-		previewURI   string
-		nsfw         = false
-		extensible   = true
-		createdAt    string
-		royaltyShare string
-		uriHash      string
+		name                string
+		description         string
+		previewURI          string
+		uriHash             string
+		transferable        = true
+		extensible          = true
+		nsfw                = false
+		createdAt           time.Time
+		royaltyShare        = sdkmath.LegacyZeroDec()
+		royaltyReceivers    []*WeightedAddress
+		transferLockedUntil *time.Time
 	)
+	popString(dataMap, nftKeyVersion)
 
-	if v, ok := dataMap[nftKeyName]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				name = vStr
-				delete(dataMap, nftKeyName)
-			}
-		}
+	if v, ok := popString(dataMap, nftKeyName); ok {
+		name = v
 	}
-
-	if v, ok := dataMap[nftKeyDescription]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				description = vStr
-				delete(dataMap, nftKeyDescription)
-			}
-		}
+	if v, ok := popString(dataMap, nftKeyDescription); ok {
+		description = v
 	}
-
-	if v, ok := dataMap[nftKeyURIHash]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				uriHash = vStr
-				delete(dataMap, nftKeyURIHash)
-			}
-		}
+	if v, ok := popString(dataMap, nftKeyURIHash); ok {
+		uriHash = v
 	}
-	if v, ok := dataMap[nftKeyPreviewURI]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				previewURI = vStr
-				delete(dataMap, nftKeyPreviewURI)
-			}
-		}
+	if v, ok := popString(dataMap, nftKeyPreviewURI); ok {
+		previewURI = v
 	}
-	if v, ok := dataMap[nftKeyCreatedAt]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vStr, ok := vMap[KeyMediaFieldValue].(string); ok {
-				createdAt = vStr
-				delete(dataMap, nftKeyCreatedAt)
-			}
-		}
+	if v, ok := popString(dataMap, nftKeyCreatedAt); ok {
+		createdAt, _ = time.Parse(time.RFC3339, v)
 	}
-
-	if v, ok := dataMap[nftKeyExtensible]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vBool, ok := vMap[KeyMediaFieldValue].(bool); ok {
-				extensible = vBool
-				delete(dataMap, nftKeyExtensible)
-			}
-		}
+	if v, ok := popBool(dataMap, nftKeyTransferable); ok {
+		transferable = v
 	}
-
-	if v, ok := dataMap[nftKeyNSFW]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vBool, ok := vMap[KeyMediaFieldValue].(bool); ok {
-				nsfw = vBool
-				delete(dataMap, nftKeyNSFW)
-			}
+	if v, ok := popBool(dataMap, nftKeyExtensible); ok {
+		extensible = v
+	}
+	if v, ok := popBool(dataMap, nftKeyNSFW); ok {
+		nsfw = v
+	}
+	if v, ok := popString(dataMap, nftKeyRoyaltyShare); ok {
+		if royalty, err := sdkmath.LegacyNewDecFromStr(v); err == nil {
+			royaltyShare = royalty
 		}
 	}
-
-	if v, ok := dataMap[nftKeyNSFW]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vBool, ok := vMap[KeyMediaFieldValue].(bool); ok {
-				nsfw = vBool
-				delete(dataMap, nftKeyNSFW)
-			}
+	if v, ok := mediaFieldValue(dataMap, nftKeyRoyaltyReceivers); ok {
+		if vAddrs, ok := parseWeightedAddresses(v); ok {
+			royaltyReceivers = vAddrs
+			delete(dataMap, nftKeyRoyaltyReceivers)
 		}
 	}
-
-	if v, ok := dataMap[nftKeyRoyaltyShare]; ok {
-		if vMap, ok := v.(map[string]interface{}); ok {
-			if vDec, ok := vMap[KeyMediaFieldValue].(string); ok {
-				royaltyShare = vDec
-				delete(dataMap, nftKeyRoyaltyShare)
-			}
+	if v, ok := popString(dataMap, nftKeyTransferLocked); ok {
+		if lockedUntil, err := time.Parse(time.RFC3339, v); err == nil {
+			transferLockedUntil = &lockedUntil
 		}
 	}
 
-	data := ""
-	if len(dataMap) > 0 {
-		dataBz, err := json.Marshal(dataMap)
-		if err != nil {
-			return nft.NFT{}, err
-		}
-		data = string(dataBz)
+	data, err := marshalData(dataMap, nftKeyData)
+	if err != nil {
+		return nft.NFT{}, err
 	}
-	createdTime, _ := time.Parse(time.RFC3339, createdAt)
-	royalty, _ := sdkmath.LegacyNewDecFromStr(royaltyShare)
 
 	metadata, err := codectypes.NewAnyWithValue(&ONFTMetadata{
-		Name:         name,
-		Description:  description,
-		PreviewURI:   previewURI,
-		Data:         data,
-		Transferable: true,
-		Extensible:   extensible,
-		Nsfw:         nsfw,
-		CreatedAt:    createdTime,
-		RoyaltyShare: royalty,
+		Name:                name,
+		Description:         description,
+		PreviewURI:          previewURI,
+		Data:                data,
+		Transferable:        transferable,
+		Extensible:          extensible,
+		Nsfw:                nsfw,
+		CreatedAt:           createdAt,
+		RoyaltyShare:        royaltyShare,
+		RoyaltyReceivers:    royaltyReceivers,
+		TransferLockedUntil: transferLockedUntil,
 	})
 	if err != nil {
 		return nft.NFT{}, err
@@ -451,3 +454,115 @@ func (nb NFTBuilder) Build(classId, nftID, nftURI, nftData string) (nft.NFT, err
 		Data:    metadata,
 	}, nil
 }
+
+// unmarshalDataObject decodes data as a json object, empty data is an empty object. Numbers
+// are decoded as json.Number so that they are encoded back without loss of precision.
+func unmarshalDataObject(data []byte) (map[string]interface{}, bool) {
+	dataMap := make(map[string]interface{})
+	if len(data) == 0 {
+		return dataMap, true
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	// note: if data is null, it may cause map to be redefined as nil
+	if err := decoder.Decode(&dataMap); err != nil || dataMap == nil {
+		return nil, false
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, false
+	}
+	return dataMap, true
+}
+
+// marshalData encodes the remaining fields of the data object, the data carried in the
+// dataKey media field is returned as is
+func marshalData(dataMap map[string]interface{}, dataKey string) (string, error) {
+	if v, ok := popString(dataMap, dataKey); ok {
+		return v, nil
+	}
+	if len(dataMap) == 0 {
+		return "", nil
+	}
+	dataBz, err := json.Marshal(dataMap)
+	if err != nil {
+		return "", err
+	}
+	return string(dataBz), nil
+}
+
+// mediaFieldValue returns the value of a media field of the data object
+func mediaFieldValue(dataMap map[string]interface{}, key string) (interface{}, bool) {
+	v, ok := dataMap[key]
+	if !ok {
+		return nil, false
+	}
+	vMap, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	value, ok := vMap[KeyMediaFieldValue]
+	return value, ok
+}
+
+// popString returns the string value of a media field and removes the field from the data object
+func popString(dataMap map[string]interface{}, key string) (string, bool) {
+	v, ok := mediaFieldValue(dataMap, key)
+	if !ok {
+		return "", false
+	}
+	vStr, ok := v.(string)
+	if ok {
+		delete(dataMap, key)
+	}
+	return vStr, ok
+}
+
+// popBool returns the bool value of a media field and removes the field from the data object
+func popBool(dataMap map[string]interface{}, key string) (bool, bool) {
+	v, ok := mediaFieldValue(dataMap, key)
+	if !ok {
+		return false, false
+	}
+	vBool, ok := v.(bool)
+	if ok {
+		delete(dataMap, key)
+	}
+	return vBool, ok
+}
+
+// popUint64 returns the uint64 value of a media field encoded as a decimal string or a
+// number and removes the field from the data object
+func popUint64(dataMap map[string]interface{}, key string) (uint64, error) {
+	v, ok := mediaFieldValue(dataMap, key)
+	if !ok {
+		return 0, nil
+	}
+	var vStr string
+	switch value := v.(type) {
+	case string:
+		vStr = value
+	case json.Number:
+		vStr = value.String()
+	default:
+		return 0, nil
+	}
+	vUint, err := strconv.ParseUint(vStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	delete(dataMap, key)
+	return vUint, nil
+}
+
+// parseWeightedAddresses converts a decoded json value back into weighted addresses
+func parseWeightedAddresses(v interface{}) ([]*WeightedAddress, bool) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	var weightedAddresses []*WeightedAddress
+	if err := json.Unmarshal(bz, &weightedAddresses); err != nil {
+		return nil, false
+	}
+	return weightedAddresses, true
+}
@@ -18,7 +18,34 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMintONFT{}, "OmniFlix/onft/MsgMintONFT")
 	legacy.RegisterAminoMsg(cdc, &MsgBurnONFT{}, "OmniFlix/onft/MsgBurnONFT")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateONFTData{}, "OmniFlix/onft/MsgUpdateONFTData")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateONFTRoyaltyReceivers{}, "OmniFlix/onft/MsgUpdateRoyaltyReceivers")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "OmniFlix/onft/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchMintONFT{}, "OmniFlix/onft/MsgBatchMintONFT")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchTransferONFT{}, "OmniFlix/onft/MsgBatchTransferONFT")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchBurnONFT{}, "OmniFlix/onft/MsgBatchBurnONFT")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantMinter{}, "OmniFlix/onft/MsgGrantMinter")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeMinter{}, "OmniFlix/onft/MsgRevokeMinter")
+	legacy.RegisterAminoMsg(cdc, &MsgCloseMinting{}, "OmniFlix/onft/MsgCloseMinting")
+	legacy.RegisterAminoMsg(cdc, &MsgApprove{}, "OmniFlix/onft/MsgApprove")
+	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "OmniFlix/onft/MsgRevoke")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveAll{}, "OmniFlix/onft/MsgApproveAll")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAll{}, "OmniFlix/onft/MsgRevokeAll")
+	legacy.RegisterAminoMsg(cdc, &MsgSetONFTUser{}, "OmniFlix/onft/MsgSetONFTUser")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemMintVoucher{}, "OmniFlix/onft/MsgRedeemMintVoucher")
+	legacy.RegisterAminoMsg(cdc, &MsgSetLaunchpad{}, "OmniFlix/onft/MsgSetLaunchpad")
+	legacy.RegisterAminoMsg(cdc, &MsgLaunchpadMint{}, "OmniFlix/onft/MsgLaunchpadMint")
+	legacy.RegisterAminoMsg(cdc, &MsgFractionalize{}, "OmniFlix/onft/MsgFractionalize")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeem{}, "OmniFlix/onft/MsgRedeem")
+	legacy.RegisterAminoMsg(cdc, &MsgBuyout{}, "OmniFlix/onft/MsgBuyout")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimBuyout{}, "OmniFlix/onft/MsgClaimBuyout")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeONFT{}, "OmniFlix/onft/MsgRevokeONFT")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeDenom{}, "OmniFlix/onft/MsgFreezeDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreezeDenom{}, "OmniFlix/onft/MsgUnfreezeDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgNestONFT{}, "OmniFlix/onft/MsgNestONFT")
+	legacy.RegisterAminoMsg(cdc, &MsgUnnestONFT{}, "OmniFlix/onft/MsgUnnestONFT")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateEditions{}, "OmniFlix/onft/MsgCreateEditions")
+	legacy.RegisterAminoMsg(cdc, &MsgPrintEdition{}, "OmniFlix/onft/MsgPrintEdition")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDataHistoryRetention{}, "OmniFlix/onft/MsgUpdateDataHistory")
 
 	cdc.RegisterConcrete(&Params{}, "OmniFlix/onft/Params", nil)
 
@@ -35,7 +62,34 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgMintONFT{},
 		&MsgBurnONFT{},
 		&MsgUpdateONFTData{},
+		&MsgUpdateONFTRoyaltyReceivers{},
 		&MsgUpdateParams{},
+		&MsgBatchMintONFT{},
+		&MsgBatchTransferONFT{},
+		&MsgBatchBurnONFT{},
+		&MsgGrantMinter{},
+		&MsgRevokeMinter{},
+		&MsgCloseMinting{},
+		&MsgApprove{},
+		&MsgRevoke{},
+		&MsgApproveAll{},
+		&MsgRevokeAll{},
+		&MsgSetONFTUser{},
+		&MsgRedeemMintVoucher{},
+		&MsgSetLaunchpad{},
+		&MsgLaunchpadMint{},
+		&MsgFractionalize{},
+		&MsgRedeem{},
+		&MsgBuyout{},
+		&MsgClaimBuyout{},
+		&MsgRevokeONFT{},
+		&MsgFreezeDenom{},
+		&MsgUnfreezeDenom{},
+		&MsgNestONFT{},
+		&MsgUnnestONFT{},
+		&MsgCreateEditions{},
+		&MsgPrintEdition{},
+		&MsgUpdateDataHistoryRetention{},
 	)
 
 	registry.RegisterInterface(
@@ -11,4 +11,10 @@ const (
 	DoNotModify       = "[do-not-modify]"
 	IDPrefix          = "onft"
 	DenomPrefix       = "onftdenom"
+	MaxBatchSize      = 500
+	MaxReasonLen      = 64
+	MaxNestingDepth   = 5
+	MaxEditions       = 10000
+	MaxTraits         = 64
+	MaxTraitLen       = 128
 )
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewONFTDataVersion(
+	denomID,
+	onftID string,
+	version uint64,
+	data string,
+	height int64,
+	timestamp time.Time,
+	updater sdk.AccAddress,
+) ONFTDataVersion {
+	return ONFTDataVersion{
+		DenomId: denomID,
+		OnftId:  onftID,
+		Version: version,
+		Data:    data,
+		Height:  height,
+		Time:    timestamp,
+		Updater: updater.String(),
+	}
+}
+
+func (v ONFTDataVersion) Validate() error {
+	if strings.TrimSpace(v.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(v.OnftId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing onft id")
+	}
+	if v.Version == 0 {
+		return errorsmod.Wrap(ErrInvalidDataVersion, "version must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Updater); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDataVersion, "invalid updater address %s", v.Updater)
+	}
+	return nil
+}
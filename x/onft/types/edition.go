@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateMaxEditions checks the edition cap of a master oNFT
+func ValidateMaxEditions(maxEditions uint64) error {
+	if maxEditions == 0 || maxEditions > MaxEditions {
+		return errorsmod.Wrapf(
+			ErrInvalidEdition,
+			"invalid max editions %d, must be between [1, %d]", maxEditions, MaxEditions,
+		)
+	}
+	return nil
+}
+
+func (s EditionSet) Validate() error {
+	if strings.TrimSpace(s.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(s.MasterId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing master id")
+	}
+	if err := ValidateMaxEditions(s.MaxEditions); err != nil {
+		return err
+	}
+	if s.Printed > s.MaxEditions {
+		return errorsmod.Wrapf(
+			ErrMaxEditionsReached,
+			"printed editions %d of master %s exceed the max editions %d", s.Printed, s.MasterId, s.MaxEditions,
+		)
+	}
+	return nil
+}
+
+func (e Edition) Validate() error {
+	if strings.TrimSpace(e.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(e.OnftId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing onft id")
+	}
+	if strings.TrimSpace(e.MasterId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing master id")
+	}
+	if e.OnftId == e.MasterId {
+		return errorsmod.Wrap(ErrInvalidEdition, "edition id can not be the master id")
+	}
+	if e.Number == 0 {
+		return errorsmod.Wrapf(ErrInvalidEdition, "edition %s has no number", e.OnftId)
+	}
+	return nil
+}
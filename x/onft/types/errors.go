@@ -34,4 +34,34 @@ var (
 	ErrInvalidRoyaltyReceivers = errorsmod.Register(ModuleName, 27, "invalid royalty receivers")
 	ErrNotAllowed              = errorsmod.Register(ModuleName, 28, "not allowed ")
 	ErrInvalidData             = errorsmod.Register(ModuleName, 29, "invalid data")
+	ErrInvalidBatch            = errorsmod.Register(ModuleName, 30, "invalid batch")
+	ErrInvalidMinter           = errorsmod.Register(ModuleName, 31, "invalid minter")
+	ErrMintQuotaExceeded       = errorsmod.Register(ModuleName, 32, "mint quota exceeded")
+	ErrMaxSupplyReached        = errorsmod.Register(ModuleName, 33, "max supply reached")
+	ErrMintingClosed           = errorsmod.Register(ModuleName, 34, "minting closed")
+	ErrInvalidApproval         = errorsmod.Register(ModuleName, 35, "invalid approval")
+	ErrInvalidONFTUser         = errorsmod.Register(ModuleName, 36, "invalid onft user")
+	ErrInvalidSchema           = errorsmod.Register(ModuleName, 37, "invalid schema")
+	ErrInvalidDataVersion      = errorsmod.Register(ModuleName, 38, "invalid data version")
+	ErrInvalidVoucher          = errorsmod.Register(ModuleName, 39, "invalid mint voucher")
+	ErrInvalidLaunchpad        = errorsmod.Register(ModuleName, 40, "invalid launchpad")
+	ErrMintPhaseNotActive      = errorsmod.Register(ModuleName, 41, "mint phase not active")
+	ErrNotAllowlisted          = errorsmod.Register(ModuleName, 42, "address not in allowlist")
+	ErrWalletLimitReached      = errorsmod.Register(ModuleName, 43, "wallet mint limit reached")
+	ErrPhaseSupplyReached      = errorsmod.Register(ModuleName, 44, "phase supply reached")
+	ErrInvalidVault            = errorsmod.Register(ModuleName, 45, "invalid vault")
+	ErrVaultNotActive          = errorsmod.Register(ModuleName, 46, "vault not active")
+	ErrNotRevocable            = errorsmod.Register(ModuleName, 47, "denom not revocable")
+	ErrInvalidRevocation       = errorsmod.Register(ModuleName, 48, "invalid revocation")
+	ErrDenomFrozen             = errorsmod.Register(ModuleName, 49, "denom frozen")
+	ErrDenomNotFrozen          = errorsmod.Register(ModuleName, 50, "denom not frozen")
+	ErrTransferLocked          = errorsmod.Register(ModuleName, 51, "nft transfer locked")
+	ErrInvalidNesting          = errorsmod.Register(ModuleName, 52, "invalid nesting")
+	ErrMaxNestingDepth         = errorsmod.Register(ModuleName, 53, "max nesting depth exceeded")
+	ErrHasChildren             = errorsmod.Register(ModuleName, 54, "nft has nested children")
+	ErrInvalidEdition          = errorsmod.Register(ModuleName, 55, "invalid edition")
+	ErrMaxEditionsReached      = errorsmod.Register(ModuleName, 56, "max editions reached")
+	ErrInvalidTraits           = errorsmod.Register(ModuleName, 57, "invalid traits")
+	ErrInvalidOwnershipRecord  = errorsmod.Register(ModuleName, 58, "invalid ownership record")
+	ErrInvalidHistoryRetention = errorsmod.Register(ModuleName, 59, "invalid data history retention")
 )
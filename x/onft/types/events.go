@@ -5,11 +5,47 @@ const (
 	EventTypeUpdateONFTDenom   = "update_onft_denom"
 	EventTypeTransferONFTDenom = "transfer_onft_denom"
 	EventTypePurgeONFTDenom    = "purge_onft_denom"
+	EventTypeCloseMinting      = "close_minting"
 
 	EventTypeMintONFT     = "mint_onft"
 	EventTypeTransferONFT = "transfer_onft"
 	EventTypeBurnONFT     = "burn_onft"
 
+	EventTypeUpdateONFTRoyaltyReceivers = "update_onft_royalty_receivers"
+
+	EventTypeGrantMinter  = "grant_minter"
+	EventTypeRevokeMinter = "revoke_minter"
+
+	EventTypeApproveONFT = "approve_onft"
+	EventTypeRevokeONFT  = "revoke_onft"
+	EventTypeApproveAll  = "approve_all"
+	EventTypeRevokeAll   = "revoke_all"
+
+	EventTypeSetONFTUser = "set_onft_user"
+
+	EventTypeRedeemMintVoucher = "redeem_mint_voucher"
+
+	EventTypeSetLaunchpad  = "set_launchpad"
+	EventTypeLaunchpadMint = "launchpad_mint"
+
+	EventTypeFractionalize = "fractionalize_onft"
+	EventTypeRedeemVault   = "redeem_vault"
+	EventTypeBuyoutVault   = "buyout_vault"
+	EventTypeClaimBuyout   = "claim_buyout"
+
+	EventTypeRevocation = "onft_revocation"
+
+	EventTypeFreezeDenom   = "freeze_denom"
+	EventTypeUnfreezeDenom = "unfreeze_denom"
+
+	EventTypeNestONFT   = "nest_onft"
+	EventTypeUnnestONFT = "unnest_onft"
+
+	EventTypeCreateEditions = "create_editions"
+	EventTypePrintEdition   = "print_edition"
+
+	EventTypeUpdateDataHistoryRetention = "update_data_history_retention"
+
 	AttributeValueCategory       = ModuleName
 	AttributeKeySender           = "sender"
 	AttributeKeyCreator          = "creator"
@@ -23,4 +59,28 @@ const (
 	AttributeKeyMediaURI         = "media-uri"
 	AttributeKeyPreviewURI       = "preview-uri"
 	AttributeKeyRoyaltyReceivers = "royalty-receivers"
+	AttributeKeyMinter           = "minter"
+	AttributeKeyQuota            = "quota"
+	AttributeKeyExpiry           = "expiry"
+	AttributeKeyTotalSupply      = "total-supply"
+	AttributeKeySpender          = "spender"
+	AttributeKeyOperator         = "operator"
+	AttributeKeyUser             = "user"
+	AttributeKeySigner           = "signer"
+	AttributeKeyNonce            = "nonce"
+	AttributeKeyPrice            = "price"
+	AttributeKeyPhaseID          = "phase-id"
+	AttributeKeyVaultID          = "vault-id"
+	AttributeKeyFractionDenom    = "fraction-denom"
+	AttributeKeySupply           = "supply"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyHolder           = "holder"
+	AttributeKeyReason           = "reason"
+	AttributeKeyReclaimed        = "reclaimed"
+	AttributeKeyParentDenomID    = "parent-denom-id"
+	AttributeKeyParentNFTID      = "parent-nft-id"
+	AttributeKeyMasterID         = "master-id"
+	AttributeKeyMaxEditions      = "max-editions"
+	AttributeKeyEditionNumber    = "edition-number"
+	AttributeKeyRetention        = "retention"
 )
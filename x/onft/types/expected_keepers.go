@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // AccountKeeper defines the expected account keeper for query account
@@ -12,7 +14,9 @@ type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	GetModuleAccount(ctx context.Context, name string) sdk.ModuleAccountI
 	GetModuleAddress(module string) sdk.AccAddress
+	GetModulePermissions() map[string]authtypes.PermissionsForAddress
 	AddressCodec() address.Codec
+	GetParams(ctx context.Context) authtypes.Params
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -22,6 +26,15 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+}
+
+// TokenFactoryKeeper defines the expected tokenfactory keeper used to mint the fractions of vaults
+type TokenFactoryKeeper interface {
+	CreateDenomWithPayer(ctx sdk.Context, creatorAddr, subdenom, payerAddr string) (string, error)
+	MintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error
+	BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error
 }
 
 // DistributionKeeper defines the expected distribution keeper
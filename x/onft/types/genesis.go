@@ -6,10 +6,43 @@ import (
 	errorsmod "github.com/pkg/errors"
 )
 
-func NewGenesisState(collections []Collection, params Params) *GenesisState {
+func NewGenesisState(
+	collections []Collection,
+	params Params,
+	minters []Minter,
+	approvals []Approval,
+	operators []OperatorApproval,
+	users []ONFTUser,
+	dataHistory []ONFTDataVersion,
+	voucherNonces []VoucherNonce,
+	launchpads []Launchpad,
+	launchpadWalletMints []LaunchpadWalletMint,
+	vaults []Vault,
+	nextVaultID uint64,
+	revocations []Revocation,
+	nestings []Nesting,
+	editionSets []EditionSet,
+	editions []Edition,
+	ownershipHistory []OwnershipRecord,
+) *GenesisState {
 	return &GenesisState{
-		Collections: collections,
-		Params:      params,
+		Collections:          collections,
+		Params:               params,
+		Minters:              minters,
+		Approvals:            approvals,
+		Operators:            operators,
+		Users:                users,
+		DataHistory:          dataHistory,
+		VoucherNonces:        voucherNonces,
+		Launchpads:           launchpads,
+		LaunchpadWalletMints: launchpadWalletMints,
+		Vaults:               vaults,
+		NextVaultId:          nextVaultID,
+		Revocations:          revocations,
+		Nestings:             nestings,
+		EditionSets:          editionSets,
+		Editions:             editions,
+		OwnershipHistory:     ownershipHistory,
 	}
 }
 
@@ -64,6 +97,88 @@ func ValidateGenesis(data GenesisState) error {
 			}
 		}
 	}
+	for _, minter := range data.Minters {
+		if err := minter.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, approval := range data.Approvals {
+		if err := approval.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, operator := range data.Operators {
+		if err := operator.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, user := range data.Users {
+		if err := user.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, version := range data.DataHistory {
+		if err := version.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, nonce := range data.VoucherNonces {
+		if err := nonce.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, launchpad := range data.Launchpads {
+		if err := launchpad.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, walletMint := range data.LaunchpadWalletMints {
+		if err := walletMint.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, vault := range data.Vaults {
+		if err := vault.Validate(); err != nil {
+			return err
+		}
+		if vault.Id >= data.NextVaultId {
+			return errorsmod.Wrapf(ErrInvalidVault, "vault id %d must be less than next vault id %d", vault.Id, data.NextVaultId)
+		}
+	}
+	for _, revocation := range data.Revocations {
+		if err := revocation.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, nesting := range data.Nestings {
+		if err := nesting.Validate(); err != nil {
+			return err
+		}
+	}
+	editionSets := make(map[string]EditionSet, len(data.EditionSets))
+	for _, editionSet := range data.EditionSets {
+		if err := editionSet.Validate(); err != nil {
+			return err
+		}
+		editionSets[editionSet.DenomId+"/"+editionSet.MasterId] = editionSet
+	}
+	for _, edition := range data.Editions {
+		if err := edition.Validate(); err != nil {
+			return err
+		}
+		editionSet, ok := editionSets[edition.DenomId+"/"+edition.MasterId]
+		if !ok || edition.Number > editionSet.Printed {
+			return errorsmod.Wrapf(
+				ErrInvalidEdition,
+				"edition %s is not printed from master %s", edition.OnftId, edition.MasterId,
+			)
+		}
+	}
+	for _, record := range data.OwnershipHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
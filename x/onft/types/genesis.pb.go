@@ -25,8 +25,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the nft module's genesis state.
 type GenesisState struct {
-	Collections []Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
-	Params      Params       `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Collections          []Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
+	Params               Params                `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Minters              []Minter              `protobuf:"bytes,3,rep,name=minters,proto3" json:"minters"`
+	Approvals            []Approval            `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals"`
+	Operators            []OperatorApproval    `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators"`
+	Users                []ONFTUser            `protobuf:"bytes,6,rep,name=users,proto3" json:"users"`
+	DataHistory          []ONFTDataVersion     `protobuf:"bytes,7,rep,name=data_history,json=dataHistory,proto3" json:"data_history"`
+	VoucherNonces        []VoucherNonce        `protobuf:"bytes,8,rep,name=voucher_nonces,json=voucherNonces,proto3" json:"voucher_nonces"`
+	Launchpads           []Launchpad           `protobuf:"bytes,9,rep,name=launchpads,proto3" json:"launchpads"`
+	LaunchpadWalletMints []LaunchpadWalletMint `protobuf:"bytes,10,rep,name=launchpad_wallet_mints,json=launchpadWalletMints,proto3" json:"launchpad_wallet_mints"`
+	Vaults               []Vault               `protobuf:"bytes,11,rep,name=vaults,proto3" json:"vaults"`
+	NextVaultId          uint64                `protobuf:"varint,12,opt,name=next_vault_id,json=nextVaultId,proto3" json:"next_vault_id,omitempty"`
+	Revocations          []Revocation          `protobuf:"bytes,13,rep,name=revocations,proto3" json:"revocations"`
+	Nestings             []Nesting             `protobuf:"bytes,14,rep,name=nestings,proto3" json:"nestings"`
+	EditionSets          []EditionSet          `protobuf:"bytes,15,rep,name=edition_sets,json=editionSets,proto3" json:"edition_sets"`
+	Editions             []Edition             `protobuf:"bytes,16,rep,name=editions,proto3" json:"editions"`
+	OwnershipHistory     []OwnershipRecord     `protobuf:"bytes,17,rep,name=ownership_history,json=ownershipHistory,proto3" json:"ownership_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +91,111 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMinters() []Minter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApprovals() []Approval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperators() []OperatorApproval {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsers() []ONFTUser {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataHistory() []ONFTDataVersion {
+	if m != nil {
+		return m.DataHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoucherNonces() []VoucherNonce {
+	if m != nil {
+		return m.VoucherNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLaunchpads() []Launchpad {
+	if m != nil {
+		return m.Launchpads
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLaunchpadWalletMints() []LaunchpadWalletMint {
+	if m != nil {
+		return m.LaunchpadWalletMints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVaults() []Vault {
+	if m != nil {
+		return m.Vaults
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextVaultId() uint64 {
+	if m != nil {
+		return m.NextVaultId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRevocations() []Revocation {
+	if m != nil {
+		return m.Revocations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNestings() []Nesting {
+	if m != nil {
+		return m.Nestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEditionSets() []EditionSet {
+	if m != nil {
+		return m.EditionSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEditions() []Edition {
+	if m != nil {
+		return m.Editions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOwnershipHistory() []OwnershipRecord {
+	if m != nil {
+		return m.OwnershipHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "OmniFlix.onft.v1beta1.GenesisState")
 }
@@ -85,23 +205,47 @@ func init() {
 }
 
 var fileDescriptor_95e11c2b95418a25 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xf6, 0xcf, 0xcd, 0xcb,
-	0x74, 0xcb, 0xc9, 0xac, 0xd0, 0xcf, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x29, 0xd2, 0x03, 0x29, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x14, 0xb0, 0x9b, 0x08, 0xd6, 0x09, 0x51, 0xa1, 0x84,
-	0x5d, 0x45, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x4a, 0xa5, 0x69, 0x8c, 0x5c, 0x3c, 0xee, 0x10,
-	0x47, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x79, 0x72, 0x71, 0x27, 0xe7, 0xe7, 0xe4, 0xa4, 0x26,
-	0x97, 0x64, 0xe6, 0xe7, 0x15, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x29, 0xea, 0x61, 0x75,
-	0x99, 0x9e, 0x33, 0x5c, 0xa5, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0xc8, 0x7a, 0x85, 0xac,
-	0xb9, 0xd8, 0x20, 0x76, 0x49, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe2, 0x30, 0x25, 0x00,
-	0xac, 0x08, 0x6a, 0x02, 0x54, 0x8b, 0x93, 0xe7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x23, 0x7c,
-	0x98, 0x9b, 0x97, 0x99, 0x96, 0x93, 0x59, 0x91, 0x51, 0x9a, 0xa4, 0x5f, 0x66, 0xaa, 0x0f, 0xf5,
-	0x72, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xab, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x62, 0x26, 0xb7, 0x1a, 0x84, 0x01, 0x00, 0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x4f, 0xd4, 0x40,
+	0x18, 0x87, 0x77, 0x05, 0x16, 0x76, 0x76, 0x41, 0x98, 0xa0, 0x99, 0x10, 0x2d, 0x05, 0xa2, 0x12,
+	0x0f, 0x6d, 0xc0, 0xc4, 0x83, 0xc4, 0x44, 0x41, 0x51, 0xfc, 0xc3, 0x92, 0x45, 0x31, 0x7a, 0x69,
+	0x66, 0xbb, 0xc3, 0x76, 0x92, 0xee, 0x4c, 0x33, 0x33, 0x2d, 0xf0, 0x2d, 0xfc, 0x58, 0x5c, 0x4c,
+	0x38, 0x7a, 0x32, 0x86, 0xfd, 0x22, 0xa6, 0xd3, 0x69, 0xd9, 0xe8, 0xb6, 0xde, 0x76, 0x66, 0x9f,
+	0xdf, 0xd3, 0xf7, 0xcd, 0xbc, 0x33, 0x60, 0xa3, 0x33, 0x64, 0x74, 0x3f, 0xa4, 0xe7, 0x2e, 0x67,
+	0xa7, 0xca, 0x4d, 0xb6, 0x7a, 0x44, 0xe1, 0x2d, 0x77, 0x40, 0x18, 0x91, 0x54, 0x3a, 0x91, 0xe0,
+	0x8a, 0xc3, 0x3b, 0x39, 0xe4, 0xa4, 0x90, 0x63, 0xa0, 0x95, 0xe5, 0x01, 0x1f, 0x70, 0x4d, 0xb8,
+	0xe9, 0xaf, 0x0c, 0x5e, 0xb1, 0x27, 0x1b, 0x75, 0x32, 0x23, 0xd6, 0x27, 0x13, 0x11, 0x16, 0x78,
+	0x68, 0x3e, 0xb9, 0xf2, 0x60, 0x32, 0x13, 0xe2, 0x98, 0xf9, 0x41, 0x84, 0xfb, 0x06, 0x5b, 0x9b,
+	0x8c, 0x25, 0x38, 0x0e, 0xcd, 0xd7, 0xd6, 0x7f, 0x34, 0x41, 0xfb, 0x4d, 0xd6, 0xce, 0xb1, 0xc2,
+	0x8a, 0xc0, 0x03, 0xd0, 0xf2, 0x79, 0x18, 0x12, 0x5f, 0x51, 0xce, 0x24, 0xaa, 0xdb, 0x53, 0x9b,
+	0xad, 0xed, 0x35, 0x67, 0x62, 0x8f, 0xce, 0x5e, 0x41, 0xee, 0x4e, 0x5f, 0xfe, 0x5a, 0xad, 0x75,
+	0xc7, 0xb3, 0x70, 0x07, 0x34, 0xb2, 0xaa, 0xd1, 0x2d, 0xbb, 0xbe, 0xd9, 0xda, 0xbe, 0x5f, 0x62,
+	0x39, 0xd2, 0x90, 0x31, 0x98, 0x08, 0x7c, 0x0e, 0x66, 0x87, 0x94, 0x29, 0x22, 0x24, 0x9a, 0xb2,
+	0xa7, 0x2a, 0xd2, 0x1f, 0x35, 0x65, 0xd2, 0x79, 0x06, 0xee, 0x81, 0x26, 0x8e, 0x22, 0xc1, 0x13,
+	0x1c, 0x4a, 0x34, 0xad, 0x05, 0xab, 0x25, 0x82, 0x97, 0x86, 0x33, 0x8a, 0x9b, 0x1c, 0x7c, 0x0f,
+	0x9a, 0x3c, 0x22, 0x02, 0x2b, 0x2e, 0x24, 0x9a, 0xd1, 0x92, 0x47, 0x25, 0x92, 0x8e, 0xe1, 0xfe,
+	0x96, 0x15, 0x79, 0xb8, 0x03, 0x66, 0x62, 0x99, 0xb6, 0xd3, 0xa8, 0xac, 0xa6, 0x73, 0xb8, 0xff,
+	0xe9, 0xb3, 0x2c, 0x1a, 0xca, 0x32, 0xb0, 0x03, 0xda, 0x7d, 0xac, 0xb0, 0x17, 0x50, 0xa9, 0xb8,
+	0xb8, 0x40, 0xb3, 0xda, 0xf1, 0xb0, 0xc2, 0xf1, 0x0a, 0x2b, 0x7c, 0x42, 0x84, 0x1c, 0x3b, 0x9b,
+	0xd4, 0xf0, 0x36, 0x13, 0xc0, 0x23, 0xb0, 0x90, 0xf0, 0xd8, 0x0f, 0x88, 0xf0, 0x18, 0x67, 0x3e,
+	0x91, 0x68, 0x4e, 0x2b, 0x37, 0x4a, 0x94, 0x27, 0x19, 0x7c, 0x98, 0xb2, 0xc6, 0x37, 0x9f, 0x8c,
+	0xed, 0x49, 0xb8, 0x0f, 0x40, 0x31, 0x7f, 0x12, 0x35, 0xb5, 0xcd, 0x2e, 0xb1, 0x7d, 0xc8, 0x41,
+	0xa3, 0x1a, 0x4b, 0xc2, 0x53, 0x70, 0xb7, 0x58, 0x79, 0x67, 0x38, 0x0c, 0x89, 0xf2, 0xd2, 0x53,
+	0x95, 0x08, 0x68, 0xe7, 0xe3, 0xff, 0x39, 0xbf, 0xe8, 0x4c, 0x3a, 0x16, 0xc6, 0xbe, 0x1c, 0xfe,
+	0xfb, 0x97, 0x84, 0xcf, 0x40, 0x43, 0x5f, 0x04, 0x89, 0x5a, 0xda, 0x7b, 0xaf, 0xac, 0xf3, 0x14,
+	0xca, 0x87, 0x33, 0x4b, 0xc0, 0x75, 0x30, 0xcf, 0xc8, 0xb9, 0xf2, 0xf4, 0xd2, 0xa3, 0x7d, 0xd4,
+	0xb6, 0xeb, 0x9b, 0xd3, 0xdd, 0x56, 0xba, 0xa9, 0xf9, 0x83, 0x7e, 0x7a, 0x91, 0x04, 0x49, 0xb8,
+	0x8f, 0xb3, 0x8b, 0x34, 0x5f, 0x79, 0x91, 0xba, 0x05, 0x99, 0x1f, 0xd6, 0x58, 0x16, 0xbe, 0x00,
+	0x73, 0x8c, 0x48, 0x45, 0xd9, 0x40, 0xa2, 0x05, 0xed, 0xb1, 0x4a, 0x3c, 0x87, 0x19, 0x66, 0x24,
+	0x45, 0x0a, 0xbe, 0x03, 0x6d, 0xd2, 0xa7, 0xa9, 0xcd, 0x93, 0x44, 0x49, 0x74, 0xbb, 0xb2, 0x9a,
+	0xd7, 0x19, 0x7a, 0x4c, 0xf2, 0xbe, 0x5b, 0xa4, 0xd8, 0xd1, 0xd5, 0x98, 0xa5, 0x44, 0x8b, 0x95,
+	0xd5, 0x18, 0x4f, 0x5e, 0x4d, 0x9e, 0x82, 0x5f, 0xc1, 0x12, 0x3f, 0x63, 0x44, 0xc8, 0x80, 0x46,
+	0xc5, 0x48, 0x2f, 0x55, 0x8f, 0x74, 0xce, 0x77, 0x89, 0xcf, 0x45, 0x3e, 0x37, 0x8b, 0x85, 0xc6,
+	0xcc, 0xf5, 0xee, 0xc1, 0xe5, 0xb5, 0x55, 0xbf, 0xba, 0xb6, 0xea, 0xbf, 0xaf, 0xad, 0xfa, 0xf7,
+	0x91, 0x55, 0xbb, 0x1a, 0x59, 0xb5, 0x9f, 0x23, 0xab, 0xf6, 0xcd, 0x1d, 0x50, 0x15, 0xc4, 0x3d,
+	0xc7, 0xe7, 0x43, 0xf7, 0xe6, 0x5d, 0x1c, 0x32, 0x7a, 0x1a, 0xd2, 0xf3, 0x20, 0xee, 0xb9, 0xc9,
+	0x53, 0xd7, 0x3c, 0x94, 0xea, 0x22, 0x22, 0xb2, 0xd7, 0xd0, 0x2f, 0xe4, 0x93, 0x3f, 0x03, 0x00,
+	0xe0, 0x08, 0xb0, 0xa6, 0x05, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +268,211 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnershipHistory) > 0 {
+		for iNdEx := len(m.OwnershipHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnershipHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Editions) > 0 {
+		for iNdEx := len(m.Editions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Editions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.EditionSets) > 0 {
+		for iNdEx := len(m.EditionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EditionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Nestings) > 0 {
+		for iNdEx := len(m.Nestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Revocations) > 0 {
+		for iNdEx := len(m.Revocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextVaultId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVaultId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.LaunchpadWalletMints) > 0 {
+		for iNdEx := len(m.LaunchpadWalletMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LaunchpadWalletMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Launchpads) > 0 {
+		for iNdEx := len(m.Launchpads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Launchpads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VoucherNonces) > 0 {
+		for iNdEx := len(m.VoucherNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoucherNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DataHistory) > 0 {
+		for iNdEx := len(m.DataHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -176,42 +525,129 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataHistory) > 0 {
+		for _, e := range m.DataHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoucherNonces) > 0 {
+		for _, e := range m.VoucherNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Launchpads) > 0 {
+		for _, e := range m.Launchpads {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LaunchpadWalletMints) > 0 {
+		for _, e := range m.LaunchpadWalletMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextVaultId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVaultId))
+	}
+	if len(m.Revocations) > 0 {
+		for _, e := range m.Revocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Nestings) > 0 {
+		for _, e := range m.Nestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EditionSets) > 0 {
+		for _, e := range m.EditionSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Editions) > 0 {
+		for _, e := range m.Editions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OwnershipHistory) > 0 {
+		for _, e := range m.OwnershipHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -281,6 +717,501 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, OperatorApproval{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, ONFTUser{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHistory = append(m.DataHistory, ONFTDataVersion{})
+			if err := m.DataHistory[len(m.DataHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherNonces = append(m.VoucherNonces, VoucherNonce{})
+			if err := m.VoucherNonces[len(m.VoucherNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launchpads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Launchpads = append(m.Launchpads, Launchpad{})
+			if err := m.Launchpads[len(m.Launchpads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchpadWalletMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaunchpadWalletMints = append(m.LaunchpadWalletMints, LaunchpadWalletMint{})
+			if err := m.LaunchpadWalletMints[len(m.LaunchpadWalletMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVaultId", wireType)
+			}
+			m.NextVaultId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVaultId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revocations = append(m.Revocations, Revocation{})
+			if err := m.Revocations[len(m.Revocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nestings = append(m.Nestings, Nesting{})
+			if err := m.Nestings[len(m.Nestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditionSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditionSets = append(m.EditionSets, EditionSet{})
+			if err := m.EditionSets[len(m.EditionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editions = append(m.Editions, Edition{})
+			if err := m.Editions[len(m.Editions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnershipHistory = append(m.OwnershipHistory, OwnershipRecord{})
+			if err := m.OwnershipHistory[len(m.OwnershipHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,10 +2,14 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 )
 
+var Delimiter = []byte{0x00}
+
 const (
 	ModuleName = "onft"
 	StoreKey   = ModuleName
@@ -19,8 +23,222 @@ var (
 	PrefixDenom      = []byte{0x04}
 
 	ParamsKey = []byte{0x07}
+
+	PrefixMinter   = []byte{0x08}
+	PrefixApproval = []byte{0x09}
+	PrefixOperator = []byte{0x0A}
+	PrefixONFTUser = []byte{0x0B}
+
+	PrefixDataHistory  = []byte{0x0C}
+	PrefixVoucherNonce = []byte{0x0D}
+
+	PrefixLaunchpad           = []byte{0x0E}
+	PrefixLaunchpadWalletMint = []byte{0x0F}
+
+	PrefixVault    = []byte{0x10}
+	NextVaultIDKey = []byte{0x11}
+
+	PrefixRevocation = []byte{0x12}
+
+	PrefixNestParent   = []byte{0x13}
+	PrefixNestChildren = []byte{0x14}
+
+	PrefixEditionSet    = []byte{0x15}
+	PrefixEdition       = []byte{0x16}
+	PrefixMasterEdition = []byte{0x17}
+
+	PrefixTraitONFT  = []byte{0x18}
+	PrefixTraitCount = []byte{0x19}
+
+	PrefixOwnershipHistory = []byte{0x1A}
 )
 
+// KeyMinterPrefix returns the store prefix of all minters of a denom
+func KeyMinterPrefix(denomID string) []byte {
+	key := append(PrefixMinter, []byte(denomID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyMinter returns the store key of a denom minter
+func KeyMinter(denomID string, minter sdk.AccAddress) []byte {
+	return append(KeyMinterPrefix(denomID), minter.Bytes()...)
+}
+
+// KeyApprovalPrefix returns the store prefix of all approvals of an onft
+func KeyApprovalPrefix(denomID, onftID string) []byte {
+	key := append(PrefixApproval, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	key = append(key, []byte(onftID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyApproval returns the store key of an onft approval
+func KeyApproval(denomID, onftID string, spender sdk.AccAddress) []byte {
+	return append(KeyApprovalPrefix(denomID, onftID), spender.Bytes()...)
+}
+
+// KeyOperatorPrefix returns the store prefix of all operators of an owner
+func KeyOperatorPrefix(owner sdk.AccAddress) []byte {
+	return append(PrefixOperator, address.MustLengthPrefix(owner.Bytes())...)
+}
+
+// KeyOperator returns the store key of an operator approval
+func KeyOperator(owner, operator sdk.AccAddress) []byte {
+	return append(KeyOperatorPrefix(owner), operator.Bytes()...)
+}
+
+// KeyONFTUser returns the store key of the user of an onft
+func KeyONFTUser(denomID, onftID string) []byte {
+	key := append(PrefixONFTUser, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	return append(key, []byte(onftID)...)
+}
+
+// KeyDataHistoryPrefix returns the store prefix of the data history of an onft
+func KeyDataHistoryPrefix(denomID, onftID string) []byte {
+	key := append(PrefixDataHistory, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	key = append(key, []byte(onftID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyDataVersion returns the store key of a data version of an onft
+func KeyDataVersion(denomID, onftID string, version uint64) []byte {
+	return append(KeyDataHistoryPrefix(denomID, onftID), sdk.Uint64ToBigEndian(version)...)
+}
+
+// KeyOwnershipHistoryPrefix returns the store prefix of the ownership history of an onft
+func KeyOwnershipHistoryPrefix(denomID, onftID string) []byte {
+	key := append(PrefixOwnershipHistory, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	key = append(key, []byte(onftID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyOwnershipRecord returns the store key of an ownership change of an onft
+func KeyOwnershipRecord(denomID, onftID string, sequence uint64) []byte {
+	return append(KeyOwnershipHistoryPrefix(denomID, onftID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyVoucherNonce returns the store key of a redeemed voucher nonce of a signer
+func KeyVoucherNonce(denomID string, signer sdk.AccAddress, nonce uint64) []byte {
+	key := append(PrefixVoucherNonce, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	key = append(key, address.MustLengthPrefix(signer.Bytes())...)
+	return append(key, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// KeyLaunchpad returns the store key of the launchpad of a denom
+func KeyLaunchpad(denomID string) []byte {
+	return append(PrefixLaunchpad, []byte(denomID)...)
+}
+
+// KeyLaunchpadWalletMintPrefix returns the store prefix of the wallet mints of a launchpad
+func KeyLaunchpadWalletMintPrefix(denomID string) []byte {
+	key := append(PrefixLaunchpadWalletMint, []byte(denomID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyLaunchpadWalletMint returns the store key of the mints of an address in a mint phase
+func KeyLaunchpadWalletMint(denomID string, phaseID uint64, addr sdk.AccAddress) []byte {
+	key := append(KeyLaunchpadWalletMintPrefix(denomID), sdk.Uint64ToBigEndian(phaseID)...)
+	return append(key, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// KeyVault returns the store key of a vault
+func KeyVault(vaultID uint64) []byte {
+	return append(PrefixVault, sdk.Uint64ToBigEndian(vaultID)...)
+}
+
+// KeyRevocationPrefix returns the store prefix of the revocations of a denom,
+// or of a single onft of the denom if onftID is not empty
+func KeyRevocationPrefix(denomID, onftID string) []byte {
+	key := append(PrefixRevocation, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	if onftID == "" {
+		return key
+	}
+	key = append(key, []byte(onftID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyRevocation returns the store key of a revocation of an onft
+func KeyRevocation(denomID, onftID string, sequence uint64) []byte {
+	return append(KeyRevocationPrefix(denomID, onftID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyNestParent returns the store key of the nesting of an onft in its parent
+func KeyNestParent(denomID, onftID string) []byte {
+	key := append(PrefixNestParent, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	return append(key, []byte(onftID)...)
+}
+
+// KeyNestChildrenPrefix returns the store prefix of the onfts nested in a parent onft
+func KeyNestChildrenPrefix(parentDenomID, parentID string) []byte {
+	key := append(PrefixNestChildren, []byte(parentDenomID)...)
+	key = append(key, Delimiter...)
+	key = append(key, []byte(parentID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyNestChild returns the store key of an onft nested in a parent onft
+func KeyNestChild(parentDenomID, parentID, denomID, onftID string) []byte {
+	key := append(KeyNestChildrenPrefix(parentDenomID, parentID), []byte(denomID)...)
+	key = append(key, Delimiter...)
+	return append(key, []byte(onftID)...)
+}
+
+// KeyEditionSet returns the store key of the edition set of a master onft
+func KeyEditionSet(denomID, masterID string) []byte {
+	key := append(PrefixEditionSet, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	return append(key, []byte(masterID)...)
+}
+
+// KeyEdition returns the store key of the edition of a printed onft
+func KeyEdition(denomID, onftID string) []byte {
+	key := append(PrefixEdition, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	return append(key, []byte(onftID)...)
+}
+
+// KeyMasterEditionsPrefix returns the store prefix of the editions printed from a master onft
+func KeyMasterEditionsPrefix(denomID, masterID string) []byte {
+	key := append(PrefixMasterEdition, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	key = append(key, []byte(masterID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyMasterEdition returns the store key of a numbered edition of a master onft
+func KeyMasterEdition(denomID, masterID string, number uint64) []byte {
+	return append(KeyMasterEditionsPrefix(denomID, masterID), sdk.Uint64ToBigEndian(number)...)
+}
+
+// KeyTraitCountPrefix returns the store prefix of the trait counts of a denom
+func KeyTraitCountPrefix(denomID string) []byte {
+	key := append(PrefixTraitCount, []byte(denomID)...)
+	return append(key, Delimiter...)
+}
+
+// KeyTraitCount returns the store key of the count of onfts of a denom with a trait value
+func KeyTraitCount(denomID string, trait Trait) []byte {
+	return append(KeyTraitCountPrefix(denomID), trait.key()...)
+}
+
+// KeyTraitONFTsPrefix returns the store prefix of the onfts of a denom with a trait value
+func KeyTraitONFTsPrefix(denomID string, trait Trait) []byte {
+	key := append(PrefixTraitONFT, []byte(denomID)...)
+	key = append(key, Delimiter...)
+	return append(key, trait.key()...)
+}
+
+// KeyTraitONFT returns the store key of an onft of a denom with a trait value
+func KeyTraitONFT(denomID string, trait Trait, onftID string) []byte {
+	return append(KeyTraitONFTsPrefix(denomID, trait), []byte(onftID)...)
+}
+
 func MustUnMarshalSupply(cdc codec.BinaryCodec, value []byte) uint64 {
 	var supplyWrap gogotypes.UInt64Value
 	cdc.MustUnmarshal(value, &supplyWrap)
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxMintPhases maximum number of mint phases in a launchpad
+const MaxMintPhases = 20
+
+func (l Launchpad) Validate() error {
+	if strings.TrimSpace(l.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if err := ValidateName(l.Name); err != nil {
+		return err
+	}
+	if err := ValidateDescription(l.Description); err != nil {
+		return err
+	}
+	if err := ValidateMediaURI(l.MediaURI); err != nil {
+		return err
+	}
+	if err := ValidateURI(l.PreviewURI); err != nil {
+		return err
+	}
+	if l.RoyaltyShare.IsNil() || l.RoyaltyShare.IsNegative() || l.RoyaltyShare.GTE(sdkmath.LegacyNewDec(1)) {
+		return errorsmod.Wrapf(ErrInvalidPercentage, "invalid royalty share percentage decimal value; %s, must be positive and less than 1", l.RoyaltyShare)
+	}
+	if l.SplitShares != nil {
+		if err := ValidateWeightedAddresses(l.SplitShares); err != nil {
+			return errorsmod.Wrapf(ErrInvalidLaunchpad, "invalid split shares; %s", err)
+		}
+	}
+	if len(l.Phases) == 0 || len(l.Phases) > MaxMintPhases {
+		return errorsmod.Wrapf(ErrInvalidLaunchpad, "number of phases must be between [1, %d]", MaxMintPhases)
+	}
+	phaseIds := make(map[uint64]bool, len(l.Phases))
+	for _, phase := range l.Phases {
+		if phaseIds[phase.Id] {
+			return errorsmod.Wrapf(ErrInvalidLaunchpad, "duplicate phase id %d", phase.Id)
+		}
+		phaseIds[phase.Id] = true
+		if err := phase.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPhase returns the mint phase with the given id
+func (l Launchpad) GetPhase(phaseID uint64) (*MintPhase, bool) {
+	for i := range l.Phases {
+		if l.Phases[i].Id == phaseID {
+			return &l.Phases[i], true
+		}
+	}
+	return nil, false
+}
+
+// TokenMetadata returns the metadata of the oNFT with the given token number
+func (l Launchpad) TokenMetadata(tokenNumber uint64) Metadata {
+	metadata := Metadata{
+		Name:        fmt.Sprintf("%s #%d", l.Name, tokenNumber),
+		Description: l.Description,
+		MediaURI:    fmt.Sprintf("%s/%d", strings.TrimSuffix(l.MediaURI, "/"), tokenNumber),
+	}
+	if len(l.PreviewURI) > 0 {
+		metadata.PreviewURI = fmt.Sprintf("%s/%d", strings.TrimSuffix(l.PreviewURI, "/"), tokenNumber)
+	}
+	return metadata
+}
+
+func (p MintPhase) Validate() error {
+	if !p.EndTime.After(p.StartTime) {
+		return errorsmod.Wrapf(ErrInvalidLaunchpad, "end time of phase %d must be after start time", p.Id)
+	}
+	if !p.Price.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidLaunchpad, "invalid price %s of phase %d", p.Price.String(), p.Id)
+	}
+	if len(p.MerkleRoot) != 0 && len(p.MerkleRoot) != 32 {
+		return errorsmod.Wrapf(ErrInvalidLaunchpad, "merkle root of phase %d must be a sha256 hash", p.Id)
+	}
+	if p.Supply != 0 && p.Minted > p.Supply {
+		return errorsmod.Wrapf(ErrInvalidLaunchpad, "minted count of phase %d exceeds supply", p.Id)
+	}
+	return nil
+}
+
+// IsActive returns true if the phase is open for minting at the given time
+func (p MintPhase) IsActive(blockTime time.Time) bool {
+	return !blockTime.Before(p.StartTime) && blockTime.Before(p.EndTime)
+}
+
+// HasAllowlist returns true if minting in the phase is restricted to an allowlist
+func (p MintPhase) HasAllowlist() bool {
+	return len(p.MerkleRoot) > 0
+}
+
+func (m LaunchpadWalletMint) GetAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Address)
+	return addr
+}
+
+func (m LaunchpadWalletMint) Validate() error {
+	if strings.TrimSpace(m.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidLaunchpad, "invalid address %s", m.Address)
+	}
+	return nil
+}
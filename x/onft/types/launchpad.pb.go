@@ -0,0 +1,1595 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/launchpad.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Launchpad defines the mint configuration of a denom drop, oNFTs are minted
+// to the buyers during the mint phases with sequential token numbers
+type Launchpad struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	// name of the minted oNFTs, formatted as "<name> #<token-number>"
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// base media uri of the minted oNFTs, formatted as "<media_uri>/<token-number>"
+	MediaURI string `protobuf:"bytes,4,opt,name=media_uri,json=mediaUri,proto3" json:"media_uri,omitempty" yaml:"media_uri"`
+	// optional base preview uri of the minted oNFTs, formatted as "<preview_uri>/<token-number>"
+	PreviewURI   string                      `protobuf:"bytes,5,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty" yaml:"preview_uri"`
+	Data         string                      `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Transferable bool                        `protobuf:"varint,7,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	Extensible   bool                        `protobuf:"varint,8,opt,name=extensible,proto3" json:"extensible,omitempty"`
+	Nsfw         bool                        `protobuf:"varint,9,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	RoyaltyShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=royalty_share,json=royaltyShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"royalty_share" yaml:"royalty_share"`
+	// split_shares of the mint proceeds, the denom royalty receivers are used when empty
+	SplitShares []*WeightedAddress `protobuf:"bytes,11,rep,name=split_shares,json=splitShares,proto3" json:"split_shares,omitempty" yaml:"split_shares"`
+	Phases      []MintPhase        `protobuf:"bytes,12,rep,name=phases,proto3" json:"phases"`
+	// minted is the last token number minted through the launchpad
+	Minted uint64 `protobuf:"varint,13,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *Launchpad) Reset()         { *m = Launchpad{} }
+func (m *Launchpad) String() string { return proto.CompactTextString(m) }
+func (*Launchpad) ProtoMessage()    {}
+func (*Launchpad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10bcc999cc38c24b, []int{0}
+}
+func (m *Launchpad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Launchpad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Launchpad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Launchpad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Launchpad.Merge(m, src)
+}
+func (m *Launchpad) XXX_Size() int {
+	return m.Size()
+}
+func (m *Launchpad) XXX_DiscardUnknown() {
+	xxx_messageInfo_Launchpad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Launchpad proto.InternalMessageInfo
+
+// MintPhase defines a timed mint phase of a launchpad
+type MintPhase struct {
+	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartTime time.Time  `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time  `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Price     types.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	// merkle_root of the allowlist, empty root allows every address to mint
+	MerkleRoot []byte `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
+	// per_wallet_limit is the maximum number of mints per address, 0 for unlimited
+	PerWalletLimit uint64 `protobuf:"varint,7,opt,name=per_wallet_limit,json=perWalletLimit,proto3" json:"per_wallet_limit,omitempty" yaml:"per_wallet_limit"`
+	// supply is the maximum number of mints in the phase, 0 for unlimited
+	Supply uint64 `protobuf:"varint,8,opt,name=supply,proto3" json:"supply,omitempty"`
+	Minted uint64 `protobuf:"varint,9,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *MintPhase) Reset()         { *m = MintPhase{} }
+func (m *MintPhase) String() string { return proto.CompactTextString(m) }
+func (*MintPhase) ProtoMessage()    {}
+func (*MintPhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10bcc999cc38c24b, []int{1}
+}
+func (m *MintPhase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintPhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintPhase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintPhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintPhase.Merge(m, src)
+}
+func (m *MintPhase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintPhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintPhase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintPhase proto.InternalMessageInfo
+
+// LaunchpadWalletMint defines the number of mints of an address in a mint phase
+type LaunchpadWalletMint struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	PhaseId uint64 `protobuf:"varint,2,opt,name=phase_id,json=phaseId,proto3" json:"phase_id,omitempty" yaml:"phase_id"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Minted  uint64 `protobuf:"varint,4,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *LaunchpadWalletMint) Reset()         { *m = LaunchpadWalletMint{} }
+func (m *LaunchpadWalletMint) String() string { return proto.CompactTextString(m) }
+func (*LaunchpadWalletMint) ProtoMessage()    {}
+func (*LaunchpadWalletMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10bcc999cc38c24b, []int{2}
+}
+func (m *LaunchpadWalletMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaunchpadWalletMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaunchpadWalletMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaunchpadWalletMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaunchpadWalletMint.Merge(m, src)
+}
+func (m *LaunchpadWalletMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaunchpadWalletMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaunchpadWalletMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaunchpadWalletMint proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Launchpad)(nil), "OmniFlix.onft.v1beta1.Launchpad")
+	proto.RegisterType((*MintPhase)(nil), "OmniFlix.onft.v1beta1.MintPhase")
+	proto.RegisterType((*LaunchpadWalletMint)(nil), "OmniFlix.onft.v1beta1.LaunchpadWalletMint")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/launchpad.proto", fileDescriptor_10bcc999cc38c24b)
+}
+
+var fileDescriptor_10bcc999cc38c24b = []byte{
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0x5b, 0xb7, 0x49, 0x26, 0xd9, 0x65, 0x99, 0x2e, 0xbb, 0xa6, 0x15, 0x76, 0x64, 0x01,
+	0xca, 0x95, 0xad, 0x2d, 0x02, 0x24, 0x10, 0x48, 0x04, 0x16, 0xa9, 0x52, 0x2b, 0x56, 0x03, 0xab,
+	0x45, 0xdc, 0x84, 0xb1, 0x3d, 0x49, 0x46, 0x6b, 0x7b, 0xac, 0x99, 0x49, 0xdb, 0x5c, 0xf2, 0x06,
+	0xfb, 0x16, 0xbc, 0x08, 0x17, 0xbd, 0xdc, 0x4b, 0xc4, 0x85, 0x81, 0xf4, 0x0d, 0xf2, 0x04, 0x68,
+	0x7e, 0x9c, 0xba, 0xa8, 0x08, 0xed, 0xdd, 0xf9, 0xf9, 0xe6, 0x3b, 0x67, 0xe6, 0x7c, 0x67, 0xc0,
+	0x07, 0xdf, 0x15, 0x25, 0xfd, 0x36, 0xa7, 0x97, 0x31, 0x2b, 0x67, 0x32, 0x3e, 0x7f, 0x92, 0x10,
+	0x89, 0x9f, 0xc4, 0x39, 0x5e, 0x96, 0xe9, 0xa2, 0xc2, 0x59, 0x54, 0x71, 0x26, 0x19, 0x7c, 0xa7,
+	0x81, 0x45, 0x0a, 0x16, 0x59, 0xd8, 0xe1, 0xc3, 0x39, 0x9b, 0x33, 0x8d, 0x88, 0x95, 0x65, 0xc0,
+	0x87, 0xc1, 0x9c, 0xb1, 0x79, 0x4e, 0x62, 0xed, 0x25, 0xcb, 0x59, 0x2c, 0x69, 0x41, 0x84, 0xc4,
+	0x45, 0x65, 0x01, 0x7e, 0xca, 0x44, 0xc1, 0x44, 0x9c, 0x60, 0x41, 0xb6, 0x25, 0x53, 0x46, 0x4b,
+	0x9b, 0x1f, 0xdd, 0xdd, 0x94, 0x2e, 0xad, 0x11, 0xe1, 0x2f, 0x7b, 0xa0, 0x7f, 0xda, 0xf4, 0x08,
+	0x23, 0xd0, 0xcb, 0x48, 0xc9, 0x8a, 0x29, 0xcd, 0x3c, 0x67, 0xe4, 0x8c, 0xfb, 0x93, 0x83, 0x4d,
+	0x1d, 0xbc, 0xb5, 0xc2, 0x45, 0xfe, 0x59, 0xd8, 0x64, 0x42, 0xd4, 0xd5, 0xe6, 0x49, 0x06, 0x21,
+	0x70, 0x4b, 0x5c, 0x10, 0x6f, 0x47, 0x61, 0x91, 0xb6, 0xe1, 0x08, 0x0c, 0x32, 0x22, 0x52, 0x4e,
+	0x2b, 0x49, 0x59, 0xe9, 0xed, 0xea, 0x54, 0x3b, 0x04, 0xbf, 0x00, 0xfd, 0x82, 0x64, 0x14, 0x4f,
+	0x97, 0x9c, 0x7a, 0xae, 0x2e, 0x33, 0x5a, 0xd7, 0x41, 0xef, 0x4c, 0x05, 0x9f, 0xa3, 0x93, 0x4d,
+	0x1d, 0x3c, 0x30, 0x25, 0xb7, 0xb0, 0x10, 0xf5, 0xb4, 0xfd, 0x9c, 0x53, 0xf8, 0x14, 0x0c, 0x2a,
+	0x4e, 0xce, 0x29, 0xb9, 0xd0, 0x04, 0x7b, 0x9a, 0xe0, 0xfd, 0x75, 0x1d, 0x80, 0x67, 0x26, 0x6c,
+	0x28, 0xa0, 0xa1, 0x68, 0x41, 0x43, 0x04, 0xac, 0xa7, 0x68, 0x20, 0x70, 0x33, 0x2c, 0xb1, 0xb7,
+	0x6f, 0x7a, 0x57, 0x36, 0x0c, 0xc1, 0x50, 0x72, 0x5c, 0x8a, 0x19, 0xe1, 0x38, 0xc9, 0x89, 0xd7,
+	0x1d, 0x39, 0xe3, 0x1e, 0xba, 0x15, 0x83, 0x3e, 0x00, 0xe4, 0x52, 0x92, 0x52, 0x50, 0x85, 0xe8,
+	0x69, 0x44, 0x2b, 0xa2, 0xdf, 0x44, 0xcc, 0x2e, 0xbc, 0xbe, 0xce, 0x68, 0x1b, 0xfe, 0x0c, 0xee,
+	0x71, 0xb6, 0xc2, 0xb9, 0x5c, 0x4d, 0xc5, 0x02, 0x73, 0xe2, 0x01, 0xdd, 0xf4, 0xe7, 0x57, 0x75,
+	0xd0, 0xf9, 0xa3, 0x0e, 0x8e, 0xcc, 0x18, 0x45, 0xf6, 0x32, 0xa2, 0x2c, 0x2e, 0xb0, 0x5c, 0x44,
+	0xa7, 0x64, 0x8e, 0xd3, 0xd5, 0x37, 0x24, 0xdd, 0xd4, 0xc1, 0x43, 0x73, 0x93, 0x5b, 0x0c, 0x21,
+	0x1a, 0x5a, 0xff, 0x7b, 0xe5, 0xc2, 0x04, 0x0c, 0x45, 0x95, 0x53, 0x69, 0xb2, 0xc2, 0x1b, 0x8c,
+	0x76, 0xc7, 0x83, 0xe3, 0x0f, 0xa3, 0x3b, 0xe5, 0x16, 0xbd, 0x20, 0x74, 0xbe, 0x90, 0x24, 0xfb,
+	0x2a, 0xcb, 0x38, 0x11, 0x62, 0xf2, 0x78, 0x53, 0x07, 0x07, 0xa6, 0x4a, 0x9b, 0x25, 0x44, 0x03,
+	0xed, 0xea, 0x12, 0x02, 0x7e, 0x09, 0xf6, 0xab, 0x05, 0x16, 0x44, 0x78, 0x43, 0xcd, 0x3e, 0xfa,
+	0x0f, 0xf6, 0x33, 0x5a, 0xca, 0x67, 0x0a, 0x38, 0x71, 0xd5, 0x05, 0x91, 0x3d, 0x05, 0x1f, 0x81,
+	0xfd, 0x82, 0x96, 0x92, 0x64, 0xde, 0xbd, 0x91, 0x33, 0x76, 0x91, 0xf5, 0xc2, 0xdf, 0x76, 0x41,
+	0x7f, 0x7b, 0x06, 0xde, 0x07, 0x3b, 0x56, 0x7d, 0x2e, 0xda, 0xa1, 0x77, 0x6b, 0xec, 0x47, 0x00,
+	0x84, 0xc4, 0x5c, 0x4e, 0xd5, 0x42, 0x68, 0x89, 0x0d, 0x8e, 0x0f, 0x23, 0xb3, 0x2d, 0x51, 0xb3,
+	0x2d, 0xd1, 0x0f, 0xcd, 0xb6, 0x4c, 0xde, 0x53, 0x7d, 0x6c, 0xea, 0xe0, 0x6d, 0x7b, 0xc7, 0xed,
+	0xd9, 0xf0, 0xd5, 0x9f, 0x81, 0x83, 0xfa, 0x3a, 0xa0, 0xe0, 0x10, 0x81, 0x1e, 0x29, 0x33, 0xc3,
+	0xeb, 0xfe, 0x2f, 0xef, 0x91, 0xe5, 0xb5, 0x1b, 0xd2, 0x9c, 0x34, 0xac, 0x5d, 0x52, 0x66, 0x9a,
+	0xf3, 0x63, 0xb0, 0x57, 0x71, 0x9a, 0x12, 0x2d, 0xd5, 0xc1, 0xf1, 0xbb, 0x91, 0x19, 0x77, 0xa4,
+	0xb6, 0x76, 0xfb, 0x68, 0x5f, 0x33, 0x5a, 0xda, 0xf7, 0x32, 0x68, 0xf8, 0x29, 0x18, 0x14, 0x84,
+	0xbf, 0xcc, 0xc9, 0x94, 0x33, 0x26, 0xb5, 0x4e, 0x87, 0x93, 0x47, 0x37, 0xca, 0x6e, 0x25, 0x43,
+	0x04, 0x8c, 0x87, 0x18, 0x93, 0xf0, 0x29, 0x78, 0x50, 0x11, 0x3e, 0xbd, 0xc0, 0x79, 0x4e, 0xe4,
+	0x34, 0xa7, 0x05, 0x95, 0x5a, 0xc9, 0xee, 0xe4, 0x68, 0x53, 0x07, 0x8f, 0xed, 0x5e, 0xfc, 0x0b,
+	0x11, 0xa2, 0xfb, 0x15, 0xe1, 0x2f, 0x74, 0xe4, 0x54, 0x05, 0xd4, 0xb8, 0xc4, 0xb2, 0xaa, 0xf2,
+	0x95, 0x16, 0xb9, 0x8b, 0xac, 0xd7, 0x1a, 0x63, 0xff, 0xd6, 0x18, 0x7f, 0x75, 0xc0, 0xc1, 0xf6,
+	0x2b, 0x31, 0x44, 0x6a, 0xaa, 0x6f, 0xfc, 0xa9, 0x44, 0xa0, 0xa7, 0x05, 0xa3, 0xf0, 0x3b, 0xba,
+	0xed, 0x16, 0xbe, 0xc9, 0x84, 0xa8, 0xab, 0xcd, 0x93, 0x0c, 0x7a, 0xa0, 0x8b, 0x8d, 0x8e, 0xed,
+	0x67, 0xd3, 0xb8, 0xad, 0x4e, 0xdd, 0x76, 0xa7, 0x93, 0xb3, 0xab, 0xbf, 0xfd, 0xce, 0xd5, 0xda,
+	0x77, 0x5e, 0xaf, 0x7d, 0xe7, 0xaf, 0xb5, 0xef, 0xbc, 0xba, 0xf6, 0x3b, 0xaf, 0xaf, 0xfd, 0xce,
+	0xef, 0xd7, 0x7e, 0xe7, 0xa7, 0x78, 0x4e, 0xe5, 0x62, 0x99, 0x44, 0x29, 0x2b, 0xe2, 0x9b, 0xff,
+	0xb3, 0x28, 0xe9, 0x2c, 0xa7, 0x97, 0x8b, 0x65, 0x12, 0x9f, 0x7f, 0x12, 0xdb, 0x0f, 0x55, 0xae,
+	0x2a, 0x22, 0x92, 0x7d, 0xad, 0x8c, 0x8f, 0xfe, 0x19, 0x00, 0x0b, 0xad, 0x74, 0x99, 0x03, 0x06,
+	0x00, 0x00,
+}
+
+func (m *Launchpad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Launchpad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Launchpad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintLaunchpad(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLaunchpad(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SplitShares) > 0 {
+		for iNdEx := len(m.SplitShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLaunchpad(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.RoyaltyShare.Size()
+		i -= size
+		if _, err := m.RoyaltyShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLaunchpad(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Nsfw {
+		i--
+		if m.Nsfw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Extensible {
+		i--
+		if m.Extensible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Transferable {
+		i--
+		if m.Transferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PreviewURI) > 0 {
+		i -= len(m.PreviewURI)
+		copy(dAtA[i:], m.PreviewURI)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.PreviewURI)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MediaURI) > 0 {
+		i -= len(m.MediaURI)
+		copy(dAtA[i:], m.MediaURI)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.MediaURI)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintPhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintLaunchpad(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Supply != 0 {
+		i = encodeVarintLaunchpad(dAtA, i, uint64(m.Supply))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PerWalletLimit != 0 {
+		i = encodeVarintLaunchpad(dAtA, i, uint64(m.PerWalletLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLaunchpad(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLaunchpad(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLaunchpad(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLaunchpad(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaunchpadWalletMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaunchpadWalletMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaunchpadWalletMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintLaunchpad(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PhaseId != 0 {
+		i = encodeVarintLaunchpad(dAtA, i, uint64(m.PhaseId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintLaunchpad(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLaunchpad(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLaunchpad(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Launchpad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	l = len(m.MediaURI)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	l = len(m.PreviewURI)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	if m.Transferable {
+		n += 2
+	}
+	if m.Extensible {
+		n += 2
+	}
+	if m.Nsfw {
+		n += 2
+	}
+	l = m.RoyaltyShare.Size()
+	n += 1 + l + sovLaunchpad(uint64(l))
+	if len(m.SplitShares) > 0 {
+		for _, e := range m.SplitShares {
+			l = e.Size()
+			n += 1 + l + sovLaunchpad(uint64(l))
+		}
+	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovLaunchpad(uint64(l))
+		}
+	}
+	if m.Minted != 0 {
+		n += 1 + sovLaunchpad(uint64(m.Minted))
+	}
+	return n
+}
+
+func (m *MintPhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLaunchpad(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLaunchpad(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLaunchpad(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovLaunchpad(uint64(l))
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	if m.PerWalletLimit != 0 {
+		n += 1 + sovLaunchpad(uint64(m.PerWalletLimit))
+	}
+	if m.Supply != 0 {
+		n += 1 + sovLaunchpad(uint64(m.Supply))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovLaunchpad(uint64(m.Minted))
+	}
+	return n
+}
+
+func (m *LaunchpadWalletMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	if m.PhaseId != 0 {
+		n += 1 + sovLaunchpad(uint64(m.PhaseId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLaunchpad(uint64(l))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovLaunchpad(uint64(m.Minted))
+	}
+	return n
+}
+
+func sovLaunchpad(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLaunchpad(x uint64) (n int) {
+	return sovLaunchpad(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Launchpad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLaunchpad
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Launchpad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Launchpad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extensible = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nsfw = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitShares = append(m.SplitShares, &WeightedAddress{})
+			if err := m.SplitShares[len(m.SplitShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, MintPhase{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLaunchpad(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintPhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLaunchpad
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerWalletLimit", wireType)
+			}
+			m.PerWalletLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerWalletLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			m.Supply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Supply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLaunchpad(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaunchpadWalletMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLaunchpad
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaunchpadWalletMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaunchpadWalletMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseId", wireType)
+			}
+			m.PhaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLaunchpad(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLaunchpad
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLaunchpad(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLaunchpad
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLaunchpad
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLaunchpad
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLaunchpad
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLaunchpad
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLaunchpad        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLaunchpad          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLaunchpad = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllowlistLeaf returns the merkle leaf of an address in a mint phase allowlist,
+// the leaf is the sha256 hash of the bech32 address
+func AllowlistLeaf(addr sdk.AccAddress) []byte {
+	hash := sha256.Sum256([]byte(addr.String()))
+	return hash[:]
+}
+
+// VerifyMerkleProof verifies the proof of leaf against root, sibling hashes are
+// combined in sorted order so the proof does not carry the position of the leaf
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = hashMerklePair(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
+
+func hashMerklePair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return hash[:]
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewMinter(denomID string, address sdk.AccAddress, quota uint64, expiry *time.Time) Minter {
+	return Minter{
+		DenomId: denomID,
+		Address: address.String(),
+		Quota:   quota,
+		Expiry:  expiry,
+	}
+}
+
+func (m Minter) GetAddress() sdk.AccAddress {
+	address, _ := sdk.AccAddressFromBech32(m.Address)
+	return address
+}
+
+// IsExpired returns true if the minter has an expiry and it is not after the given time
+func (m Minter) IsExpired(blockTime time.Time) bool {
+	return m.Expiry != nil && !m.Expiry.After(blockTime)
+}
+
+// HasQuota returns true if the minter can mint count more oNFTs
+func (m Minter) HasQuota(count uint64) bool {
+	return m.Quota == 0 || m.Minted+count <= m.Quota
+}
+
+// IsActive returns true if the minter is not expired and has quota to mint at least one oNFT
+func (m Minter) IsActive(blockTime time.Time) bool {
+	return !m.IsExpired(blockTime) && m.HasQuota(1)
+}
+
+func (m Minter) Validate() error {
+	if strings.TrimSpace(m.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMinter, "invalid minter address %s", m.Address)
+	}
+	if m.Quota != 0 && m.Minted > m.Quota {
+		return errorsmod.Wrapf(ErrInvalidMinter, "minted count %d exceeds quota %d", m.Minted, m.Quota)
+	}
+	return nil
+}
@@ -2,11 +2,13 @@ package types
 
 import (
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	sdkmath "cosmossdk.io/math"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -20,6 +22,46 @@ const (
 	TypeMsgTransferONFT   = "transfer_onft"
 	TypeMsgBurnONFT       = "burn_onft"
 	TypeMsgUpdateONFTData = "update_onft_data"
+
+	TypeMsgUpdateONFTRoyaltyReceivers = "update_onft_royalty_receivers"
+
+	TypeMsgBatchMintONFT     = "batch_mint_onft"
+	TypeMsgBatchTransferONFT = "batch_transfer_onft"
+	TypeMsgBatchBurnONFT     = "batch_burn_onft"
+
+	TypeMsgGrantMinter  = "grant_minter"
+	TypeMsgRevokeMinter = "revoke_minter"
+	TypeMsgCloseMinting = "close_minting"
+
+	TypeMsgApprove    = "approve"
+	TypeMsgRevoke     = "revoke"
+	TypeMsgApproveAll = "approve_all"
+	TypeMsgRevokeAll  = "revoke_all"
+
+	TypeMsgSetONFTUser = "set_onft_user"
+
+	TypeMsgRedeemMintVoucher = "redeem_mint_voucher"
+
+	TypeMsgSetLaunchpad  = "set_launchpad"
+	TypeMsgLaunchpadMint = "launchpad_mint"
+
+	TypeMsgFractionalize = "fractionalize"
+	TypeMsgRedeem        = "redeem"
+	TypeMsgBuyout        = "buyout"
+	TypeMsgClaimBuyout   = "claim_buyout"
+
+	TypeMsgRevokeONFT = "revoke_onft"
+
+	TypeMsgFreezeDenom   = "freeze_denom"
+	TypeMsgUnfreezeDenom = "unfreeze_denom"
+
+	TypeMsgNestONFT   = "nest_onft"
+	TypeMsgUnnestONFT = "unnest_onft"
+
+	TypeMsgCreateEditions = "create_editions"
+	TypeMsgPrintEdition   = "print_edition"
+
+	TypeMsgUpdateDataHistoryRetention = "update_data_history_retention"
 )
 
 var (
@@ -32,6 +74,47 @@ var (
 	_ sdk.Msg = &MsgMintONFT{}
 	_ sdk.Msg = &MsgTransferONFT{}
 	_ sdk.Msg = &MsgBurnONFT{}
+
+	_ sdk.Msg = &MsgUpdateONFTData{}
+	_ sdk.Msg = &MsgUpdateONFTRoyaltyReceivers{}
+
+	_ sdk.Msg = &MsgBatchMintONFT{}
+	_ sdk.Msg = &MsgBatchTransferONFT{}
+	_ sdk.Msg = &MsgBatchBurnONFT{}
+
+	_ sdk.Msg = &MsgGrantMinter{}
+	_ sdk.Msg = &MsgRevokeMinter{}
+	_ sdk.Msg = &MsgCloseMinting{}
+
+	_ sdk.Msg = &MsgApprove{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgApproveAll{}
+	_ sdk.Msg = &MsgRevokeAll{}
+
+	_ sdk.Msg = &MsgSetONFTUser{}
+
+	_ sdk.Msg = &MsgRedeemMintVoucher{}
+
+	_ sdk.Msg = &MsgSetLaunchpad{}
+	_ sdk.Msg = &MsgLaunchpadMint{}
+
+	_ sdk.Msg = &MsgFractionalize{}
+	_ sdk.Msg = &MsgRedeem{}
+	_ sdk.Msg = &MsgBuyout{}
+	_ sdk.Msg = &MsgClaimBuyout{}
+
+	_ sdk.Msg = &MsgRevokeONFT{}
+
+	_ sdk.Msg = &MsgFreezeDenom{}
+	_ sdk.Msg = &MsgUnfreezeDenom{}
+
+	_ sdk.Msg = &MsgNestONFT{}
+	_ sdk.Msg = &MsgUnnestONFT{}
+
+	_ sdk.Msg = &MsgCreateEditions{}
+	_ sdk.Msg = &MsgPrintEdition{}
+
+	_ sdk.Msg = &MsgUpdateDataHistoryRetention{}
 )
 
 func NewMsgCreateDenom(
@@ -40,6 +123,9 @@ func NewMsgCreateDenom(
 	creationFee sdk.Coin,
 	royaltyReceivers []*WeightedAddress,
 	updatableData bool,
+	maxSupply uint64,
+	revocable bool,
+	indexedTraits bool,
 ) *MsgCreateDenom {
 	return &MsgCreateDenom{
 		Sender:           sender,
@@ -55,6 +141,11 @@ func NewMsgCreateDenom(
 		CreationFee:      creationFee,
 		RoyaltyReceivers: royaltyReceivers,
 		UpdatableData:    updatableData,
+		MaxSupply:        maxSupply,
+		Revocable:        revocable,
+		IndexedTraits:    indexedTraits,
+
+		DataHistoryRetention: DefaultDataHistoryRetention,
 	}
 }
 
@@ -87,11 +178,17 @@ func (msg MsgCreateDenom) ValidateBasic() error {
 	if err := ValidateCreationFee(msg.CreationFee); err != nil {
 		return err
 	}
+	if _, err := CompileDataSchema(msg.Schema); err != nil {
+		return err
+	}
 	if msg.RoyaltyReceivers != nil {
 		if err := ValidateWeightedAddresses(msg.RoyaltyReceivers); err != nil {
 			return errorsmod.Wrap(ErrInvalidRoyaltyReceivers, "royalty receivers value is invalid")
 		}
 	}
+	if err := ValidateDataHistoryRetention(msg.DataHistoryRetention); err != nil {
+		return err
+	}
 
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
@@ -224,18 +321,21 @@ func (msg MsgPurgeDenom) GetSigners() []sdk.AccAddress {
 func NewMsgMintONFT(
 	denomId, sender, recipient string, metadata Metadata, data string,
 	transferable, extensible, nsfw bool, royaltyShare sdkmath.LegacyDec,
+	royaltyReceivers []*WeightedAddress, transferLockedUntil *time.Time,
 ) *MsgMintONFT {
 	return &MsgMintONFT{
-		Id:           GenUniqueID(IDPrefix),
-		DenomId:      denomId,
-		Metadata:     metadata,
-		Data:         data,
-		Transferable: transferable,
-		Extensible:   extensible,
-		Nsfw:         nsfw,
-		RoyaltyShare: royaltyShare,
-		Sender:       sender,
-		Recipient:    recipient,
+		Id:                  GenUniqueID(IDPrefix),
+		DenomId:             denomId,
+		Metadata:            metadata,
+		Data:                data,
+		Transferable:        transferable,
+		Extensible:          extensible,
+		Nsfw:                nsfw,
+		RoyaltyShare:        royaltyShare,
+		RoyaltyReceivers:    royaltyReceivers,
+		TransferLockedUntil: transferLockedUntil,
+		Sender:              sender,
+		Recipient:           recipient,
 	}
 }
 
@@ -266,6 +366,11 @@ func (msg MsgMintONFT) ValidateBasic() error {
 	if msg.RoyaltyShare.IsNegative() || msg.RoyaltyShare.GTE(sdkmath.LegacyNewDec(1)) {
 		return errorsmod.Wrapf(ErrInvalidPercentage, "invalid royalty share percentage decimal value; %d, must be positive and less than 1", msg.RoyaltyShare)
 	}
+	if msg.RoyaltyReceivers != nil {
+		if err := ValidateWeightedAddresses(msg.RoyaltyReceivers); err != nil {
+			return errorsmod.Wrap(ErrInvalidRoyaltyReceivers, "royalty receivers value is invalid")
+		}
+	}
 
 	return ValidateONFTID(msg.Id)
 }
@@ -392,3 +497,959 @@ func (msg MsgUpdateONFTData) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+func NewMsgUpdateONFTRoyaltyReceivers(
+	denomId, id string,
+	royaltyReceivers []*WeightedAddress,
+	sender string,
+) *MsgUpdateONFTRoyaltyReceivers {
+	return &MsgUpdateONFTRoyaltyReceivers{
+		Id:               id,
+		DenomId:          denomId,
+		RoyaltyReceivers: royaltyReceivers,
+		Sender:           sender,
+	}
+}
+
+func (msg MsgUpdateONFTRoyaltyReceivers) Route() string { return RouterKey }
+
+func (msg MsgUpdateONFTRoyaltyReceivers) Type() string { return TypeMsgUpdateONFTRoyaltyReceivers }
+
+func (msg MsgUpdateONFTRoyaltyReceivers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.Id); err != nil {
+		return err
+	}
+	if len(msg.RoyaltyReceivers) > 0 {
+		if err := ValidateWeightedAddresses(msg.RoyaltyReceivers); err != nil {
+			return errorsmod.Wrap(ErrInvalidRoyaltyReceivers, "royalty receivers value is invalid")
+		}
+	}
+	return nil
+}
+
+func (msg MsgUpdateONFTRoyaltyReceivers) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgBatchMintONFT(denomId, sender string, onfts []MintONFTItem) *MsgBatchMintONFT {
+	return &MsgBatchMintONFT{
+		DenomId: denomId,
+		ONFTs:   onfts,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgBatchMintONFT) Route() string { return RouterKey }
+
+func (msg MsgBatchMintONFT) Type() string { return TypeMsgBatchMintONFT }
+
+func (msg MsgBatchMintONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateBatchSize(len(msg.ONFTs)); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(msg.ONFTs))
+	for _, item := range msg.ONFTs {
+		if seen[item.Id] {
+			return errorsmod.Wrapf(ErrInvalidBatch, "duplicate onft id %s in batch", item.Id)
+		}
+		seen[item.Id] = true
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgBatchMintONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// Validate does a sanity check on a single batch mint entry
+func (item MintONFTItem) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(item.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address; %s", err)
+	}
+	if err := ValidateName(item.Metadata.Name); err != nil {
+		return err
+	}
+	if err := ValidateDescription(item.Metadata.Description); err != nil {
+		return err
+	}
+	if err := ValidateMediaURI(item.Metadata.MediaURI); err != nil {
+		return err
+	}
+	if err := ValidateURI(item.Metadata.PreviewURI); err != nil {
+		return err
+	}
+	if item.RoyaltyShare.IsNil() || item.RoyaltyShare.IsNegative() || item.RoyaltyShare.GTE(sdkmath.LegacyNewDec(1)) {
+		return errorsmod.Wrapf(ErrInvalidPercentage, "invalid royalty share percentage decimal value; %s, must be positive and less than 1", item.RoyaltyShare)
+	}
+	if item.RoyaltyReceivers != nil {
+		if err := ValidateWeightedAddresses(item.RoyaltyReceivers); err != nil {
+			return errorsmod.Wrap(ErrInvalidRoyaltyReceivers, "royalty receivers value is invalid")
+		}
+	}
+	return ValidateONFTID(item.Id)
+}
+
+func NewMsgBatchTransferONFT(sender string, onfts []TransferONFTItem) *MsgBatchTransferONFT {
+	return &MsgBatchTransferONFT{
+		ONFTs:  onfts,
+		Sender: sender,
+	}
+}
+
+func (msg MsgBatchTransferONFT) Route() string { return RouterKey }
+
+func (msg MsgBatchTransferONFT) Type() string { return TypeMsgBatchTransferONFT }
+
+func (msg MsgBatchTransferONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateBatchSize(len(msg.ONFTs)); err != nil {
+		return err
+	}
+	for _, item := range msg.ONFTs {
+		if err := ValidateDenomID(item.DenomId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(item.Recipient); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address; %s", err)
+		}
+		if err := ValidateONFTID(item.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgBatchTransferONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgBatchBurnONFT(sender string, onfts []BurnONFTItem) *MsgBatchBurnONFT {
+	return &MsgBatchBurnONFT{
+		ONFTs:  onfts,
+		Sender: sender,
+	}
+}
+
+func (msg MsgBatchBurnONFT) Route() string { return RouterKey }
+
+func (msg MsgBatchBurnONFT) Type() string { return TypeMsgBatchBurnONFT }
+
+func (msg MsgBatchBurnONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateBatchSize(len(msg.ONFTs)); err != nil {
+		return err
+	}
+	for _, item := range msg.ONFTs {
+		if err := ValidateONFTID(item.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgBatchBurnONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgGrantMinter(denomId, minter string, quota uint64, expiry *time.Time, sender string) *MsgGrantMinter {
+	return &MsgGrantMinter{
+		DenomId: denomId,
+		Minter:  minter,
+		Quota:   quota,
+		Expiry:  expiry,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgGrantMinter) Route() string { return RouterKey }
+
+func (msg MsgGrantMinter) Type() string { return TypeMsgGrantMinter }
+
+func (msg MsgGrantMinter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address; %s", err)
+	}
+	if msg.Minter == msg.Sender {
+		return errorsmod.Wrap(ErrInvalidMinter, "sender can not grant minter role to self")
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	return nil
+}
+
+func (msg MsgGrantMinter) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeMinter(denomId, minter, sender string) *MsgRevokeMinter {
+	return &MsgRevokeMinter{
+		DenomId: denomId,
+		Minter:  minter,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevokeMinter) Route() string { return RouterKey }
+
+func (msg MsgRevokeMinter) Type() string { return TypeMsgRevokeMinter }
+
+func (msg MsgRevokeMinter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address; %s", err)
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	return nil
+}
+
+func (msg MsgRevokeMinter) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCloseMinting(denomId, sender string) *MsgCloseMinting {
+	return &MsgCloseMinting{
+		DenomId: denomId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgCloseMinting) Route() string { return RouterKey }
+
+func (msg MsgCloseMinting) Type() string { return TypeMsgCloseMinting }
+
+func (msg MsgCloseMinting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	return nil
+}
+
+func (msg MsgCloseMinting) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgApprove(denomId, onftId, spender string, expiry *time.Time, sender string) *MsgApprove {
+	return &MsgApprove{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Spender: spender,
+		Expiry:  expiry,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgApprove) Route() string { return RouterKey }
+
+func (msg MsgApprove) Type() string { return TypeMsgApprove }
+
+func (msg MsgApprove) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spender address; %s", err)
+	}
+	if msg.Spender == msg.Sender {
+		return errorsmod.Wrap(ErrInvalidApproval, "spender can not be same as sender")
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgApprove) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevoke(denomId, onftId, spender, sender string) *MsgRevoke {
+	return &MsgRevoke{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Spender: spender,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+func (msg MsgRevoke) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spender address; %s", err)
+	}
+	if msg.Spender == msg.Sender {
+		return errorsmod.Wrap(ErrInvalidApproval, "spender can not be same as sender")
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgApproveAll(operator string, expiry *time.Time, sender string) *MsgApproveAll {
+	return &MsgApproveAll{
+		Operator: operator,
+		Expiry:   expiry,
+		Sender:   sender,
+	}
+}
+
+func (msg MsgApproveAll) Route() string { return RouterKey }
+
+func (msg MsgApproveAll) Type() string { return TypeMsgApproveAll }
+
+func (msg MsgApproveAll) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address; %s", err)
+	}
+	if msg.Operator == msg.Sender {
+		return errorsmod.Wrap(ErrInvalidApproval, "operator can not be same as sender")
+	}
+	return nil
+}
+
+func (msg MsgApproveAll) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeAll(operator, sender string) *MsgRevokeAll {
+	return &MsgRevokeAll{
+		Operator: operator,
+		Sender:   sender,
+	}
+}
+
+func (msg MsgRevokeAll) Route() string { return RouterKey }
+
+func (msg MsgRevokeAll) Type() string { return TypeMsgRevokeAll }
+
+func (msg MsgRevokeAll) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address; %s", err)
+	}
+	if msg.Operator == msg.Sender {
+		return errorsmod.Wrap(ErrInvalidApproval, "operator can not be same as sender")
+	}
+	return nil
+}
+
+func (msg MsgRevokeAll) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetONFTUser(denomId, onftId, user string, expiry time.Time, sender string) *MsgSetONFTUser {
+	return &MsgSetONFTUser{
+		DenomId: denomId,
+		OnftId:  onftId,
+		User:    user,
+		Expiry:  expiry,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgSetONFTUser) Route() string { return RouterKey }
+
+func (msg MsgSetONFTUser) Type() string { return TypeMsgSetONFTUser }
+
+func (msg MsgSetONFTUser) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	// empty user removes the current user of the onft
+	if len(msg.User) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.User); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid user address; %s", err)
+		}
+		if msg.Expiry.IsZero() {
+			return errorsmod.Wrap(ErrInvalidONFTUser, "expiry is required")
+		}
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgSetONFTUser) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRedeemMintVoucher(voucher MintVoucher, pubKey, signature []byte, sender string) *MsgRedeemMintVoucher {
+	return &MsgRedeemMintVoucher{
+		Voucher:   voucher,
+		PubKey:    pubKey,
+		Signature: signature,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgRedeemMintVoucher) Route() string { return RouterKey }
+
+func (msg MsgRedeemMintVoucher) Type() string { return TypeMsgRedeemMintVoucher }
+
+func (msg MsgRedeemMintVoucher) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if len(msg.PubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(ErrInvalidVoucher, "invalid pub key length %d", len(msg.PubKey))
+	}
+	if len(msg.Signature) == 0 {
+		return errorsmod.Wrap(ErrInvalidVoucher, "missing signature")
+	}
+	return msg.Voucher.Validate()
+}
+
+func (msg MsgRedeemMintVoucher) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgSetLaunchpad(launchpad Launchpad, sender string) *MsgSetLaunchpad {
+	return &MsgSetLaunchpad{
+		Launchpad: launchpad,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgSetLaunchpad) Route() string { return RouterKey }
+
+func (msg MsgSetLaunchpad) Type() string { return TypeMsgSetLaunchpad }
+
+func (msg MsgSetLaunchpad) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	return msg.Launchpad.Validate()
+}
+
+func (msg MsgSetLaunchpad) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgLaunchpadMint(denomId string, phaseId uint64, proof [][]byte, sender string) *MsgLaunchpadMint {
+	return &MsgLaunchpadMint{
+		DenomId: strings.TrimSpace(denomId),
+		PhaseId: phaseId,
+		Proof:   proof,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgLaunchpadMint) Route() string { return RouterKey }
+
+func (msg MsgLaunchpadMint) Type() string { return TypeMsgLaunchpadMint }
+
+func (msg MsgLaunchpadMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	for _, hash := range msg.Proof {
+		if len(hash) != 32 {
+			return errorsmod.Wrap(ErrNotAllowlisted, "proof hashes must be sha256 hashes")
+		}
+	}
+	return nil
+}
+
+func (msg MsgLaunchpadMint) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgFractionalize(denomId, onftId string, supply sdkmath.Int, buyoutPrice sdk.Coin, sender string) *MsgFractionalize {
+	return &MsgFractionalize{
+		DenomId:     strings.TrimSpace(denomId),
+		OnftId:      strings.TrimSpace(onftId),
+		Supply:      supply,
+		BuyoutPrice: buyoutPrice,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgFractionalize) Route() string { return RouterKey }
+
+func (msg MsgFractionalize) Type() string { return TypeMsgFractionalize }
+
+func (msg MsgFractionalize) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if strings.TrimSpace(msg.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if msg.Supply.IsNil() || !msg.Supply.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidVault, "supply must be positive")
+	}
+	if !msg.BuyoutPrice.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidVault, "invalid buyout price %s", msg.BuyoutPrice.String())
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgFractionalize) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRedeem(vaultId uint64, sender string) *MsgRedeem {
+	return &MsgRedeem{
+		VaultId: vaultId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRedeem) Route() string { return RouterKey }
+
+func (msg MsgRedeem) Type() string { return TypeMsgRedeem }
+
+func (msg MsgRedeem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.VaultId == 0 {
+		return errorsmod.Wrap(ErrInvalidVault, "vault id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgRedeem) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgBuyout(vaultId uint64, sender string) *MsgBuyout {
+	return &MsgBuyout{
+		VaultId: vaultId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgBuyout) Route() string { return RouterKey }
+
+func (msg MsgBuyout) Type() string { return TypeMsgBuyout }
+
+func (msg MsgBuyout) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.VaultId == 0 {
+		return errorsmod.Wrap(ErrInvalidVault, "vault id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgBuyout) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgClaimBuyout(vaultId uint64, sender string) *MsgClaimBuyout {
+	return &MsgClaimBuyout{
+		VaultId: vaultId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgClaimBuyout) Route() string { return RouterKey }
+
+func (msg MsgClaimBuyout) Type() string { return TypeMsgClaimBuyout }
+
+func (msg MsgClaimBuyout) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.VaultId == 0 {
+		return errorsmod.Wrap(ErrInvalidVault, "vault id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgClaimBuyout) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeONFT(denomId, id, reason string, reclaim bool, sender string) *MsgRevokeONFT {
+	return &MsgRevokeONFT{
+		Id:      id,
+		DenomId: denomId,
+		Reason:  reason,
+		Reclaim: reclaim,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevokeONFT) Route() string { return RouterKey }
+
+func (msg MsgRevokeONFT) Type() string { return TypeMsgRevokeONFT }
+
+func (msg MsgRevokeONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.Id); err != nil {
+		return err
+	}
+	return ValidateRevocationReason(msg.Reason)
+}
+
+func (msg MsgRevokeONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgFreezeDenom(denomId, sender string) *MsgFreezeDenom {
+	return &MsgFreezeDenom{
+		Id:     denomId,
+		Sender: sender,
+	}
+}
+
+func (msg MsgFreezeDenom) Route() string { return RouterKey }
+
+func (msg MsgFreezeDenom) Type() string { return TypeMsgFreezeDenom }
+
+func (msg MsgFreezeDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	return ValidateDenomID(msg.Id)
+}
+
+func (msg MsgFreezeDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgUnfreezeDenom(denomId, sender string) *MsgUnfreezeDenom {
+	return &MsgUnfreezeDenom{
+		Id:     denomId,
+		Sender: sender,
+	}
+}
+
+func (msg MsgUnfreezeDenom) Route() string { return RouterKey }
+
+func (msg MsgUnfreezeDenom) Type() string { return TypeMsgUnfreezeDenom }
+
+func (msg MsgUnfreezeDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	return ValidateDenomID(msg.Id)
+}
+
+func (msg MsgUnfreezeDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgNestONFT(denomId, id, parentDenomId, parentId, sender string) *MsgNestONFT {
+	return &MsgNestONFT{
+		Id:            id,
+		DenomId:       denomId,
+		ParentDenomId: parentDenomId,
+		ParentId:      parentId,
+		Sender:        sender,
+	}
+}
+
+func (msg MsgNestONFT) Route() string { return RouterKey }
+
+func (msg MsgNestONFT) Type() string { return TypeMsgNestONFT }
+
+func (msg MsgNestONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.Id); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(msg.ParentDenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.ParentId); err != nil {
+		return err
+	}
+	if msg.DenomId == msg.ParentDenomId && msg.Id == msg.ParentId {
+		return errorsmod.Wrap(ErrInvalidNesting, "onft can not be nested in itself")
+	}
+	return nil
+}
+
+func (msg MsgNestONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgUnnestONFT(denomId, id, sender string) *MsgUnnestONFT {
+	return &MsgUnnestONFT{
+		Id:      id,
+		DenomId: denomId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgUnnestONFT) Route() string { return RouterKey }
+
+func (msg MsgUnnestONFT) Type() string { return TypeMsgUnnestONFT }
+
+func (msg MsgUnnestONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.Id)
+}
+
+func (msg MsgUnnestONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateEditions(denomId, masterId string, maxEditions uint64, sender string) *MsgCreateEditions {
+	return &MsgCreateEditions{
+		MasterId:    masterId,
+		DenomId:     denomId,
+		MaxEditions: maxEditions,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgCreateEditions) Route() string { return RouterKey }
+
+func (msg MsgCreateEditions) Type() string { return TypeMsgCreateEditions }
+
+func (msg MsgCreateEditions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.MasterId); err != nil {
+		return err
+	}
+	return ValidateMaxEditions(msg.MaxEditions)
+}
+
+func (msg MsgCreateEditions) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgPrintEdition(denomId, masterId, id, recipient, sender string) *MsgPrintEdition {
+	return &MsgPrintEdition{
+		Id:        id,
+		DenomId:   denomId,
+		MasterId:  masterId,
+		Recipient: recipient,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgPrintEdition) Route() string { return RouterKey }
+
+func (msg MsgPrintEdition) Type() string { return TypeMsgPrintEdition }
+
+func (msg MsgPrintEdition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.MasterId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.Id); err != nil {
+		return err
+	}
+	if msg.Id == msg.MasterId {
+		return errorsmod.Wrap(ErrInvalidEdition, "edition id can not be the master id")
+	}
+	return nil
+}
+
+func (msg MsgPrintEdition) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgUpdateDataHistoryRetention(denomId string, retention uint64, sender string) *MsgUpdateDataHistoryRetention {
+	return &MsgUpdateDataHistoryRetention{
+		Id:                   denomId,
+		DataHistoryRetention: retention,
+		Sender:               sender,
+	}
+}
+
+func (msg MsgUpdateDataHistoryRetention) Route() string { return RouterKey }
+
+func (msg MsgUpdateDataHistoryRetention) Type() string { return TypeMsgUpdateDataHistoryRetention }
+
+func (msg MsgUpdateDataHistoryRetention) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.Id); err != nil {
+		return err
+	}
+	return ValidateDataHistoryRetention(msg.DataHistoryRetention)
+}
+
+func (msg MsgUpdateDataHistoryRetention) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
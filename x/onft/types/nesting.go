@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// NestAddress returns the address holding the oNFTs nested in an oNFT
+func NestAddress(denomID, onftID string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(fmt.Sprintf("nest/%s/%s", denomID, onftID)))
+}
+
+func (n Nesting) Validate() error {
+	if strings.TrimSpace(n.ParentDenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing parent denom id")
+	}
+	if strings.TrimSpace(n.ParentId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing parent onft id")
+	}
+	if strings.TrimSpace(n.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(n.OnftId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing onft id")
+	}
+	if n.ParentDenomId == n.DenomId && n.ParentId == n.OnftId {
+		return errorsmod.Wrap(ErrInvalidNesting, "onft can not be nested in itself")
+	}
+	return nil
+}
@@ -98,6 +98,19 @@ func (onft ONFT) GetRoyaltyShare() sdkmath.LegacyDec {
 	return onft.RoyaltyShare
 }
 
+func (onft ONFT) GetRoyaltyReceivers() []*WeightedAddress {
+	return onft.RoyaltyReceivers
+}
+
+func (onft ONFT) GetTransferLockedUntil() *time.Time {
+	return onft.TransferLockedUntil
+}
+
+// IsTransferLocked returns true if the transfer lock of the nft has not passed at the given time
+func (m ONFTMetadata) IsTransferLocked(blockTime time.Time) bool {
+	return m.TransferLockedUntil != nil && blockTime.Before(*m.TransferLockedUntil)
+}
+
 // ONFT
 
 type ONFTs []exported.ONFTI
@@ -6,9 +6,10 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
-	github_com_gogo_protobuf_types "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -28,6 +29,47 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OwnershipChangeCause defines the cause of a change of the owner of an oNFT
+type OwnershipChangeCause int32
+
+const (
+	OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_UNSPECIFIED OwnershipChangeCause = 0
+	OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_TRANSFER    OwnershipChangeCause = 1
+	OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_SALE        OwnershipChangeCause = 2
+	OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_AUCTION     OwnershipChangeCause = 3
+	OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_CLAIM       OwnershipChangeCause = 4
+	OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_IBC         OwnershipChangeCause = 5
+	OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_REVOCATION  OwnershipChangeCause = 6
+)
+
+var OwnershipChangeCause_name = map[int32]string{
+	0: "OWNERSHIP_CHANGE_CAUSE_UNSPECIFIED",
+	1: "OWNERSHIP_CHANGE_CAUSE_TRANSFER",
+	2: "OWNERSHIP_CHANGE_CAUSE_SALE",
+	3: "OWNERSHIP_CHANGE_CAUSE_AUCTION",
+	4: "OWNERSHIP_CHANGE_CAUSE_CLAIM",
+	5: "OWNERSHIP_CHANGE_CAUSE_IBC",
+	6: "OWNERSHIP_CHANGE_CAUSE_REVOCATION",
+}
+
+var OwnershipChangeCause_value = map[string]int32{
+	"OWNERSHIP_CHANGE_CAUSE_UNSPECIFIED": 0,
+	"OWNERSHIP_CHANGE_CAUSE_TRANSFER":    1,
+	"OWNERSHIP_CHANGE_CAUSE_SALE":        2,
+	"OWNERSHIP_CHANGE_CAUSE_AUCTION":     3,
+	"OWNERSHIP_CHANGE_CAUSE_CLAIM":       4,
+	"OWNERSHIP_CHANGE_CAUSE_IBC":         5,
+	"OWNERSHIP_CHANGE_CAUSE_REVOCATION":  6,
+}
+
+func (x OwnershipChangeCause) String() string {
+	return proto.EnumName(OwnershipChangeCause_name, int32(x))
+}
+
+func (OwnershipChangeCause) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{0}
+}
+
 // Collection
 type Collection struct {
 	Denom Denom  `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
@@ -118,6 +160,19 @@ type Denom struct {
 	Data             string             `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
 	RoyaltyReceivers []*WeightedAddress `protobuf:"bytes,11,rep,name=royalty_receivers,json=royaltyReceivers,proto3" json:"royalty_receivers,omitempty" yaml:"royalty_receivers"`
 	UpdatableData    bool               `protobuf:"varint,12,opt,name=updatable_data,json=updatableData,proto3" json:"updatable_data,omitempty"`
+	// max_supply is the maximum number of oNFTs that can exist in the denom, 0 means unlimited
+	MaxSupply uint64 `protobuf:"varint,13,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// minting_closed is set once the creator permanently closes minting on the denom
+	MintingClosed bool `protobuf:"varint,14,opt,name=minting_closed,json=mintingClosed,proto3" json:"minting_closed,omitempty"`
+	// revocable allows the creator to revoke the oNFTs of the denom from their holders
+	Revocable bool `protobuf:"varint,15,opt,name=revocable,proto3" json:"revocable,omitempty"`
+	// frozen blocks transfers, marketplace listings and ICS-721 sends of the oNFTs of the denom
+	Frozen bool `protobuf:"varint,16,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// indexed_traits indexes the traits of the attributes array in the data of the oNFTs of the denom
+	IndexedTraits bool `protobuf:"varint,17,opt,name=indexed_traits,json=indexedTraits,proto3" json:"indexed_traits,omitempty"`
+	// data_history_retention is the number of prior data versions retained per oNFT of the denom,
+	// capped by the max_data_history_retention param, 0 disables the data history of the denom
+	DataHistoryRetention uint64 `protobuf:"varint,18,opt,name=data_history_retention,json=dataHistoryRetention,proto3" json:"data_history_retention,omitempty"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
@@ -154,14 +209,20 @@ func (m *Denom) XXX_DiscardUnknown() {
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
 type DenomMetadata struct {
-	Creator          string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Schema           string             `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
-	Description      string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PreviewUri       string             `protobuf:"bytes,4,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty"`
-	Data             string             `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	UriHash          string             `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
-	RoyaltyReceivers []*WeightedAddress `protobuf:"bytes,7,rep,name=royalty_receivers,json=royaltyReceivers,proto3" json:"royalty_receivers,omitempty" yaml:"royalty_receivers"`
-	UpdatableData    bool               `protobuf:"varint,8,opt,name=updatable_data,json=updatableData,proto3" json:"updatable_data,omitempty"`
+	Creator              string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Schema               string             `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Description          string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PreviewUri           string             `protobuf:"bytes,4,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty"`
+	Data                 string             `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	UriHash              string             `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	RoyaltyReceivers     []*WeightedAddress `protobuf:"bytes,7,rep,name=royalty_receivers,json=royaltyReceivers,proto3" json:"royalty_receivers,omitempty" yaml:"royalty_receivers"`
+	UpdatableData        bool               `protobuf:"varint,8,opt,name=updatable_data,json=updatableData,proto3" json:"updatable_data,omitempty"`
+	MaxSupply            uint64             `protobuf:"varint,9,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	MintingClosed        bool               `protobuf:"varint,10,opt,name=minting_closed,json=mintingClosed,proto3" json:"minting_closed,omitempty"`
+	Revocable            bool               `protobuf:"varint,11,opt,name=revocable,proto3" json:"revocable,omitempty"`
+	Frozen               bool               `protobuf:"varint,12,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	IndexedTraits        bool               `protobuf:"varint,13,opt,name=indexed_traits,json=indexedTraits,proto3" json:"indexed_traits,omitempty"`
+	DataHistoryRetention uint64             `protobuf:"varint,14,opt,name=data_history_retention,json=dataHistoryRetention,proto3" json:"data_history_retention,omitempty"`
 }
 
 func (m *DenomMetadata) Reset()         { *m = DenomMetadata{} }
@@ -208,6 +269,10 @@ type ONFT struct {
 	CreatedAt    time.Time                   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
 	Nsfw         bool                        `protobuf:"varint,8,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
 	RoyaltyShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=royalty_share,json=royaltyShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"royalty_share" yaml:"royalty_share"`
+	// royalty_receivers overrides the royalty receivers of the denom when set
+	RoyaltyReceivers []*WeightedAddress `protobuf:"bytes,10,rep,name=royalty_receivers,json=royaltyReceivers,proto3" json:"royalty_receivers,omitempty" yaml:"royalty_receivers"`
+	// transfer_locked_until blocks transfers of the oNFT by its owner until the time when set
+	TransferLockedUntil *time.Time `protobuf:"bytes,11,opt,name=transfer_locked_until,json=transferLockedUntil,proto3,stdtime" json:"transfer_locked_until,omitempty" yaml:"transfer_locked_until"`
 }
 
 func (m *ONFT) Reset()         { *m = ONFT{} }
@@ -285,16 +350,18 @@ func (m *Metadata) XXX_DiscardUnknown() {
 var xxx_messageInfo_Metadata proto.InternalMessageInfo
 
 type ONFTMetadata struct {
-	Name         string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description  string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	PreviewURI   string                      `protobuf:"bytes,3,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty" yaml:"preview_uri"`
-	Data         string                      `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	Transferable bool                        `protobuf:"varint,5,opt,name=transferable,proto3" json:"transferable,omitempty"`
-	Extensible   bool                        `protobuf:"varint,6,opt,name=extensible,proto3" json:"extensible,omitempty"`
-	CreatedAt    time.Time                   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
-	Nsfw         bool                        `protobuf:"varint,8,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
-	RoyaltyShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=royalty_share,json=royaltyShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"royalty_share" yaml:"royalty_share"`
-	UriHash      string                      `protobuf:"bytes,10,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	Name                string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PreviewURI          string                      `protobuf:"bytes,3,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty" yaml:"preview_uri"`
+	Data                string                      `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Transferable        bool                        `protobuf:"varint,5,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	Extensible          bool                        `protobuf:"varint,6,opt,name=extensible,proto3" json:"extensible,omitempty"`
+	CreatedAt           time.Time                   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
+	Nsfw                bool                        `protobuf:"varint,8,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	RoyaltyShare        cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=royalty_share,json=royaltyShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"royalty_share" yaml:"royalty_share"`
+	UriHash             string                      `protobuf:"bytes,10,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	RoyaltyReceivers    []*WeightedAddress          `protobuf:"bytes,11,rep,name=royalty_receivers,json=royaltyReceivers,proto3" json:"royalty_receivers,omitempty" yaml:"royalty_receivers"`
+	TransferLockedUntil *time.Time                  `protobuf:"bytes,12,opt,name=transfer_locked_until,json=transferLockedUntil,proto3,stdtime" json:"transfer_locked_until,omitempty" yaml:"transfer_locked_until"`
 }
 
 func (m *ONFTMetadata) Reset()         { *m = ONFTMetadata{} }
@@ -406,732 +473,902 @@ func (m *WeightedAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Collection)(nil), "OmniFlix.onft.v1beta1.Collection")
-	proto.RegisterType((*IDCollection)(nil), "OmniFlix.onft.v1beta1.IDCollection")
-	proto.RegisterType((*Denom)(nil), "OmniFlix.onft.v1beta1.Denom")
-	proto.RegisterType((*DenomMetadata)(nil), "OmniFlix.onft.v1beta1.DenomMetadata")
-	proto.RegisterType((*ONFT)(nil), "OmniFlix.onft.v1beta1.ONFT")
-	proto.RegisterType((*Metadata)(nil), "OmniFlix.onft.v1beta1.Metadata")
-	proto.RegisterType((*ONFTMetadata)(nil), "OmniFlix.onft.v1beta1.ONFTMetadata")
-	proto.RegisterType((*Owner)(nil), "OmniFlix.onft.v1beta1.Owner")
-	proto.RegisterType((*WeightedAddress)(nil), "OmniFlix.onft.v1beta1.WeightedAddress")
+// Minter defines an address that is allowed to mint oNFTs under a denom
+// on behalf of the denom creator
+type Minter struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// quota is the maximum number of oNFTs the minter can mint, 0 means unlimited
+	Quota  uint64     `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	Minted uint64     `protobuf:"varint,4,opt,name=minted,proto3" json:"minted,omitempty"`
+	Expiry *time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty" yaml:"expiry"`
 }
 
-func init() { proto.RegisterFile("OmniFlix/onft/v1beta1/onft.proto", fileDescriptor_de1608f52787b22e) }
-
-var fileDescriptor_de1608f52787b22e = []byte{
-	// 1038 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xce, 0xf8, 0x3f, 0xe5, 0x38, 0x9b, 0x6d, 0xc2, 0x6a, 0xc8, 0x2e, 0x1e, 0xab, 0x77, 0x41,
-	0x39, 0xa0, 0xb1, 0x36, 0x80, 0xb4, 0x5a, 0x84, 0x44, 0x86, 0xb0, 0x22, 0xd2, 0x86, 0xa0, 0x61,
-	0x57, 0x20, 0x2e, 0xa6, 0x3d, 0xd3, 0xb1, 0x5b, 0x78, 0x66, 0xcc, 0xf4, 0x38, 0x89, 0xdf, 0x80,
-	0x0b, 0x52, 0x24, 0xce, 0x48, 0xbc, 0x06, 0x6f, 0x90, 0x0b, 0x68, 0x8f, 0x88, 0xc3, 0x00, 0xce,
-	0x85, 0xb3, 0x9f, 0x00, 0xf5, 0xcf, 0xd8, 0x33, 0xf9, 0x41, 0x68, 0x0f, 0x9c, 0xb8, 0x75, 0x55,
-	0x57, 0x57, 0x57, 0x7f, 0x5f, 0xd5, 0xa7, 0x86, 0xce, 0x61, 0x10, 0xb2, 0x27, 0x23, 0x76, 0xda,
-	0x8d, 0xc2, 0xa3, 0xa4, 0x7b, 0xfc, 0xb0, 0x4f, 0x13, 0xf2, 0x50, 0x1a, 0xf6, 0x38, 0x8e, 0x92,
-	0x08, 0xbd, 0x9a, 0x45, 0xd8, 0xd2, 0xa9, 0x23, 0xb6, 0x36, 0x07, 0xd1, 0x20, 0x92, 0x11, 0x5d,
-	0xb1, 0x52, 0xc1, 0x5b, 0xd6, 0x20, 0x8a, 0x06, 0x23, 0xda, 0x95, 0x56, 0x7f, 0x72, 0xd4, 0x4d,
-	0x58, 0x40, 0x79, 0x42, 0x82, 0xb1, 0x0a, 0xc0, 0xdf, 0x1a, 0x00, 0x1f, 0x46, 0xa3, 0x11, 0xf5,
-	0x12, 0x16, 0x85, 0xe8, 0x11, 0x54, 0x7d, 0x1a, 0x46, 0x81, 0x69, 0x74, 0x8c, 0xed, 0xe6, 0xce,
-	0x3d, 0xfb, 0xda, 0xcb, 0xec, 0x3d, 0x11, 0xe3, 0x54, 0xce, 0x53, 0x6b, 0xc5, 0x55, 0x07, 0xd0,
-	0x07, 0x50, 0x15, 0x21, 0xdc, 0x2c, 0x75, 0xca, 0xdb, 0xcd, 0x9d, 0xbb, 0x37, 0x9c, 0x3c, 0xfc,
-	0xe4, 0xc9, 0x33, 0xa7, 0x25, 0x0e, 0xce, 0x52, 0xab, 0x2a, 0x2c, 0xee, 0xaa, 0x83, 0x38, 0x84,
-	0xb5, 0xfd, 0xbd, 0x5c, 0x2d, 0x36, 0x34, 0x64, 0xea, 0x1e, 0xf3, 0x65, 0x39, 0xab, 0xce, 0x2b,
-	0xf3, 0xd4, 0xba, 0x35, 0x25, 0xc1, 0xe8, 0x31, 0xce, 0x76, 0xb0, 0x5b, 0x97, 0xcb, 0x7d, 0x5f,
-	0xc4, 0x8b, 0x44, 0x3d, 0xe6, 0xab, 0x22, 0x0a, 0xf1, 0xd9, 0x0e, 0x76, 0xeb, 0x62, 0xb9, 0xef,
-	0x73, 0xfc, 0x53, 0x19, 0xaa, 0xf2, 0x21, 0x68, 0x1d, 0x4a, 0xd9, 0x1d, 0x6e, 0x89, 0xf9, 0xe8,
-	0x0e, 0xd4, 0xf8, 0x34, 0xe8, 0x47, 0x23, 0xb3, 0x24, 0x7d, 0xda, 0x42, 0x08, 0x2a, 0x21, 0x09,
-	0xa8, 0x59, 0x96, 0x5e, 0xb9, 0x96, 0xb1, 0xde, 0x90, 0x06, 0xc4, 0xac, 0xe8, 0x58, 0x69, 0x21,
-	0x13, 0xea, 0x5e, 0x4c, 0x49, 0x12, 0xc5, 0x66, 0x55, 0x6e, 0x64, 0x26, 0xea, 0x40, 0xd3, 0xa7,
-	0xdc, 0x8b, 0xd9, 0x58, 0x3c, 0xd3, 0xac, 0xc9, 0xdd, 0xbc, 0x0b, 0x7d, 0x04, 0xcd, 0x71, 0x4c,
-	0x8f, 0x19, 0x3d, 0xe9, 0x4d, 0x62, 0x66, 0xd6, 0xe5, 0xe3, 0x1f, 0xcc, 0x52, 0x0b, 0x3e, 0x55,
-	0xee, 0xe7, 0xee, 0xfe, 0x3c, 0xb5, 0x90, 0x7a, 0x5a, 0x2e, 0x14, 0xbb, 0xa0, 0xad, 0xe7, 0x31,
-	0x43, 0x1b, 0x50, 0x16, 0xc7, 0x1b, 0xf2, 0x02, 0xb1, 0x44, 0xaf, 0x41, 0x63, 0x12, 0xb3, 0xde,
-	0x90, 0xf0, 0xa1, 0xb9, 0xaa, 0xaa, 0x9a, 0xc4, 0xec, 0x63, 0xc2, 0x87, 0xe2, 0x6d, 0x3e, 0x49,
-	0x88, 0x09, 0xea, 0x6d, 0x62, 0x8d, 0xbe, 0x81, 0xdb, 0x71, 0x34, 0x25, 0xa3, 0x64, 0xda, 0x8b,
-	0xa9, 0x47, 0xd9, 0x31, 0x8d, 0xb9, 0xd9, 0x94, 0xfc, 0xbe, 0x79, 0x03, 0xbf, 0x9f, 0x53, 0x36,
-	0x18, 0x26, 0xd4, 0xdf, 0xf5, 0xfd, 0x98, 0x72, 0xee, 0xdc, 0x9b, 0xa7, 0x96, 0xa9, 0xea, 0xbc,
-	0x92, 0x0a, 0xbb, 0x1b, 0xda, 0xe7, 0x66, 0x2e, 0xf4, 0x06, 0xac, 0x4f, 0xc6, 0xe2, 0xf2, 0xfe,
-	0x88, 0xf6, 0x64, 0x41, 0x6b, 0x1d, 0x63, 0xbb, 0xe1, 0xb6, 0x16, 0xde, 0x3d, 0x92, 0x10, 0xfc,
-	0x73, 0x09, 0x5a, 0x92, 0xbb, 0x03, 0x9a, 0x10, 0x59, 0x6b, 0x0e, 0x6f, 0xa3, 0x88, 0xf7, 0x92,
-	0xa1, 0x52, 0x81, 0xa1, 0x4b, 0x3c, 0x94, 0xaf, 0xf2, 0x60, 0x15, 0x79, 0x50, 0x04, 0xe7, 0x11,
-	0xce, 0x40, 0xab, 0xe6, 0x40, 0xcb, 0x63, 0x5c, 0x2b, 0x62, 0x7c, 0x2d, 0x9e, 0xf5, 0xff, 0x18,
-	0xcf, 0xc6, 0x75, 0x78, 0xfe, 0x50, 0x86, 0x8a, 0x18, 0xc6, 0x2b, 0xa3, 0xb0, 0x0b, 0x8d, 0x40,
-	0x43, 0x2c, 0xe1, 0x6b, 0xee, 0x58, 0x37, 0x54, 0x9a, 0x31, 0xa1, 0x65, 0x61, 0x71, 0x6c, 0x01,
-	0x52, 0x39, 0x07, 0xd2, 0x26, 0x54, 0xa3, 0x93, 0x90, 0xc6, 0x1a, 0x53, 0x65, 0x20, 0x0c, 0x6b,
-	0x49, 0x4c, 0x42, 0x7e, 0x44, 0x63, 0x51, 0x99, 0x84, 0xb5, 0xe1, 0x16, 0x7c, 0xa8, 0x0d, 0x40,
-	0x4f, 0x13, 0x1a, 0x72, 0x26, 0x22, 0x6a, 0x32, 0x22, 0xe7, 0x41, 0x5f, 0x00, 0x48, 0xe2, 0xa9,
-	0xdf, 0x23, 0x89, 0x1c, 0x9d, 0xe6, 0xce, 0x96, 0xad, 0x64, 0xd0, 0xce, 0x64, 0xd0, 0x7e, 0x96,
-	0xc9, 0xa0, 0xf3, 0xba, 0xa8, 0x76, 0x9e, 0x5a, 0xb7, 0x15, 0xa8, 0xcb, 0xb3, 0xf8, 0xec, 0x77,
-	0xcb, 0x70, 0x57, 0xb5, 0x63, 0x37, 0x91, 0xd3, 0xcf, 0x8f, 0x4e, 0x34, 0x80, 0x72, 0x8d, 0xbe,
-	0x82, 0x56, 0x46, 0x03, 0x1f, 0x92, 0x98, 0xaa, 0xa9, 0x72, 0xde, 0x13, 0x49, 0x7f, 0x4b, 0xad,
-	0xbb, 0x5e, 0xc4, 0x83, 0x88, 0x73, 0xff, 0x6b, 0x9b, 0x45, 0xdd, 0x80, 0x24, 0x43, 0xfb, 0x29,
-	0x1d, 0x10, 0x6f, 0xba, 0x47, 0xbd, 0x79, 0x6a, 0x6d, 0x16, 0x89, 0x94, 0x19, 0xb0, 0xbb, 0xa6,
-	0xed, 0xcf, 0x84, 0xf9, 0xb8, 0xf2, 0xd7, 0x8f, 0x96, 0x81, 0xcf, 0x4a, 0xd0, 0x58, 0xb4, 0xfa,
-	0x7d, 0x2d, 0x43, 0x4a, 0x14, 0x6f, 0xcd, 0x53, 0xab, 0xa9, 0x12, 0x09, 0x2f, 0xd6, 0xba, 0xf4,
-	0xa8, 0xd8, 0xdd, 0xb2, 0xf5, 0x9d, 0x3b, 0x4b, 0xd5, 0xc8, 0x6d, 0xe2, 0x62, 0xd7, 0xbf, 0x0f,
-	0xab, 0x01, 0xf5, 0x19, 0x91, 0x3d, 0x2f, 0x49, 0x73, 0x3a, 0xb3, 0xd4, 0x6a, 0x1c, 0x08, 0xa7,
-	0x52, 0x9e, 0x0d, 0x95, 0x63, 0x11, 0x86, 0x05, 0xdd, 0x62, 0x37, 0x66, 0x97, 0xc5, 0xab, 0xf2,
-	0x92, 0xe2, 0x95, 0x1f, 0xa3, 0x6a, 0x61, 0x8c, 0x34, 0x24, 0xbf, 0x94, 0x61, 0x4d, 0xb4, 0xec,
-	0x41, 0xae, 0xcf, 0x96, 0xb0, 0x68, 0x14, 0x3a, 0xd7, 0xa0, 0xf0, 0x8f, 0x5a, 0x5b, 0x7e, 0xc9,
-	0x72, 0xb3, 0x26, 0xaf, 0xe4, 0x9a, 0xfc, 0xff, 0x76, 0xbe, 0xd2, 0xce, 0x05, 0x5a, 0xa1, 0x40,
-	0x2b, 0xfe, 0xde, 0x80, 0xea, 0xa1, 0xd4, 0x01, 0x13, 0xea, 0x44, 0xe9, 0x5d, 0xa6, 0xe5, 0xda,
-	0x44, 0x63, 0x58, 0x67, 0x7e, 0xcf, 0x5b, 0x7c, 0x12, 0xb2, 0xef, 0xc6, 0xfd, 0x1b, 0x44, 0x29,
-	0xff, 0xa1, 0x70, 0x1e, 0xe8, 0x6f, 0x47, 0x2b, 0xef, 0xe5, 0xcb, 0xf1, 0x61, 0xbe, 0xc7, 0xb1,
-	0xdb, 0x62, 0x7e, 0x6e, 0x17, 0x7f, 0x67, 0xc0, 0xad, 0x4b, 0x22, 0x8c, 0xde, 0xba, 0x54, 0x9f,
-	0x83, 0xe6, 0xa9, 0xb5, 0xae, 0x92, 0xe8, 0x0d, 0xbc, 0xac, 0xf9, 0x29, 0xd4, 0x4e, 0x64, 0x02,
-	0x3d, 0x84, 0xef, 0xfc, 0x3b, 0x34, 0x5b, 0x2a, 0x9f, 0x3a, 0x8a, 0x5d, 0x9d, 0xc3, 0x39, 0x38,
-	0xff, 0xb3, 0xbd, 0x72, 0x3e, 0x6b, 0x1b, 0x2f, 0x66, 0x6d, 0xe3, 0x8f, 0x59, 0xdb, 0x38, 0xbb,
-	0x68, 0xaf, 0xbc, 0xb8, 0x68, 0xaf, 0xfc, 0x7a, 0xd1, 0x5e, 0xf9, 0xb2, 0x3b, 0x60, 0xc9, 0x70,
-	0xd2, 0xb7, 0xbd, 0x28, 0xe8, 0x2e, 0x7f, 0x92, 0x41, 0xc8, 0x8e, 0x46, 0xec, 0x74, 0x38, 0xe9,
-	0x77, 0x8f, 0xdf, 0xed, 0xea, 0xaf, 0x65, 0x32, 0x1d, 0x53, 0xde, 0xaf, 0xc9, 0x1e, 0x7a, 0xfb,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x87, 0x2a, 0x7d, 0x78, 0x0a, 0x00, 0x00,
+func (m *Minter) Reset()         { *m = Minter{} }
+func (m *Minter) String() string { return proto.CompactTextString(m) }
+func (*Minter) ProtoMessage()    {}
+func (*Minter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{9}
 }
-
-func (this *ONFT) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ONFT)
-	if !ok {
-		that2, ok := that.(ONFT)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Minter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Minter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Minter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if !this.Metadata.Equal(&that1.Metadata) {
-		return false
-	}
-	if this.Data != that1.Data {
-		return false
-	}
-	if this.Owner != that1.Owner {
-		return false
-	}
-	if this.Transferable != that1.Transferable {
-		return false
-	}
-	if this.Extensible != that1.Extensible {
-		return false
-	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
-	}
-	if this.Nsfw != that1.Nsfw {
-		return false
-	}
-	if !this.RoyaltyShare.Equal(that1.RoyaltyShare) {
-		return false
-	}
-	return true
 }
-func (this *Metadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Minter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Minter.Merge(m, src)
+}
+func (m *Minter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Minter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Minter.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Metadata)
-	if !ok {
-		that2, ok := that.(Metadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_Minter proto.InternalMessageInfo
+
+// Approval defines a spender approved to transfer an oNFT on behalf of its owner
+type Approval struct {
+	DenomId string     `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId  string     `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Spender string     `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	Expiry  *time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty" yaml:"expiry"`
+}
+
+func (m *Approval) Reset()         { *m = Approval{} }
+func (m *Approval) String() string { return proto.CompactTextString(m) }
+func (*Approval) ProtoMessage()    {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{10}
+}
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Approval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.MediaURI != that1.MediaURI {
-		return false
-	}
-	if this.PreviewURI != that1.PreviewURI {
-		return false
-	}
-	if this.UriHash != that1.UriHash {
-		return false
-	}
-	return true
 }
-func (m *Collection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
 }
 
-func (m *Collection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+// OperatorApproval defines an operator approved to transfer all oNFTs of an owner
+type OperatorApproval struct {
+	Owner    string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Operator string     `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Expiry   *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty" yaml:"expiry"`
 }
 
-func (m *Collection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ONFTs) > 0 {
-		for iNdEx := len(m.ONFTs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ONFTs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOnft(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+func (m *OperatorApproval) Reset()         { *m = OperatorApproval{} }
+func (m *OperatorApproval) String() string { return proto.CompactTextString(m) }
+func (*OperatorApproval) ProtoMessage()    {}
+func (*OperatorApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{11}
+}
+func (m *OperatorApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOnft(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *IDCollection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OperatorApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorApproval.Merge(m, src)
+}
+func (m *OperatorApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorApproval.DiscardUnknown(m)
 }
 
-func (m *IDCollection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OperatorApproval proto.InternalMessageInfo
+
+// ONFTUser defines the user of an oNFT, the user role lapses once
+// the block time passes the expiry
+type ONFTUser struct {
+	DenomId string    `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId  string    `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	User    string    `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Expiry  time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
 }
 
-func (m *IDCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OnftIds) > 0 {
-		for iNdEx := len(m.OnftIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OnftIds[iNdEx])
-			copy(dAtA[i:], m.OnftIds[iNdEx])
-			i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftIds[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *ONFTUser) Reset()         { *m = ONFTUser{} }
+func (m *ONFTUser) String() string { return proto.CompactTextString(m) }
+func (*ONFTUser) ProtoMessage()    {}
+func (*ONFTUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{12}
+}
+func (m *ONFTUser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ONFTUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ONFTUser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DenomId) > 0 {
-		i -= len(m.DenomId)
-		copy(dAtA[i:], m.DenomId)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Denom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ONFTUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ONFTUser.Merge(m, src)
+}
+func (m *ONFTUser) XXX_Size() int {
+	return m.Size()
+}
+func (m *ONFTUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_ONFTUser.DiscardUnknown(m)
 }
 
-func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ONFTUser proto.InternalMessageInfo
+
+// ONFTDataVersion defines a prior data value of an oNFT of an updatable data denom,
+// height, time and updater record the data update that replaced it
+type ONFTDataVersion struct {
+	DenomId string    `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId  string    `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Version uint64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Data    string    `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Height  int64     `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+	Updater string    `protobuf:"bytes,7,opt,name=updater,proto3" json:"updater,omitempty"`
 }
 
-func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdatableData {
-		i--
-		if m.UpdatableData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.RoyaltyReceivers) > 0 {
-		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOnft(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+func (m *ONFTDataVersion) Reset()         { *m = ONFTDataVersion{} }
+func (m *ONFTDataVersion) String() string { return proto.CompactTextString(m) }
+func (*ONFTDataVersion) ProtoMessage()    {}
+func (*ONFTDataVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{13}
+}
+func (m *ONFTDataVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ONFTDataVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ONFTDataVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.UriHash) > 0 {
-		i -= len(m.UriHash)
-		copy(dAtA[i:], m.UriHash)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PreviewURI) > 0 {
-		i -= len(m.PreviewURI)
-		copy(dAtA[i:], m.PreviewURI)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewURI)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DenomMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ONFTDataVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ONFTDataVersion.Merge(m, src)
+}
+func (m *ONFTDataVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ONFTDataVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ONFTDataVersion.DiscardUnknown(m)
 }
 
-func (m *DenomMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ONFTDataVersion proto.InternalMessageInfo
+
+// OwnershipRecord defines a change of the owner of an oNFT, from is empty for
+// oNFTs received over IBC
+type OwnershipRecord struct {
+	DenomId  string               `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId   string               `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Sequence uint64               `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	From     string               `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To       string               `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Height   int64                `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time            `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+	Cause    OwnershipChangeCause `protobuf:"varint,8,opt,name=cause,proto3,enum=OmniFlix.onft.v1beta1.OwnershipChangeCause" json:"cause,omitempty"`
 }
 
-func (m *DenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdatableData {
-		i--
-		if m.UpdatableData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.RoyaltyReceivers) > 0 {
-		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOnft(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+func (m *OwnershipRecord) Reset()         { *m = OwnershipRecord{} }
+func (m *OwnershipRecord) String() string { return proto.CompactTextString(m) }
+func (*OwnershipRecord) ProtoMessage()    {}
+func (*OwnershipRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{14}
+}
+func (m *OwnershipRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.UriHash) > 0 {
-		i -= len(m.UriHash)
-		copy(dAtA[i:], m.UriHash)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PreviewUri) > 0 {
-		i -= len(m.PreviewUri)
-		copy(dAtA[i:], m.PreviewUri)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewUri)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *OwnershipRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipRecord.Merge(m, src)
+}
+func (m *OwnershipRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipRecord.DiscardUnknown(m)
 }
 
-func (m *ONFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OwnershipRecord proto.InternalMessageInfo
+
+// Revocation defines the revocation of an oNFT of a revocable denom by the denom creator,
+// reclaimed is set when the oNFT was transferred to the issuer instead of burned
+type Revocation struct {
+	DenomId   string    `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId    string    `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Sequence  uint64    `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Holder    string    `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	Issuer    string    `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Reason    string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reclaimed bool      `protobuf:"varint,7,opt,name=reclaimed,proto3" json:"reclaimed,omitempty"`
+	Height    int64     `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	RevokedAt time.Time `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3,stdtime" json:"revoked_at" yaml:"revoked_at"`
+}
+
+func (m *Revocation) Reset()         { *m = Revocation{} }
+func (m *Revocation) String() string { return proto.CompactTextString(m) }
+func (*Revocation) ProtoMessage()    {}
+func (*Revocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{15}
+}
+func (m *Revocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Revocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Revocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Revocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revocation.Merge(m, src)
+}
+func (m *Revocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Revocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revocation.DiscardUnknown(m)
 }
 
-func (m *ONFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Revocation proto.InternalMessageInfo
+
+// Nesting defines an oNFT owned by a parent oNFT, the child is held by the
+// nest address derived from the parent and moves with it on transfers
+type Nesting struct {
+	ParentDenomId string `protobuf:"bytes,1,opt,name=parent_denom_id,json=parentDenomId,proto3" json:"parent_denom_id,omitempty" yaml:"parent_denom_id"`
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty" yaml:"parent_id"`
+	DenomId       string `protobuf:"bytes,3,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId        string `protobuf:"bytes,4,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
 }
 
-func (m *ONFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RoyaltyShare.Size()
-		i -= size
-		if _, err := m.RoyaltyShare.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *Nesting) Reset()         { *m = Nesting{} }
+func (m *Nesting) String() string { return proto.CompactTextString(m) }
+func (*Nesting) ProtoMessage()    {}
+func (*Nesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{16}
+}
+func (m *Nesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Nesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Nesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOnft(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Nsfw {
-		i--
-		if m.Nsfw {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *Nesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nesting.Merge(m, src)
+}
+func (m *Nesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Nesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nesting proto.InternalMessageInfo
+
+// EditionSet defines the cap of numbered editions printed from a master oNFT
+type EditionSet struct {
+	DenomId     string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	MasterId    string `protobuf:"bytes,2,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty" yaml:"master_id"`
+	MaxEditions uint64 `protobuf:"varint,3,opt,name=max_editions,json=maxEditions,proto3" json:"max_editions,omitempty" yaml:"max_editions"`
+	// printed is the number of editions printed so far, burned editions are not reissued
+	Printed uint64 `protobuf:"varint,4,opt,name=printed,proto3" json:"printed,omitempty"`
+}
+
+func (m *EditionSet) Reset()         { *m = EditionSet{} }
+func (m *EditionSet) String() string { return proto.CompactTextString(m) }
+func (*EditionSet) ProtoMessage()    {}
+func (*EditionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{17}
+}
+func (m *EditionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x40
-	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err2 != nil {
-		return 0, err2
+		return b[:n], nil
 	}
-	i -= n2
-	i = encodeVarintOnft(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x3a
-	if m.Extensible {
-		i--
-		if m.Extensible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *EditionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditionSet.Merge(m, src)
+}
+func (m *EditionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditionSet proto.InternalMessageInfo
+
+// Edition defines a numbered print of a master oNFT, prints share the denom of the master
+type Edition struct {
+	DenomId  string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId   string `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	MasterId string `protobuf:"bytes,3,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty" yaml:"master_id"`
+	Number   uint64 `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *Edition) Reset()         { *m = Edition{} }
+func (m *Edition) String() string { return proto.CompactTextString(m) }
+func (*Edition) ProtoMessage()    {}
+func (*Edition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{18}
+}
+func (m *Edition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Edition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Edition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if m.Transferable {
-		i--
-		if m.Transferable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *Edition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Edition.Merge(m, src)
+}
+func (m *Edition) XXX_Size() int {
+	return m.Size()
+}
+func (m *Edition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Edition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Edition proto.InternalMessageInfo
+
+// TraitCount defines the number of oNFTs of a denom with a trait value
+type TraitCount struct {
+	TraitType string `protobuf:"bytes,1,opt,name=trait_type,json=traitType,proto3" json:"trait_type,omitempty" yaml:"trait_type"`
+	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count     uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *TraitCount) Reset()         { *m = TraitCount{} }
+func (m *TraitCount) String() string { return proto.CompactTextString(m) }
+func (*TraitCount) ProtoMessage()    {}
+func (*TraitCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{19}
+}
+func (m *TraitCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraitCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraitCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *TraitCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraitCount.Merge(m, src)
+}
+func (m *TraitCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraitCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraitCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraitCount proto.InternalMessageInfo
+
+// MintVoucher defines an off-chain signed permission of a denom minter to mint
+// an oNFT to the redeemer of the voucher on payment of the price
+type MintVoucher struct {
+	DenomId          string                      `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Id               string                      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata         Metadata                    `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+	Data             string                      `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Transferable     bool                        `protobuf:"varint,5,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	Extensible       bool                        `protobuf:"varint,6,opt,name=extensible,proto3" json:"extensible,omitempty"`
+	Nsfw             bool                        `protobuf:"varint,7,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	RoyaltyShare     cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=royalty_share,json=royaltyShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"royalty_share" yaml:"royalty_share"`
+	RoyaltyReceivers []*WeightedAddress          `protobuf:"bytes,9,rep,name=royalty_receivers,json=royaltyReceivers,proto3" json:"royalty_receivers,omitempty" yaml:"royalty_receivers"`
+	Price            types.Coin                  `protobuf:"bytes,10,opt,name=price,proto3" json:"price"`
+	Expiry           time.Time                   `protobuf:"bytes,11,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	Nonce            uint64                      `protobuf:"varint,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// signer is the denom creator or minter signing the voucher
+	Signer string `protobuf:"bytes,13,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MintVoucher) Reset()         { *m = MintVoucher{} }
+func (m *MintVoucher) String() string { return proto.CompactTextString(m) }
+func (*MintVoucher) ProtoMessage()    {}
+func (*MintVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{20}
+}
+func (m *MintVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOnft(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MintVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintVoucher.Merge(m, src)
+}
+func (m *MintVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintVoucher.DiscardUnknown(m)
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MintVoucher proto.InternalMessageInfo
+
+// MintVoucherSignDoc defines the document signed by the voucher signer
+type MintVoucherSignDoc struct {
+	ChainId string      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	Voucher MintVoucher `protobuf:"bytes,2,opt,name=voucher,proto3" json:"voucher"`
+}
+
+func (m *MintVoucherSignDoc) Reset()         { *m = MintVoucherSignDoc{} }
+func (m *MintVoucherSignDoc) String() string { return proto.CompactTextString(m) }
+func (*MintVoucherSignDoc) ProtoMessage()    {}
+func (*MintVoucherSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{21}
+}
+func (m *MintVoucherSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintVoucherSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintVoucherSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MintVoucherSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintVoucherSignDoc.Merge(m, src)
+}
+func (m *MintVoucherSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintVoucherSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintVoucherSignDoc.DiscardUnknown(m)
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MintVoucherSignDoc proto.InternalMessageInfo
+
+// VoucherNonce defines a redeemed mint voucher nonce of a signer
+type VoucherNonce struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Signer  string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Nonce   uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UriHash) > 0 {
-		i -= len(m.UriHash)
-		copy(dAtA[i:], m.UriHash)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PreviewURI) > 0 {
-		i -= len(m.PreviewURI)
-		copy(dAtA[i:], m.PreviewURI)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewURI)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MediaURI) > 0 {
-		i -= len(m.MediaURI)
-		copy(dAtA[i:], m.MediaURI)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.MediaURI)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *VoucherNonce) Reset()         { *m = VoucherNonce{} }
+func (m *VoucherNonce) String() string { return proto.CompactTextString(m) }
+func (*VoucherNonce) ProtoMessage()    {}
+func (*VoucherNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{22}
+}
+func (m *VoucherNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VoucherNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherNonce.Merge(m, src)
+}
+func (m *VoucherNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherNonce.DiscardUnknown(m)
 }
 
-func (m *ONFTMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_VoucherNonce proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("OmniFlix.onft.v1beta1.OwnershipChangeCause", OwnershipChangeCause_name, OwnershipChangeCause_value)
+	proto.RegisterType((*Collection)(nil), "OmniFlix.onft.v1beta1.Collection")
+	proto.RegisterType((*IDCollection)(nil), "OmniFlix.onft.v1beta1.IDCollection")
+	proto.RegisterType((*Denom)(nil), "OmniFlix.onft.v1beta1.Denom")
+	proto.RegisterType((*DenomMetadata)(nil), "OmniFlix.onft.v1beta1.DenomMetadata")
+	proto.RegisterType((*ONFT)(nil), "OmniFlix.onft.v1beta1.ONFT")
+	proto.RegisterType((*Metadata)(nil), "OmniFlix.onft.v1beta1.Metadata")
+	proto.RegisterType((*ONFTMetadata)(nil), "OmniFlix.onft.v1beta1.ONFTMetadata")
+	proto.RegisterType((*Owner)(nil), "OmniFlix.onft.v1beta1.Owner")
+	proto.RegisterType((*WeightedAddress)(nil), "OmniFlix.onft.v1beta1.WeightedAddress")
+	proto.RegisterType((*Minter)(nil), "OmniFlix.onft.v1beta1.Minter")
+	proto.RegisterType((*Approval)(nil), "OmniFlix.onft.v1beta1.Approval")
+	proto.RegisterType((*OperatorApproval)(nil), "OmniFlix.onft.v1beta1.OperatorApproval")
+	proto.RegisterType((*ONFTUser)(nil), "OmniFlix.onft.v1beta1.ONFTUser")
+	proto.RegisterType((*ONFTDataVersion)(nil), "OmniFlix.onft.v1beta1.ONFTDataVersion")
+	proto.RegisterType((*OwnershipRecord)(nil), "OmniFlix.onft.v1beta1.OwnershipRecord")
+	proto.RegisterType((*Revocation)(nil), "OmniFlix.onft.v1beta1.Revocation")
+	proto.RegisterType((*Nesting)(nil), "OmniFlix.onft.v1beta1.Nesting")
+	proto.RegisterType((*EditionSet)(nil), "OmniFlix.onft.v1beta1.EditionSet")
+	proto.RegisterType((*Edition)(nil), "OmniFlix.onft.v1beta1.Edition")
+	proto.RegisterType((*TraitCount)(nil), "OmniFlix.onft.v1beta1.TraitCount")
+	proto.RegisterType((*MintVoucher)(nil), "OmniFlix.onft.v1beta1.MintVoucher")
+	proto.RegisterType((*MintVoucherSignDoc)(nil), "OmniFlix.onft.v1beta1.MintVoucherSignDoc")
+	proto.RegisterType((*VoucherNonce)(nil), "OmniFlix.onft.v1beta1.VoucherNonce")
 }
 
-func (m *ONFTMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("OmniFlix/onft/v1beta1/onft.proto", fileDescriptor_de1608f52787b22e) }
+
+var fileDescriptor_de1608f52787b22e = []byte{
+	// 2150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5f, 0x6f, 0x1b, 0x4b,
+	0x15, 0xcf, 0xfa, 0xbf, 0x8f, 0xed, 0xc4, 0x9d, 0xa6, 0x61, 0x9b, 0xf6, 0x7a, 0xc3, 0xb6, 0xf7,
+	0xaa, 0xe2, 0x22, 0x5b, 0x0d, 0x05, 0x55, 0x05, 0x24, 0x6c, 0xc7, 0xa5, 0x96, 0x9a, 0xa4, 0xda,
+	0x24, 0xbd, 0x57, 0xbc, 0x98, 0xcd, 0xee, 0xc4, 0x1e, 0xd5, 0xbb, 0xeb, 0xee, 0xac, 0xd3, 0x84,
+	0x0f, 0x80, 0xee, 0x1b, 0x05, 0xbe, 0x00, 0x4f, 0xbc, 0xf3, 0xc4, 0x07, 0x40, 0x48, 0x15, 0xf0,
+	0x70, 0x1f, 0x11, 0xd2, 0x35, 0x90, 0xbe, 0xf0, 0x6c, 0xf1, 0x01, 0xd0, 0xfc, 0xd9, 0xf5, 0x3a,
+	0xb5, 0x9b, 0xba, 0x25, 0x97, 0x17, 0xde, 0xe6, 0xfc, 0x99, 0xd9, 0x73, 0xce, 0xfc, 0x66, 0xce,
+	0x39, 0xb3, 0xb0, 0xb1, 0xeb, 0xb8, 0xe4, 0x61, 0x9f, 0x9c, 0xd4, 0x3c, 0xf7, 0x28, 0xa8, 0x1d,
+	0xdf, 0x3d, 0xc4, 0x81, 0x79, 0x97, 0x13, 0xd5, 0x81, 0xef, 0x05, 0x1e, 0xba, 0x16, 0x6a, 0x54,
+	0x39, 0x53, 0x6a, 0xac, 0xaf, 0x76, 0xbd, 0xae, 0xc7, 0x35, 0x6a, 0x6c, 0x24, 0x94, 0xd7, 0xb5,
+	0xae, 0xe7, 0x75, 0xfb, 0xb8, 0xc6, 0xa9, 0xc3, 0xe1, 0x51, 0x2d, 0x20, 0x0e, 0xa6, 0x81, 0xe9,
+	0x0c, 0xa4, 0x42, 0xc5, 0xf2, 0xa8, 0xe3, 0xd1, 0xda, 0xa1, 0x49, 0x71, 0xf4, 0x35, 0xcb, 0x23,
+	0xae, 0x90, 0xeb, 0x5f, 0x28, 0x00, 0x4d, 0xaf, 0xdf, 0xc7, 0x56, 0x40, 0x3c, 0x17, 0xdd, 0x87,
+	0xb4, 0x8d, 0x5d, 0xcf, 0x51, 0x95, 0x0d, 0xe5, 0x4e, 0x61, 0xf3, 0x66, 0x75, 0xa6, 0x31, 0xd5,
+	0x2d, 0xa6, 0xd3, 0x48, 0xbd, 0x1a, 0x69, 0x4b, 0x86, 0x98, 0x80, 0x7e, 0x04, 0x69, 0xa6, 0x42,
+	0xd5, 0xc4, 0x46, 0xf2, 0x4e, 0x61, 0xf3, 0xc6, 0x9c, 0x99, 0xbb, 0x3b, 0x0f, 0xf7, 0x1b, 0x25,
+	0x36, 0xf1, 0x6c, 0xa4, 0xa5, 0x19, 0x45, 0x0d, 0x31, 0x51, 0x77, 0xa1, 0xd8, 0xde, 0x8a, 0xd9,
+	0x52, 0x85, 0x1c, 0x5f, 0xba, 0x43, 0x6c, 0x6e, 0x4e, 0xbe, 0x71, 0x75, 0x3c, 0xd2, 0x56, 0x4e,
+	0x4d, 0xa7, 0xff, 0x40, 0x0f, 0x25, 0xba, 0x91, 0xe5, 0xc3, 0xb6, 0xcd, 0xf4, 0xd9, 0x42, 0x1d,
+	0x62, 0x0b, 0x23, 0xa6, 0xf4, 0x43, 0x89, 0x6e, 0x64, 0xd9, 0xb0, 0x6d, 0x53, 0xfd, 0x57, 0x69,
+	0x48, 0x73, 0x47, 0xd0, 0x32, 0x24, 0xc2, 0x6f, 0x18, 0x09, 0x62, 0xa3, 0x35, 0xc8, 0xd0, 0x53,
+	0xe7, 0xd0, 0xeb, 0xab, 0x09, 0xce, 0x93, 0x14, 0x42, 0x90, 0x72, 0x4d, 0x07, 0xab, 0x49, 0xce,
+	0xe5, 0x63, 0xae, 0x6b, 0xf5, 0xb0, 0x63, 0xaa, 0x29, 0xa9, 0xcb, 0x29, 0xa4, 0x42, 0xd6, 0xf2,
+	0xb1, 0x19, 0x78, 0xbe, 0x9a, 0xe6, 0x82, 0x90, 0x44, 0x1b, 0x50, 0xb0, 0x31, 0xb5, 0x7c, 0x32,
+	0x60, 0x6e, 0xaa, 0x19, 0x2e, 0x8d, 0xb3, 0x50, 0x0b, 0x0a, 0x03, 0x1f, 0x1f, 0x13, 0xfc, 0xa2,
+	0x33, 0xf4, 0x89, 0x9a, 0xe5, 0xce, 0xdf, 0x3e, 0x1b, 0x69, 0xf0, 0x44, 0xb0, 0x0f, 0x8c, 0xf6,
+	0x78, 0xa4, 0x21, 0xe1, 0x5a, 0x4c, 0x55, 0x37, 0x40, 0x52, 0x07, 0x3e, 0x41, 0x65, 0x48, 0xb2,
+	0xe9, 0x39, 0xfe, 0x01, 0x36, 0x44, 0xd7, 0x21, 0x37, 0xf4, 0x49, 0xa7, 0x67, 0xd2, 0x9e, 0x9a,
+	0x17, 0x56, 0x0d, 0x7d, 0xf2, 0xc8, 0xa4, 0x3d, 0xe6, 0x9b, 0x6d, 0x06, 0xa6, 0x0a, 0xc2, 0x37,
+	0x36, 0x46, 0xcf, 0xe1, 0x8a, 0xef, 0x9d, 0x9a, 0xfd, 0xe0, 0xb4, 0xe3, 0x63, 0x0b, 0x93, 0x63,
+	0xec, 0x53, 0xb5, 0xc0, 0xf7, 0xf7, 0x93, 0x39, 0xfb, 0xfb, 0x19, 0x26, 0xdd, 0x5e, 0x80, 0xed,
+	0xba, 0x6d, 0xfb, 0x98, 0xd2, 0xc6, 0xcd, 0xf1, 0x48, 0x53, 0x85, 0x9d, 0x6f, 0x2c, 0xa5, 0x1b,
+	0x65, 0xc9, 0x33, 0x42, 0x16, 0xfa, 0x18, 0x96, 0x87, 0x03, 0xf6, 0xf1, 0xc3, 0x3e, 0xee, 0x70,
+	0x83, 0x8a, 0x1b, 0xca, 0x9d, 0x9c, 0x51, 0x8a, 0xb8, 0x5b, 0xcc, 0xb2, 0x8f, 0x00, 0x1c, 0xf3,
+	0xa4, 0x43, 0x87, 0x83, 0x41, 0xff, 0x54, 0x2d, 0x6d, 0x28, 0x77, 0x52, 0x46, 0xde, 0x31, 0x4f,
+	0xf6, 0x38, 0x83, 0xad, 0xe2, 0x10, 0x37, 0x20, 0x6e, 0xb7, 0x63, 0xf5, 0x3d, 0x8a, 0x6d, 0x75,
+	0x59, 0xac, 0x22, 0xb9, 0x4d, 0xce, 0x44, 0x37, 0x21, 0xef, 0xe3, 0x63, 0xcf, 0x62, 0xcb, 0xaa,
+	0x2b, 0x5c, 0x63, 0xc2, 0x60, 0x3b, 0x7b, 0xe4, 0x7b, 0x3f, 0xc3, 0xae, 0x5a, 0xe6, 0x22, 0x49,
+	0xb1, 0xc5, 0x89, 0x6b, 0xe3, 0x13, 0x6c, 0x77, 0x02, 0xdf, 0x24, 0x01, 0x55, 0xaf, 0x88, 0xc5,
+	0x25, 0x77, 0x9f, 0x33, 0xd1, 0x3d, 0x58, 0x63, 0x16, 0x77, 0x7a, 0x84, 0x06, 0x9e, 0xcf, 0xdc,
+	0x0e, 0xb0, 0xcb, 0x77, 0x1c, 0x71, 0x73, 0x57, 0x99, 0xf4, 0x91, 0x10, 0x1a, 0xa1, 0x4c, 0xff,
+	0x6d, 0x0a, 0x4a, 0x1c, 0x94, 0xdb, 0x38, 0x30, 0xf9, 0x26, 0xc4, 0x80, 0xa4, 0x4c, 0x03, 0x69,
+	0x02, 0xbd, 0xc4, 0x14, 0xf4, 0xce, 0x01, 0x2c, 0xf9, 0x26, 0xc0, 0xb4, 0x69, 0x80, 0x09, 0xe4,
+	0xc6, 0xa1, 0x13, 0xa2, 0x21, 0x1d, 0x43, 0x43, 0x1c, 0x3c, 0x99, 0x69, 0xf0, 0xcc, 0x04, 0x4a,
+	0xf6, 0x6b, 0x06, 0x4a, 0xee, 0x62, 0xa0, 0xe4, 0x2f, 0x06, 0x0a, 0x5c, 0x08, 0x94, 0xc2, 0x7c,
+	0xa0, 0x14, 0x2f, 0x00, 0x4a, 0x69, 0x31, 0xa0, 0x2c, 0xbf, 0x05, 0x28, 0x3f, 0x4f, 0x43, 0x8a,
+	0x5d, 0x9f, 0x6f, 0x5c, 0x5e, 0x75, 0xc8, 0x39, 0x12, 0x3b, 0x1c, 0x17, 0x85, 0x4d, 0x6d, 0xce,
+	0x16, 0x84, 0x10, 0x93, 0x17, 0x79, 0x34, 0x2d, 0xda, 0xfd, 0x64, 0x6c, 0xf7, 0x57, 0x21, 0xed,
+	0xbd, 0x70, 0xb1, 0x2f, 0xc1, 0x22, 0x08, 0xa4, 0x43, 0x31, 0xf0, 0x4d, 0x97, 0x1e, 0x61, 0x9f,
+	0xc7, 0x26, 0xcd, 0x1d, 0x9c, 0xe2, 0xa1, 0x0a, 0x00, 0x3e, 0x09, 0xb0, 0x4b, 0x09, 0xd3, 0xc8,
+	0x70, 0x8d, 0x18, 0x07, 0x7d, 0x0e, 0xc0, 0x11, 0x8d, 0xed, 0x8e, 0x19, 0xf0, 0xcb, 0xae, 0xb0,
+	0xb9, 0x5e, 0x15, 0x89, 0xad, 0x1a, 0x26, 0xb6, 0xea, 0x7e, 0x98, 0xd8, 0x1a, 0x1f, 0x31, 0x6b,
+	0xc7, 0x23, 0xed, 0x8a, 0x40, 0xcb, 0x64, 0xae, 0xfe, 0xf2, 0xef, 0x9a, 0x62, 0xe4, 0x25, 0xa3,
+	0x1e, 0xf0, 0xfb, 0x9a, 0x1e, 0xbd, 0x90, 0xc8, 0xe0, 0x63, 0xf4, 0x53, 0x28, 0x85, 0xf8, 0xa2,
+	0x3d, 0xd3, 0xc7, 0xe2, 0x1e, 0x6c, 0x7c, 0x9f, 0x2d, 0xfa, 0xb7, 0x91, 0x76, 0x43, 0xe4, 0x4b,
+	0x6a, 0x3f, 0xab, 0x12, 0xaf, 0xe6, 0x98, 0x41, 0xaf, 0xfa, 0x18, 0x77, 0x4d, 0xeb, 0x74, 0x0b,
+	0x5b, 0xe3, 0x91, 0xb6, 0x3a, 0x8d, 0x50, 0xbe, 0x82, 0x6e, 0x14, 0x25, 0xbd, 0xc7, 0xc8, 0xd9,
+	0x87, 0x01, 0x2e, 0xf5, 0x30, 0x04, 0x70, 0x2d, 0x0c, 0x79, 0xa7, 0xef, 0x59, 0xcf, 0xb0, 0xdd,
+	0x19, 0xba, 0x01, 0xe9, 0xab, 0x85, 0x0b, 0xa3, 0x79, 0x7b, 0x3c, 0xd2, 0x6e, 0x8a, 0x4f, 0xcd,
+	0x5c, 0x42, 0x04, 0xf5, 0x6a, 0x28, 0x7b, 0xcc, 0x45, 0x07, 0x4c, 0xf2, 0x20, 0xf5, 0xaf, 0xdf,
+	0x68, 0x8a, 0xfe, 0x32, 0x01, 0xb9, 0xe8, 0xb2, 0xba, 0x25, 0x33, 0xa4, 0xc8, 0xd7, 0x2b, 0xe3,
+	0x91, 0x56, 0x10, 0x6b, 0x33, 0xae, 0x2e, 0x53, 0xe6, 0xfd, 0xe9, 0xfb, 0x89, 0x5f, 0x5e, 0x8d,
+	0xb5, 0x49, 0x42, 0x8b, 0x09, 0xf5, 0xe9, 0x7b, 0xeb, 0x87, 0x90, 0x77, 0xb0, 0x4d, 0x4c, 0x7e,
+	0x6b, 0x71, 0x74, 0x36, 0x36, 0xce, 0x46, 0x5a, 0x6e, 0x9b, 0x31, 0x45, 0x52, 0x2c, 0x8b, 0x35,
+	0x22, 0x35, 0x9d, 0xe1, 0x9a, 0x49, 0x7d, 0x72, 0x3e, 0xaf, 0xa6, 0xde, 0x33, 0xaf, 0xc6, 0x2f,
+	0xc2, 0xf4, 0xd4, 0x45, 0x28, 0x43, 0xf2, 0xfb, 0x34, 0x14, 0xd9, 0xd9, 0xdc, 0x8e, 0x1d, 0xa8,
+	0x49, 0x58, 0x64, 0x14, 0x36, 0x66, 0x44, 0xe1, 0xad, 0x65, 0x40, 0xf2, 0x3d, 0xcd, 0x0d, 0x4f,
+	0x73, 0x2a, 0x76, 0x9a, 0xff, 0x7f, 0x6e, 0xdf, 0x3c, 0xb7, 0xf1, 0x6d, 0x85, 0x77, 0xc8, 0x6f,
+	0x85, 0xff, 0xcd, 0x91, 0x2e, 0x5e, 0xe2, 0x91, 0xd6, 0x7f, 0xad, 0x40, 0x7a, 0x97, 0xdf, 0xec,
+	0x2a, 0x64, 0x4d, 0x61, 0x7a, 0x58, 0x76, 0x48, 0x12, 0x0d, 0x60, 0x99, 0xd8, 0x1d, 0x2b, 0x2a,
+	0xd4, 0xc3, 0x92, 0xff, 0xd6, 0x9c, 0x48, 0xc4, 0x8b, 0xfa, 0xc6, 0x6d, 0x59, 0xfa, 0x97, 0xe2,
+	0x5c, 0x3a, 0xb9, 0x27, 0x88, 0x6d, 0x51, 0xdd, 0x28, 0x11, 0x3b, 0x26, 0x65, 0x56, 0xad, 0x9c,
+	0x8b, 0x27, 0xfa, 0xf6, 0x39, 0xfb, 0x1a, 0x68, 0x3c, 0xd2, 0x96, 0xc5, 0x22, 0x52, 0xa0, 0x4f,
+	0x6c, 0x7e, 0x0c, 0x99, 0x17, 0x7c, 0x01, 0x79, 0xdb, 0xdc, 0x7b, 0x37, 0xd8, 0x94, 0xc4, 0x7a,
+	0x62, 0xaa, 0x6e, 0xc8, 0x35, 0xe4, 0x29, 0xff, 0xb3, 0x02, 0x99, 0x6d, 0xe2, 0x06, 0xd8, 0x5f,
+	0xb8, 0x55, 0x89, 0x05, 0x37, 0x31, 0x1d, 0xdc, 0x55, 0x48, 0x3f, 0x1f, 0x7a, 0x32, 0xf7, 0xa6,
+	0x0c, 0x41, 0xb0, 0x0a, 0x83, 0x15, 0x24, 0xd8, 0xe6, 0x87, 0x38, 0x65, 0x48, 0x0a, 0xb5, 0x21,
+	0x83, 0x4f, 0x06, 0xc4, 0x3f, 0x55, 0xd3, 0x17, 0xa2, 0xe2, 0xda, 0xc4, 0x1f, 0x31, 0x47, 0xc0,
+	0x40, 0x2e, 0xa0, 0xff, 0x45, 0x81, 0x5c, 0x7d, 0x30, 0xf0, 0xbd, 0x63, 0xb3, 0xbf, 0xb0, 0x3f,
+	0x9f, 0x42, 0x56, 0x36, 0x58, 0x6a, 0xe2, 0xfc, 0x66, 0x48, 0x81, 0x6e, 0x64, 0x44, 0xe3, 0xc5,
+	0x9c, 0xa7, 0x03, 0xec, 0xda, 0xd8, 0x97, 0x05, 0x46, 0x48, 0xc6, 0xdc, 0x49, 0x7d, 0xa8, 0x3b,
+	0xbf, 0x50, 0xa0, 0xbc, 0x3b, 0xc0, 0x3e, 0x2b, 0x94, 0x23, 0xb7, 0xa2, 0x1a, 0x46, 0x89, 0xd7,
+	0x30, 0xeb, 0x90, 0xf3, 0xa4, 0xa6, 0xdc, 0x8d, 0x88, 0x8e, 0x59, 0x94, 0xfc, 0x50, 0x8b, 0xfe,
+	0xa4, 0x40, 0x8e, 0x25, 0x85, 0x03, 0x8a, 0xfd, 0xcb, 0x0d, 0x30, 0x82, 0xd4, 0x90, 0x46, 0xd1,
+	0xe5, 0x63, 0xb4, 0xbd, 0x40, 0x68, 0xaf, 0xcb, 0x8b, 0xfa, 0x2d, 0xce, 0x7c, 0x91, 0x80, 0x15,
+	0xe6, 0x0c, 0xab, 0xb1, 0x9f, 0x62, 0x9f, 0xbe, 0x4f, 0xbf, 0xbe, 0x28, 0x68, 0x8e, 0xc5, 0x77,
+	0xe4, 0xc9, 0x08, 0xc9, 0x99, 0xe9, 0x6d, 0x0d, 0x32, 0x3d, 0x71, 0xdc, 0xd9, 0xb9, 0x48, 0x1a,
+	0x92, 0x42, 0xf7, 0x21, 0xc5, 0x1e, 0x48, 0xd4, 0xcc, 0x85, 0x31, 0xc8, 0xb1, 0x18, 0x70, 0x97,
+	0xf9, 0x0c, 0xf6, 0x7d, 0xde, 0x58, 0x60, 0x5f, 0xb4, 0xe3, 0x46, 0x48, 0xea, 0x7f, 0x60, 0xa1,
+	0x60, 0x40, 0xa2, 0x3d, 0x32, 0x30, 0xb0, 0xe5, 0xf9, 0xf6, 0xe5, 0x86, 0x62, 0x1d, 0x72, 0x14,
+	0x3f, 0x1f, 0x62, 0xd7, 0xc2, 0x32, 0x16, 0x11, 0xcd, 0x82, 0x71, 0xe4, 0x7b, 0x4e, 0x18, 0x0c,
+	0x36, 0x66, 0x0d, 0x42, 0xe0, 0xc9, 0x42, 0x25, 0x11, 0x78, 0xb1, 0xe0, 0x64, 0x66, 0x06, 0x27,
+	0xbb, 0x70, 0x70, 0xea, 0x90, 0xb6, 0xcc, 0x21, 0xc5, 0x3c, 0x61, 0x2f, 0x6f, 0x7e, 0x3a, 0xef,
+	0xed, 0x27, 0x8c, 0x52, 0xb3, 0x67, 0xba, 0x5d, 0xdc, 0x64, 0x53, 0x0c, 0x31, 0x53, 0xff, 0x2a,
+	0x01, 0x60, 0xf0, 0x8e, 0x2a, 0xb8, 0x74, 0x2c, 0xbd, 0x2d, 0x80, 0x2c, 0x38, 0x5e, 0xdf, 0x8e,
+	0xfa, 0x1c, 0x49, 0x31, 0x3e, 0xa1, 0x74, 0x88, 0xc3, 0xd7, 0x1c, 0x49, 0x31, 0xbe, 0x8f, 0x4d,
+	0x1a, 0xbd, 0xe3, 0x48, 0x4a, 0x74, 0x8c, 0x56, 0xdf, 0x24, 0x0e, 0xb6, 0xd5, 0x6c, 0xd8, 0x31,
+	0x4a, 0x46, 0x6c, 0x0b, 0x72, 0x53, 0x5b, 0xf0, 0x39, 0x00, 0x6b, 0x2b, 0x9f, 0x89, 0x92, 0x2a,
+	0xbf, 0x68, 0x49, 0x35, 0x99, 0x2b, 0x4b, 0x2a, 0xc9, 0xa8, 0x07, 0xfa, 0x57, 0x0a, 0x64, 0x77,
+	0x30, 0x65, 0x3d, 0x2d, 0x6a, 0xc0, 0xca, 0xc0, 0xf4, 0xb1, 0x1b, 0x74, 0xce, 0xc5, 0x78, 0x7d,
+	0x3c, 0xd2, 0xd6, 0x64, 0x35, 0x39, 0xad, 0xa0, 0x1b, 0x25, 0xc1, 0xd9, 0x92, 0x01, 0xbf, 0x0b,
+	0x79, 0xa9, 0x12, 0x85, 0x7c, 0x75, 0x52, 0x7d, 0x47, 0x22, 0xdd, 0xc8, 0x89, 0x71, 0x7b, 0xfa,
+	0x50, 0x24, 0x17, 0xdb, 0xd3, 0xd4, 0x45, 0x7b, 0xaa, 0xff, 0x51, 0x01, 0x68, 0xd9, 0x84, 0x81,
+	0x67, 0x0f, 0x07, 0x0b, 0xe3, 0xe7, 0x2e, 0xe4, 0x1d, 0x93, 0x06, 0xd8, 0x9f, 0xe9, 0x4e, 0x24,
+	0x62, 0xcd, 0x04, 0x1f, 0xb7, 0x6d, 0xf4, 0x00, 0x8a, 0xec, 0x65, 0x01, 0x8b, 0x8f, 0x52, 0x81,
+	0xa4, 0xc6, 0x37, 0xc6, 0x23, 0xed, 0x6a, 0x38, 0x6b, 0x22, 0xd5, 0x8d, 0x82, 0x63, 0x9e, 0x48,
+	0x03, 0x29, 0xbb, 0x4d, 0x06, 0x7e, 0x3c, 0xa1, 0x87, 0xa4, 0xfe, 0x3b, 0x05, 0xb2, 0x52, 0xed,
+	0x72, 0x0f, 0xc1, 0x94, 0xc7, 0xc9, 0x77, 0xf2, 0x78, 0x0d, 0x32, 0xee, 0xd0, 0x39, 0x94, 0x67,
+	0x23, 0x65, 0x48, 0x4a, 0x77, 0x01, 0xf8, 0x53, 0x46, 0xd3, 0x1b, 0xba, 0x01, 0xba, 0x07, 0xc0,
+	0x5f, 0x3b, 0x3a, 0xc1, 0xe9, 0x20, 0x6c, 0x04, 0xaf, 0x4d, 0x30, 0x3a, 0x91, 0xe9, 0x46, 0x9e,
+	0x13, 0xfb, 0xa7, 0x03, 0xcc, 0x52, 0xf3, 0xb1, 0xd9, 0x1f, 0x62, 0x99, 0x81, 0x05, 0xc1, 0xb8,
+	0x16, 0x5b, 0x34, 0xac, 0x86, 0x38, 0xa1, 0xff, 0x3b, 0x05, 0x05, 0x56, 0x78, 0x3d, 0xf5, 0x86,
+	0x56, 0xef, 0x3d, 0x92, 0xa9, 0x78, 0x31, 0x49, 0xcc, 0x7c, 0x31, 0x49, 0x7e, 0xd8, 0x8b, 0xc9,
+	0x7f, 0xbb, 0xc7, 0x0a, 0x3b, 0xa1, 0xec, 0xdb, 0x3a, 0xa1, 0xdc, 0xd7, 0xf2, 0x82, 0x91, 0xbf,
+	0xd4, 0x76, 0xe7, 0xbb, 0x90, 0x1e, 0xf8, 0xc4, 0xc2, 0xbc, 0xf3, 0x2a, 0x6c, 0x5e, 0xaf, 0x0a,
+	0x2f, 0xaa, 0xec, 0xbf, 0x45, 0xf4, 0x91, 0xa6, 0x47, 0xdc, 0xf0, 0xaf, 0x03, 0xd7, 0x46, 0x3f,
+	0x88, 0xca, 0x9a, 0xc2, 0x02, 0x59, 0x4b, 0xce, 0x61, 0xf0, 0x72, 0x3d, 0x96, 0x05, 0x8a, 0x02,
+	0x5e, 0x9c, 0xe0, 0xcf, 0xaa, 0xa4, 0xcb, 0xca, 0xc4, 0x92, 0x7c, 0x56, 0xe5, 0x14, 0xfb, 0x55,
+	0x82, 0x62, 0xb0, 0xdb, 0x23, 0x5d, 0x77, 0xcb, 0xb3, 0x18, 0xfa, 0xac, 0x9e, 0x49, 0xdc, 0x99,
+	0xe8, 0x0b, 0x25, 0xba, 0x91, 0xe5, 0xc3, 0xb6, 0x8d, 0x1a, 0x90, 0x3d, 0x16, 0x2b, 0xc8, 0xe7,
+	0x39, 0x7d, 0x1e, 0xd8, 0x26, 0xdf, 0x92, 0x4e, 0x87, 0x13, 0xf5, 0x3e, 0x14, 0xa5, 0x64, 0x87,
+	0x9b, 0xbc, 0xe8, 0x09, 0x98, 0xb8, 0x98, 0x88, 0xbb, 0x38, 0x09, 0x48, 0x32, 0x16, 0x90, 0x6f,
+	0xfd, 0x32, 0x01, 0xab, 0xb3, 0x72, 0x37, 0xfa, 0x04, 0xf4, 0xdd, 0xcf, 0x76, 0x5a, 0xc6, 0xde,
+	0xa3, 0xf6, 0x93, 0x4e, 0xf3, 0x51, 0x7d, 0xe7, 0xc7, 0xad, 0x4e, 0xb3, 0x7e, 0xb0, 0xd7, 0xea,
+	0x1c, 0xec, 0xec, 0x3d, 0x69, 0x35, 0xdb, 0x0f, 0xdb, 0xad, 0xad, 0xf2, 0x12, 0xba, 0x05, 0xda,
+	0x1c, 0xbd, 0x7d, 0xa3, 0xbe, 0xb3, 0xf7, 0xb0, 0x65, 0x94, 0x15, 0xa4, 0xc1, 0x8d, 0x39, 0x4a,
+	0x7b, 0xf5, 0xc7, 0xad, 0x72, 0x02, 0xe9, 0x50, 0x99, 0xa3, 0x50, 0x3f, 0x68, 0xee, 0xb7, 0x77,
+	0x77, 0xca, 0x49, 0xb4, 0x01, 0x37, 0xe7, 0xe8, 0x34, 0x1f, 0xd7, 0xdb, 0xdb, 0xe5, 0x14, 0xaa,
+	0xc0, 0xfa, 0x1c, 0x8d, 0x76, 0xa3, 0x59, 0x4e, 0xa3, 0x8f, 0xe1, 0x9b, 0x73, 0xe4, 0x46, 0xeb,
+	0xe9, 0x6e, 0xb3, 0xce, 0x3f, 0x94, 0x69, 0x6c, 0xbf, 0xfa, 0x67, 0x65, 0xe9, 0xd5, 0x59, 0x45,
+	0xf9, 0xf2, 0xac, 0xa2, 0xfc, 0xe3, 0xac, 0xa2, 0xbc, 0x7c, 0x5d, 0x59, 0xfa, 0xf2, 0x75, 0x65,
+	0xe9, 0xaf, 0xaf, 0x2b, 0x4b, 0x3f, 0xa9, 0x75, 0x49, 0xd0, 0x1b, 0x1e, 0x56, 0x2d, 0xcf, 0xa9,
+	0x4d, 0x7e, 0xf8, 0x39, 0x2e, 0x39, 0xea, 0x93, 0x93, 0xde, 0xf0, 0xb0, 0x76, 0xfc, 0xbd, 0x9a,
+	0xfc, 0x03, 0xc8, 0x6e, 0x42, 0x7a, 0x98, 0xe1, 0x78, 0xfd, 0xce, 0x7f, 0x06, 0x00, 0xbf, 0xd6,
+	0x03, 0x99, 0x1f, 0x1c, 0x00, 0x00,
 }
 
-func (m *ONFTMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UriHash) > 0 {
-		i -= len(m.UriHash)
-		copy(dAtA[i:], m.UriHash)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
-		i--
-		dAtA[i] = 0x52
+func (this *ONFT) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	{
-		size := m.RoyaltyShare.Size()
-		i -= size
-		if _, err := m.RoyaltyShare.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOnft(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Nsfw {
-		i--
-		if m.Nsfw {
-			dAtA[i] = 1
+
+	that1, ok := that.(*ONFT)
+	if !ok {
+		that2, ok := that.(ONFT)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x40
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err4 != nil {
-		return 0, err4
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n4
-	i = encodeVarintOnft(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if m.Extensible {
-		i--
-		if m.Extensible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if this.Id != that1.Id {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Transferable != that1.Transferable {
+		return false
+	}
+	if this.Extensible != that1.Extensible {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if this.Nsfw != that1.Nsfw {
+		return false
+	}
+	if !this.RoyaltyShare.Equal(that1.RoyaltyShare) {
+		return false
+	}
+	if len(this.RoyaltyReceivers) != len(that1.RoyaltyReceivers) {
+		return false
+	}
+	for i := range this.RoyaltyReceivers {
+		if !this.RoyaltyReceivers[i].Equal(that1.RoyaltyReceivers[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x30
 	}
-	if m.Transferable {
-		i--
-		if m.Transferable {
-			dAtA[i] = 1
+	if that1.TransferLockedUntil == nil {
+		if this.TransferLockedUntil != nil {
+			return false
+		}
+	} else if !this.TransferLockedUntil.Equal(*that1.TransferLockedUntil) {
+		return false
+	}
+	return true
+}
+func (this *Metadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Metadata)
+	if !ok {
+		that2, ok := that.(Metadata)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.PreviewURI) > 0 {
-		i -= len(m.PreviewURI)
-		copy(dAtA[i:], m.PreviewURI)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewURI)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Name != that1.Name {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if this.Description != that1.Description {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if this.MediaURI != that1.MediaURI {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.PreviewURI != that1.PreviewURI {
+		return false
+	}
+	if this.UriHash != that1.UriHash {
+		return false
+	}
+	return true
 }
+func (this *WeightedAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Owner) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*WeightedAddress)
+	if !ok {
+		that2, ok := that.(WeightedAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (m *Collection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1141,20 +1378,20 @@ func (m *Owner) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Owner) MarshalTo(dAtA []byte) (int, error) {
+func (m *Collection) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Owner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Collection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.IDCollections) > 0 {
-		for iNdEx := len(m.IDCollections) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ONFTs) > 0 {
+		for iNdEx := len(m.ONFTs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.IDCollections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ONFTs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1165,17 +1402,20 @@ func (m *Owner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOnft(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+func (m *IDCollection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1185,331 +1425,4927 @@ func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *IDCollection) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IDCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.OnftIds) > 0 {
+		for iNdEx := len(m.OnftIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OnftIds[iNdEx])
+			copy(dAtA[i:], m.OnftIds[iNdEx])
+			i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintOnft(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintOnft(dAtA, i, uint64(len(m.Address)))
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOnft(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOnft(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Denom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Collection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Denom.Size()
-	n += 1 + l + sovOnft(uint64(l))
-	if len(m.ONFTs) > 0 {
-		for _, e := range m.ONFTs {
-			l = e.Size()
-			n += 1 + l + sovOnft(uint64(l))
-		}
-	}
-	return n
+
+func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IDCollection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DenomId)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.DataHistoryRetention != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.DataHistoryRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.OnftIds) > 0 {
-		for _, s := range m.OnftIds {
-			l = len(s)
-			n += 1 + l + sovOnft(uint64(l))
+	if m.IndexedTraits {
+		i--
+		if m.IndexedTraits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	return n
-}
-
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
-	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = len(m.PreviewURI)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.Revocable {
+		i--
+		if m.Revocable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.MintingClosed {
+		i--
+		if m.MintingClosed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.UriHash)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.MaxSupply != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.UpdatableData {
+		i--
+		if m.UpdatableData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
 	if len(m.RoyaltyReceivers) > 0 {
-		for _, e := range m.RoyaltyReceivers {
-			l = e.Size()
-			n += 1 + l + sovOnft(uint64(l))
+		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
-	if m.UpdatableData {
-		n += 2
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-
-func (m *DenomMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.PreviewURI) > 0 {
+		i -= len(m.PreviewURI)
+		copy(dAtA[i:], m.PreviewURI)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewURI)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.PreviewUri)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.UriHash)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.RoyaltyReceivers) > 0 {
-		for _, e := range m.RoyaltyReceivers {
-			l = e.Size()
-			n += 1 + l + sovOnft(uint64(l))
-		}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UpdatableData {
-		n += 2
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ONFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.DataHistoryRetention != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.DataHistoryRetention))
+		i--
+		dAtA[i] = 0x70
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovOnft(uint64(l))
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.IndexedTraits {
+		i--
+		if m.IndexedTraits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Transferable {
-		n += 2
+	if m.Revocable {
+		i--
+		if m.Revocable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.Extensible {
-		n += 2
+	if m.MintingClosed {
+		i--
+		if m.MintingClosed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovOnft(uint64(l))
-	if m.Nsfw {
-		n += 2
+	if m.MaxSupply != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = m.RoyaltyShare.Size()
-	n += 1 + l + sovOnft(uint64(l))
-	return n
-}
-
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UpdatableData {
+		i--
+		if m.UpdatableData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.RoyaltyReceivers) > 0 {
+		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.MediaURI)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PreviewURI)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.PreviewUri) > 0 {
+		i -= len(m.PreviewUri)
+		copy(dAtA[i:], m.PreviewUri)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewUri)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.UriHash)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ONFTMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ONFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ONFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ONFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.TransferLockedUntil != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TransferLockedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TransferLockedUntil):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintOnft(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.RoyaltyReceivers) > 0 {
+		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	l = len(m.PreviewURI)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	{
+		size := m.RoyaltyShare.Size()
+		i -= size
+		if _, err := m.RoyaltyShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOnft(dAtA, i, uint64(size))
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if m.Nsfw {
+		i--
+		if m.Nsfw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Transferable {
-		n += 2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err3 != nil {
+		return 0, err3
 	}
+	i -= n3
+	i = encodeVarintOnft(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
 	if m.Extensible {
-		n += 2
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovOnft(uint64(l))
-	if m.Nsfw {
-		n += 2
+		i--
+		if m.Extensible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.RoyaltyShare.Size()
-	n += 1 + l + sovOnft(uint64(l))
-	l = len(m.UriHash)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if m.Transferable {
+		i--
+		if m.Transferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *Owner) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.IDCollections) > 0 {
-		for _, e := range m.IDCollections {
-			l = e.Size()
-			n += 1 + l + sovOnft(uint64(l))
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOnft(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WeightedAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovOnft(uint64(l))
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovOnft(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovOnft(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozOnft(x uint64) (n int) {
-	return sovOnft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PreviewURI) > 0 {
+		i -= len(m.PreviewURI)
+		copy(dAtA[i:], m.PreviewURI)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewURI)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MediaURI) > 0 {
+		i -= len(m.MediaURI)
+		copy(dAtA[i:], m.MediaURI)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.MediaURI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ONFTMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ONFTMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ONFTMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferLockedUntil != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TransferLockedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TransferLockedUntil):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintOnft(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RoyaltyReceivers) > 0 {
+		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.RoyaltyShare.Size()
+		i -= size
+		if _, err := m.RoyaltyShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Nsfw {
+		i--
+		if m.Nsfw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintOnft(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	if m.Extensible {
+		i--
+		if m.Extensible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Transferable {
+		i--
+		if m.Transferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviewURI) > 0 {
+		i -= len(m.PreviewURI)
+		copy(dAtA[i:], m.PreviewURI)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.PreviewURI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Owner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Owner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Owner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IDCollections) > 0 {
+		for iNdEx := len(m.IDCollections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IDCollections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintOnft(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Minted != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quota != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Quota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintOnft(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintOnft(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ONFTUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ONFTUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ONFTUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintOnft(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ONFTDataVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ONFTDataVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ONFTDataVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updater) > 0 {
+		i -= len(m.Updater)
+		copy(dAtA[i:], m.Updater)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Updater)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintOnft(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Version != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnershipRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cause != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x40
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintOnft(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Revocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Revocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Revocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevokedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintOnft(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Reclaimed {
+		i--
+		if m.Reclaimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Nesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Nesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Nesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParentDenomId) > 0 {
+		i -= len(m.ParentDenomId)
+		copy(dAtA[i:], m.ParentDenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.ParentDenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Printed != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Printed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxEditions != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.MaxEditions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MasterId) > 0 {
+		i -= len(m.MasterId)
+		copy(dAtA[i:], m.MasterId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.MasterId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Edition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Edition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Edition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MasterId) > 0 {
+		i -= len(m.MasterId)
+		copy(dAtA[i:], m.MasterId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.MasterId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraitCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraitCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraitCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraitType) > 0 {
+		i -= len(m.TraitType)
+		copy(dAtA[i:], m.TraitType)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.TraitType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x60
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintOnft(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.RoyaltyReceivers) > 0 {
+		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.RoyaltyShare.Size()
+		i -= size
+		if _, err := m.RoyaltyShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Nsfw {
+		i--
+		if m.Nsfw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Extensible {
+		i--
+		if m.Extensible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Transferable {
+		i--
+		if m.Transferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintVoucherSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintVoucherSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintVoucherSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Voucher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoucherNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOnft(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOnft(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Collection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Denom.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	if len(m.ONFTs) > 0 {
+		for _, e := range m.ONFTs {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IDCollection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if len(m.OnftIds) > 0 {
+		for _, s := range m.OnftIds {
+			l = len(s)
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.PreviewURI)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if len(m.RoyaltyReceivers) > 0 {
+		for _, e := range m.RoyaltyReceivers {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	if m.UpdatableData {
+		n += 2
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovOnft(uint64(m.MaxSupply))
+	}
+	if m.MintingClosed {
+		n += 2
+	}
+	if m.Revocable {
+		n += 2
+	}
+	if m.Frozen {
+		n += 3
+	}
+	if m.IndexedTraits {
+		n += 3
+	}
+	if m.DataHistoryRetention != 0 {
+		n += 2 + sovOnft(uint64(m.DataHistoryRetention))
+	}
+	return n
+}
+
+func (m *DenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.PreviewUri)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if len(m.RoyaltyReceivers) > 0 {
+		for _, e := range m.RoyaltyReceivers {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	if m.UpdatableData {
+		n += 2
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovOnft(uint64(m.MaxSupply))
+	}
+	if m.MintingClosed {
+		n += 2
+	}
+	if m.Revocable {
+		n += 2
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.IndexedTraits {
+		n += 2
+	}
+	if m.DataHistoryRetention != 0 {
+		n += 1 + sovOnft(uint64(m.DataHistoryRetention))
+	}
+	return n
+}
+
+func (m *ONFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Transferable {
+		n += 2
+	}
+	if m.Extensible {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOnft(uint64(l))
+	if m.Nsfw {
+		n += 2
+	}
+	l = m.RoyaltyShare.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	if len(m.RoyaltyReceivers) > 0 {
+		for _, e := range m.RoyaltyReceivers {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	if m.TransferLockedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TransferLockedUntil)
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.MediaURI)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.PreviewURI)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *ONFTMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.PreviewURI)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Transferable {
+		n += 2
+	}
+	if m.Extensible {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOnft(uint64(l))
+	if m.Nsfw {
+		n += 2
+	}
+	l = m.RoyaltyShare.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if len(m.RoyaltyReceivers) > 0 {
+		for _, e := range m.RoyaltyReceivers {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	if m.TransferLockedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TransferLockedUntil)
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *Owner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if len(m.IDCollections) > 0 {
+		for _, e := range m.IDCollections {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	return n
+}
+
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Quota != 0 {
+		n += 1 + sovOnft(uint64(m.Quota))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovOnft(uint64(m.Minted))
+	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *Approval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *ONFTUser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovOnft(uint64(l))
+	return n
+}
+
+func (m *ONFTDataVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOnft(uint64(m.Version))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOnft(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOnft(uint64(l))
+	l = len(m.Updater)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOnft(uint64(m.Sequence))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOnft(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovOnft(uint64(l))
+	if m.Cause != 0 {
+		n += 1 + sovOnft(uint64(m.Cause))
+	}
+	return n
+}
+
+func (m *Revocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOnft(uint64(m.Sequence))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Reclaimed {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovOnft(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt)
+	n += 1 + l + sovOnft(uint64(l))
+	return n
+}
+
+func (m *Nesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParentDenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *EditionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.MasterId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.MaxEditions != 0 {
+		n += 1 + sovOnft(uint64(m.MaxEditions))
+	}
+	if m.Printed != 0 {
+		n += 1 + sovOnft(uint64(m.Printed))
+	}
+	return n
+}
+
+func (m *Edition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.MasterId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovOnft(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *TraitCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraitType)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovOnft(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *MintVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Transferable {
+		n += 2
+	}
+	if m.Extensible {
+		n += 2
+	}
+	if m.Nsfw {
+		n += 2
+	}
+	l = m.RoyaltyShare.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	if len(m.RoyaltyReceivers) > 0 {
+		for _, e := range m.RoyaltyReceivers {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovOnft(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovOnft(uint64(m.Nonce))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	return n
+}
+
+func (m *MintVoucherSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = m.Voucher.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	return n
+}
+
+func (m *VoucherNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovOnft(uint64(m.Nonce))
+	}
+	return n
+}
+
+func sovOnft(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOnft(x uint64) (n int) {
+	return sovOnft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Collection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ONFTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ONFTs = append(m.ONFTs, ONFT{})
+			if err := m.ONFTs[len(m.ONFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftIds = append(m.OnftIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyReceivers = append(m.RoyaltyReceivers, &WeightedAddress{})
+			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatableData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdatableData = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingClosed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintingClosed = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revocable = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedTraits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexedTraits = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHistoryRetention", wireType)
+			}
+			m.DataHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyReceivers = append(m.RoyaltyReceivers, &WeightedAddress{})
+			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatableData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdatableData = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingClosed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintingClosed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revocable = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedTraits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexedTraits = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHistoryRetention", wireType)
+			}
+			m.DataHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ONFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ONFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ONFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extensible = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nsfw = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyReceivers = append(m.RoyaltyReceivers, &WeightedAddress{})
+			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLockedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLockedUntil == nil {
+				m.TransferLockedUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TransferLockedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ONFTMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ONFTMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extensible = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nsfw = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyReceivers = append(m.RoyaltyReceivers, &WeightedAddress{})
+			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLockedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLockedUntil == nil {
+				m.TransferLockedUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TransferLockedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Owner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDCollections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDCollections = append(m.IDCollections, IDCollection{})
+			if err := m.IDCollections[len(m.IDCollections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			m.Quota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Collection) Unmarshal(dAtA []byte) error {
+func (m *Approval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1532,15 +6368,111 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1567,13 +6499,130 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ONFTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1600,8 +6649,10 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ONFTs = append(m.ONFTs, ONFT{})
-			if err := m.ONFTs[len(m.ONFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1626,7 +6677,7 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDCollection) Unmarshal(dAtA []byte) error {
+func (m *ONFTUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1636,28 +6687,60 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowOnft
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ONFTUser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ONFTUser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IDCollection: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDCollection: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1685,11 +6768,11 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomId = string(dAtA[iNdEx:postIndex])
+			m.OnftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnftIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1717,7 +6800,40 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnftIds = append(m.OnftIds, string(dAtA[iNdEx:postIndex]))
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1740,7 +6856,7 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *ONFTDataVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,15 +6879,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: ONFTDataVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ONFTDataVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1799,11 +6915,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1831,13 +6947,13 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.OnftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -1847,27 +6963,14 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOnft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOnft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1895,13 +6998,32 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -1911,27 +7033,28 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOnft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOnft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1959,11 +7082,61 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Updater = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1991,11 +7164,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2023,11 +7196,30 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.OnftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2055,11 +7247,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2087,11 +7279,30 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2118,16 +7329,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoyaltyReceivers = append(m.RoyaltyReceivers, &WeightedAddress{})
-			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatableData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
 			}
-			var v int
+			m.Cause = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2137,12 +7347,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Cause |= OwnershipChangeCause(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UpdatableData = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOnft(dAtA[iNdEx:])
@@ -2164,7 +7373,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *Revocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,15 +7396,15 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Revocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Revocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2223,11 +7432,11 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2255,13 +7464,13 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.OnftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2271,27 +7480,14 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOnft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOnft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewUri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2319,11 +7515,11 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewUri = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2351,11 +7547,11 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2383,13 +7579,13 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reclaimed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2399,31 +7595,17 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOnft
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOnft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RoyaltyReceivers = append(m.RoyaltyReceivers, &WeightedAddress{})
-			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Reclaimed = bool(v != 0)
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatableData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2433,12 +7615,44 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UpdatableData = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevokedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOnft(dAtA[iNdEx:])
@@ -2460,7 +7674,7 @@ func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ONFT) Unmarshal(dAtA []byte) error {
+func (m *Nesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2483,15 +7697,15 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ONFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: Nesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ONFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Nesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentDenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2519,13 +7733,13 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ParentDenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2535,28 +7749,27 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOnft
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOnft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2584,11 +7797,11 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2616,33 +7829,63 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.OnftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOnft
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
 			}
-			m.Transferable = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extensible", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2652,17 +7895,29 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Extensible = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2672,30 +7927,29 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOnft
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOnft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MasterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEditions", wireType)
 			}
-			var v int
+			m.MaxEditions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2705,17 +7959,16 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxEditions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Nsfw = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShare", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Printed", wireType)
 			}
-			var stringLen uint64
+			m.Printed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2725,26 +7978,11 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Printed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOnft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOnft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RoyaltyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOnft(dAtA[iNdEx:])
@@ -2766,7 +8004,7 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *Edition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2789,15 +8027,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Edition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Edition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2825,11 +8063,43 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2857,13 +8127,13 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.MasterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaURI", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var stringLen uint64
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -2873,27 +8143,64 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Number |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOnft
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOnft
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaURI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraitCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraitCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraitCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2921,11 +8228,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			m.TraitType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2953,8 +8260,27 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOnft(dAtA[iNdEx:])
@@ -2976,7 +8302,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
+func (m *MintVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2999,15 +8325,15 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ONFTMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ONFTMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3035,11 +8361,11 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3067,13 +8393,13 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewURI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -3083,23 +8409,24 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOnft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOnft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewURI = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -3174,8 +8501,62 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Extensible = bool(v != 0)
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nsfw = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3202,15 +8583,16 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.RoyaltyReceivers = append(m.RoyaltyReceivers, &WeightedAddress{})
+			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -3220,17 +8602,30 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Nsfw = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -3240,29 +8635,47 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOnft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOnft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RoyaltyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3290,7 +8703,7 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3313,7 +8726,7 @@ func (m *ONFTMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Owner) Unmarshal(dAtA []byte) error {
+func (m *MintVoucherSignDoc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3336,15 +8749,15 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintVoucherSignDoc: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintVoucherSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3372,11 +8785,11 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDCollections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3403,8 +8816,7 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IDCollections = append(m.IDCollections, IDCollection{})
-			if err := m.IDCollections[len(m.IDCollections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Voucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3429,7 +8841,7 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+func (m *VoucherNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3452,15 +8864,15 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoucherNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoucherNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3488,11 +8900,11 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3520,10 +8932,27 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOnft(dAtA[iNdEx:])
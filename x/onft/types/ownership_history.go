@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewOwnershipRecord(
+	denomID,
+	onftID string,
+	sequence uint64,
+	from,
+	to sdk.AccAddress,
+	height int64,
+	timestamp time.Time,
+	cause OwnershipChangeCause,
+) OwnershipRecord {
+	record := OwnershipRecord{
+		DenomId:  denomID,
+		OnftId:   onftID,
+		Sequence: sequence,
+		To:       to.String(),
+		Height:   height,
+		Time:     timestamp,
+		Cause:    cause,
+	}
+	if from != nil {
+		record.From = from.String()
+	}
+	return record
+}
+
+func (r OwnershipRecord) Validate() error {
+	if strings.TrimSpace(r.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(r.OnftId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing onft id")
+	}
+	if r.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidOwnershipRecord, "sequence must be positive")
+	}
+	if r.From != "" {
+		if _, err := sdk.AccAddressFromBech32(r.From); err != nil {
+			return errorsmod.Wrapf(ErrInvalidOwnershipRecord, "invalid from address %s", r.From)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(r.To); err != nil {
+		return errorsmod.Wrapf(ErrInvalidOwnershipRecord, "invalid to address %s", r.To)
+	}
+	if _, ok := OwnershipChangeCause_name[int32(r.Cause)]; !ok || r.Cause == OwnershipChangeCause_OWNERSHIP_CHANGE_CAUSE_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidOwnershipRecord, "invalid cause %s", r.Cause)
+	}
+	return nil
+}
@@ -10,9 +10,24 @@ import (
 // DefaultDenomCreationFee Default period for closing bids for an auction
 var DefaultDenomCreationFee = sdk.NewInt64Coin("uflix", 100_000_000) // 100FLIX
 
-func NewONFTParams(denomCreationFee sdk.Coin) Params {
+const (
+	// DefaultDataHistoryRetention default number of prior data versions retained per onft of a denom
+	DefaultDataHistoryRetention uint64 = 10
+	// DefaultMaxDataHistoryRetention default cap on the data history retention of a denom
+	DefaultMaxDataHistoryRetention uint64 = 100
+	// MaxDataHistoryRetention maximum number of prior data versions retained per onft
+	MaxDataHistoryRetention uint64 = 1000
+	// DefaultOwnershipHistoryRetention default number of ownership changes retained per onft
+	DefaultOwnershipHistoryRetention uint64 = 20
+	// MaxOwnershipHistoryRetention maximum number of ownership changes retained per onft
+	MaxOwnershipHistoryRetention uint64 = 1000
+)
+
+func NewONFTParams(denomCreationFee sdk.Coin, maxDataHistoryRetention, ownershipHistoryRetention uint64) Params {
 	return Params{
-		DenomCreationFee: denomCreationFee,
+		DenomCreationFee:          denomCreationFee,
+		MaxDataHistoryRetention:   maxDataHistoryRetention,
+		OwnershipHistoryRetention: ownershipHistoryRetention,
 	}
 }
 
@@ -20,6 +35,8 @@ func NewONFTParams(denomCreationFee sdk.Coin) Params {
 func DefaultParams() Params {
 	return NewONFTParams(
 		DefaultDenomCreationFee,
+		DefaultMaxDataHistoryRetention,
+		DefaultOwnershipHistoryRetention,
 	)
 }
 
@@ -28,6 +45,12 @@ func (p Params) ValidateBasic() error {
 	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
 		return err
 	}
+	if err := validateDataHistoryRetention(p.MaxDataHistoryRetention); err != nil {
+		return err
+	}
+	if err := validateOwnershipHistoryRetention(p.OwnershipHistoryRetention); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -44,3 +67,32 @@ func validateDenomCreationFee(i interface{}) error {
 	}
 	return nil
 }
+
+func validateDataHistoryRetention(i interface{}) error {
+	retention, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateDataHistoryRetention(retention)
+}
+
+// ValidateDataHistoryRetention checks the data history retention is within the maximum
+func ValidateDataHistoryRetention(retention uint64) error {
+	if retention > MaxDataHistoryRetention {
+		return errorsmod.Wrapf(ErrInvalidHistoryRetention,
+			"data history retention %d exceeds maximum %d", retention, MaxDataHistoryRetention)
+	}
+	return nil
+}
+
+func validateOwnershipHistoryRetention(i interface{}) error {
+	retention, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention > MaxOwnershipHistoryRetention {
+		return fmt.Errorf("ownership history retention %d exceeds maximum %d", retention, MaxOwnershipHistoryRetention)
+	}
+	return nil
+}
@@ -27,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
 	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=denom_creation_fee,json=denomCreationFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// max_data_history_retention caps the data history retention a denom creator
+	// can set, 0 disables the data history of all denoms
+	MaxDataHistoryRetention uint64 `protobuf:"varint,2,opt,name=max_data_history_retention,json=maxDataHistoryRetention,proto3" json:"max_data_history_retention,omitempty" yaml:"max_data_history_retention"`
+	// ownership_history_retention is the maximum number of ownership changes
+	// retained per oNFT, 0 disables the ownership history
+	OwnershipHistoryRetention uint64 `protobuf:"varint,3,opt,name=ownership_history_retention,json=ownershipHistoryRetention,proto3" json:"ownership_history_retention,omitempty" yaml:"ownership_history_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,25 +77,30 @@ func init() {
 }
 
 var fileDescriptor_45b4f6ff6cbc6db3 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xf2, 0xcf, 0xcd, 0xcb,
-	0x74, 0xcb, 0xc9, 0xac, 0xd0, 0xcf, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xa9, 0xd1, 0x03, 0xa9, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab,
-	0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12,
-	0x8b, 0x53, 0xe1, 0xc6, 0x25, 0xe7, 0x67, 0xe6, 0x41, 0xe4, 0x95, 0x16, 0x30, 0x72, 0xb1, 0x05,
-	0x80, 0x4d, 0x17, 0x9a, 0xca, 0xc8, 0x25, 0x94, 0x92, 0x9a, 0x97, 0x9f, 0x1b, 0x9f, 0x5c, 0x94,
-	0x9a, 0x58, 0x92, 0x99, 0x9f, 0x17, 0x9f, 0x96, 0x9a, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d,
-	0x24, 0xa9, 0x07, 0x31, 0x48, 0x0f, 0x64, 0x10, 0xcc, 0x4e, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27,
-	0x9f, 0x13, 0xf7, 0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x57, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xda, 0x0a, 0xa1, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x1a, 0x3e, 0xdd, 0x93, 0x97, 0xac, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0xb4,
-	0x4d, 0x29, 0x48, 0x00, 0x2c, 0xe8, 0x0c, 0x15, 0x73, 0x4b, 0x4d, 0x75, 0xf2, 0x3d, 0xf1, 0x50,
-	0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0x91, 0x6c, 0x45,
-	0x04, 0x5e, 0x6e, 0x5e, 0x66, 0x5a, 0x4e, 0x66, 0x45, 0x46, 0x69, 0x92, 0x7e, 0x99, 0xa9, 0x3e,
-	0x34, 0x34, 0xc1, 0x4e, 0x48, 0x62, 0x03, 0x7b, 0xdc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x6d,
-	0xbd, 0x7f, 0x4b, 0x6b, 0x01, 0x00, 0x00,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xc7, 0x93, 0xfe, 0x7e, 0x74, 0x88, 0x8b, 0x04, 0xc5, 0xb6, 0xc2, 0xa5, 0x06, 0xd4, 0x2e,
+	0xe6, 0xa8, 0x82, 0x83, 0x63, 0x2b, 0xc5, 0x41, 0x51, 0x32, 0xba, 0x84, 0x4b, 0x7a, 0x69, 0x0e,
+	0x7b, 0x77, 0x21, 0x77, 0xad, 0xe9, 0xbb, 0x70, 0xf1, 0x3d, 0x75, 0xec, 0x28, 0x0e, 0x41, 0xdb,
+	0xd5, 0xa9, 0xaf, 0x40, 0x92, 0x4b, 0xab, 0xf8, 0x6f, 0x4a, 0x78, 0xbe, 0x9f, 0x7c, 0x3f, 0xf0,
+	0xe4, 0x31, 0xec, 0x6b, 0xca, 0x48, 0x6f, 0x48, 0x52, 0xc8, 0x59, 0x28, 0xe1, 0xb8, 0xed, 0x63,
+	0x89, 0xda, 0x30, 0x46, 0x09, 0xa2, 0xc2, 0x89, 0x13, 0x2e, 0xb9, 0xb9, 0xbd, 0x62, 0x9c, 0x9c,
+	0x71, 0x4a, 0xa6, 0xb1, 0x35, 0xe0, 0x03, 0x5e, 0x10, 0x30, 0x7f, 0x53, 0x70, 0x03, 0x04, 0x5c,
+	0x50, 0x2e, 0xa0, 0x8f, 0x04, 0x5e, 0xd7, 0x05, 0x9c, 0x30, 0x95, 0xdb, 0x6f, 0x15, 0xa3, 0x7a,
+	0x53, 0xb4, 0x9b, 0x8f, 0xba, 0x61, 0xf6, 0x31, 0xe3, 0xd4, 0x0b, 0x12, 0x8c, 0x24, 0xe1, 0xcc,
+	0x0b, 0x31, 0xae, 0xe9, 0x4d, 0xbd, 0xb5, 0x71, 0x5c, 0x77, 0x54, 0x91, 0x93, 0x17, 0xad, 0x9c,
+	0x4e, 0x97, 0x13, 0xd6, 0xb9, 0x9c, 0x66, 0x96, 0xf6, 0x9c, 0x59, 0x87, 0x03, 0x22, 0xa3, 0x91,
+	0xef, 0x04, 0x9c, 0xc2, 0xd2, 0xaa, 0x1e, 0x47, 0xa2, 0x7f, 0x07, 0xe5, 0x24, 0xc6, 0xa2, 0xf8,
+	0x60, 0x99, 0x59, 0xf5, 0x09, 0xa2, 0xc3, 0x33, 0xfb, 0xbb, 0xcd, 0x76, 0x37, 0x8b, 0x61, 0xb7,
+	0x9c, 0xf5, 0x30, 0x36, 0x7d, 0xa3, 0x41, 0x51, 0xea, 0xf5, 0x91, 0x44, 0x5e, 0x44, 0x84, 0xe4,
+	0xc9, 0xc4, 0x4b, 0xb0, 0xc4, 0x2c, 0x07, 0x6a, 0x95, 0xa6, 0xde, 0xfa, 0xdf, 0xd9, 0x5f, 0x66,
+	0xd6, 0x9e, 0x2a, 0xfd, 0x9d, 0xb5, 0xdd, 0x1d, 0x8a, 0xd2, 0x73, 0x24, 0xd1, 0x85, 0x8a, 0xdc,
+	0x55, 0x62, 0x86, 0xc6, 0x2e, 0xbf, 0x67, 0x38, 0x11, 0x11, 0x89, 0x7f, 0x90, 0xfc, 0x2b, 0x24,
+	0x07, 0xcb, 0xcc, 0xb2, 0x95, 0xe4, 0x0f, 0xd8, 0x76, 0xeb, 0xeb, 0xf4, 0xab, 0xa7, 0x73, 0x35,
+	0x7d, 0x05, 0xda, 0x74, 0x0e, 0xf4, 0xd9, 0x1c, 0xe8, 0x2f, 0x73, 0xa0, 0x3f, 0x2c, 0x80, 0x36,
+	0x5b, 0x00, 0xed, 0x69, 0x01, 0xb4, 0x5b, 0xf8, 0x69, 0x83, 0x1f, 0x87, 0x40, 0x19, 0x09, 0x87,
+	0x24, 0x8d, 0x46, 0x3e, 0x1c, 0x9f, 0xc2, 0xf2, 0x32, 0x8a, 0x75, 0xfa, 0xd5, 0xe2, 0x27, 0x9e,
+	0xbc, 0x0f, 0x00, 0xa6, 0x6a, 0x8d, 0x61, 0x37, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +123,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OwnershipHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OwnershipHistoryRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDataHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDataHistoryRetention))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.DenomCreationFee.Size()
 		i -= size
@@ -144,6 +165,12 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.DenomCreationFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MaxDataHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.MaxDataHistoryRetention))
+	}
+	if m.OwnershipHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.OwnershipHistoryRetention))
+	}
 	return n
 }
 
@@ -215,6 +242,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataHistoryRetention", wireType)
+			}
+			m.MaxDataHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipHistoryRetention", wireType)
+			}
+			m.OwnershipHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnershipHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (r Revocation) Validate() error {
+	if strings.TrimSpace(r.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(r.OnftId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing onft id")
+	}
+	if r.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidRevocation, "sequence must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Holder); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRevocation, "invalid holder address %s", r.Holder)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Issuer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRevocation, "invalid issuer address %s", r.Issuer)
+	}
+	return ValidateRevocationReason(r.Reason)
+}
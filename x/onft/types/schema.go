@@ -0,0 +1,563 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	// MaxSchemaDepth is the maximum nesting depth of a denom schema
+	MaxSchemaDepth = 32
+
+	// SchemaValidationGasPerByte is the gas charged per byte of schema and data parsed
+	SchemaValidationGasPerByte = 1
+	// SchemaValidationGasPerNode is the gas charged per data node validated against the schema
+	SchemaValidationGasPerNode = 10
+
+	// MaxSchemaDataLen is the maximum length of onft data validated against a denom schema
+	MaxSchemaDataLen = 64 * 1024
+	// MaxNumberLen is the maximum length of a json number without its exponent
+	MaxNumberLen = 64
+	// MaxNumberExponent is the maximum absolute exponent of a json number, numbers are
+	// checked before they are converted as the conversion cost grows with the exponent
+	MaxNumberExponent = 400
+)
+
+var schemaTypes = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+	"number":  true,
+	"string":  true,
+	"integer": true,
+}
+
+// schemaKeywords are the keywords accepted in a denom schema, annotations are
+// accepted and ignored
+var schemaKeywords = map[string]bool{
+	"type":                 true,
+	"enum":                 true,
+	"const":                true,
+	"properties":           true,
+	"required":             true,
+	"additionalProperties": true,
+	"items":                true,
+	"minItems":             true,
+	"maxItems":             true,
+	"minLength":            true,
+	"maxLength":            true,
+	"pattern":              true,
+	"minimum":              true,
+	"maximum":              true,
+	"exclusiveMinimum":     true,
+	"exclusiveMaximum":     true,
+
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+}
+
+// DataSchema is a compiled json schema used to validate onft data.
+// It supports a deterministic subset of the JSON Schema keywords: type, enum, const,
+// properties, required, additionalProperties, items, minItems, maxItems,
+// minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum and exclusiveMaximum.
+// Other keywords are rejected when the denom is created.
+type DataSchema struct {
+	reject bool
+
+	types    []string
+	enum     []interface{}
+	constant interface{}
+	hasConst bool
+
+	properties           map[string]*DataSchema
+	required             []string
+	additionalProperties *DataSchema
+
+	items    *DataSchema
+	minItems *uint64
+	maxItems *uint64
+
+	minLength *uint64
+	maxLength *uint64
+	pattern   *regexp.Regexp
+
+	minimum          *big.Rat
+	maximum          *big.Rat
+	exclusiveMinimum *big.Rat
+	exclusiveMaximum *big.Rat
+}
+
+// CompileDataSchema compiles the schema of a new denom, it returns nil when the
+// schema is empty or is not a json object as denoms can have free form schemas.
+// Unsupported keywords are rejected.
+func CompileDataSchema(schema string) (*DataSchema, error) {
+	return compileDataSchema(schema, true)
+}
+
+// compileDataSchema compiles a denom schema, unsupported keywords are ignored unless
+// strict as denoms created before the keywords were checked can use them
+func compileDataSchema(schema string, strict bool) (*DataSchema, error) {
+	if len(strings.TrimSpace(schema)) == 0 {
+		return nil, nil
+	}
+	value, err := decodeJSON(schema)
+	if err != nil {
+		return nil, nil
+	}
+	if _, ok := value.(map[string]interface{}); !ok {
+		return nil, nil
+	}
+	compiled, err := compileSchema(value, "#", 0, strict)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidSchema, err.Error())
+	}
+	return compiled, nil
+}
+
+// ValidateDataSchema validates onft data against the denom schema, gas is consumed
+// from the gas meter for the bytes parsed and the data nodes validated.
+func ValidateDataSchema(schema, data string, gasMeter storetypes.GasMeter) error {
+	gasMeter.ConsumeGas(uint64(len(schema))*SchemaValidationGasPerByte, "onft schema compilation")
+	compiled, err := compileDataSchema(schema, false)
+	if err != nil {
+		return err
+	}
+	if compiled == nil {
+		// denoms with free form schemas are not enforced
+		return nil
+	}
+	return compiled.Validate(data, gasMeter)
+}
+
+// Validate validates data against the schema, empty data is validated as an empty json object
+func (s *DataSchema) Validate(data string, gasMeter storetypes.GasMeter) error {
+	if len(data) > MaxSchemaDataLen {
+		return errorsmod.Wrapf(ErrInvalidData, "data length %d exceeds max length %d", len(data), MaxSchemaDataLen)
+	}
+	gasMeter.ConsumeGas(uint64(len(data))*SchemaValidationGasPerByte, "onft data parsing")
+	if len(strings.TrimSpace(data)) == 0 {
+		data = "{}"
+	}
+	value, err := decodeJSON(data)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidData, "data is not a valid json: %s", err.Error())
+	}
+	return s.validate(value, "$", gasMeter)
+}
+
+func (s *DataSchema) validate(value interface{}, path string, gasMeter storetypes.GasMeter) error {
+	gasMeter.ConsumeGas(SchemaValidationGasPerNode, "onft data schema validation")
+
+	if s.reject {
+		return schemaError(path, "value is not allowed")
+	}
+	if len(s.types) > 0 && !matchesAnyType(value, s.types) {
+		return schemaError(path, "expected %s, got %s", strings.Join(s.types, " or "), jsonType(value))
+	}
+	if s.hasConst && !jsonEqual(value, s.constant) {
+		return schemaError(path, "value must be equal to the const value")
+	}
+	if len(s.enum) > 0 {
+		found := false
+		for _, e := range s.enum {
+			if jsonEqual(value, e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return schemaError(path, "value must be one of the enum values")
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return s.validateObject(v, path, gasMeter)
+	case []interface{}:
+		return s.validateArray(v, path, gasMeter)
+	case string:
+		return s.validateString(v, path)
+	case json.Number:
+		return s.validateNumber(v, path)
+	}
+	return nil
+}
+
+func (s *DataSchema) validateObject(value map[string]interface{}, path string, gasMeter storetypes.GasMeter) error {
+	for _, key := range s.required {
+		if _, ok := value[key]; !ok {
+			return schemaError(path, "missing required property %q", key)
+		}
+	}
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		propertyPath := fmt.Sprintf("%s.%s", path, key)
+		if property, ok := s.properties[key]; ok {
+			if err := property.validate(value[key], propertyPath, gasMeter); err != nil {
+				return err
+			}
+			continue
+		}
+		if s.additionalProperties != nil {
+			if err := s.additionalProperties.validate(value[key], propertyPath, gasMeter); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *DataSchema) validateArray(value []interface{}, path string, gasMeter storetypes.GasMeter) error {
+	if s.minItems != nil && uint64(len(value)) < *s.minItems {
+		return schemaError(path, "expected at least %d items, got %d", *s.minItems, len(value))
+	}
+	if s.maxItems != nil && uint64(len(value)) > *s.maxItems {
+		return schemaError(path, "expected at most %d items, got %d", *s.maxItems, len(value))
+	}
+	if s.items == nil {
+		return nil
+	}
+	for i, item := range value {
+		if err := s.items.validate(item, fmt.Sprintf("%s[%d]", path, i), gasMeter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DataSchema) validateString(value, path string) error {
+	length := uint64(utf8.RuneCountInString(value))
+	if s.minLength != nil && length < *s.minLength {
+		return schemaError(path, "expected minimum length %d, got %d", *s.minLength, length)
+	}
+	if s.maxLength != nil && length > *s.maxLength {
+		return schemaError(path, "expected maximum length %d, got %d", *s.maxLength, length)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(value) {
+		return schemaError(path, "value does not match pattern %s", s.pattern.String())
+	}
+	return nil
+}
+
+func (s *DataSchema) validateNumber(value json.Number, path string) error {
+	number, ok := parseNumber(value)
+	if !ok {
+		return schemaError(path, "invalid number %s", value.String())
+	}
+	if s.minimum != nil && number.Cmp(s.minimum) < 0 {
+		return schemaError(path, "value must be >= %s", s.minimum.RatString())
+	}
+	if s.maximum != nil && number.Cmp(s.maximum) > 0 {
+		return schemaError(path, "value must be <= %s", s.maximum.RatString())
+	}
+	if s.exclusiveMinimum != nil && number.Cmp(s.exclusiveMinimum) <= 0 {
+		return schemaError(path, "value must be > %s", s.exclusiveMinimum.RatString())
+	}
+	if s.exclusiveMaximum != nil && number.Cmp(s.exclusiveMaximum) >= 0 {
+		return schemaError(path, "value must be < %s", s.exclusiveMaximum.RatString())
+	}
+	return nil
+}
+
+func compileSchema(value interface{}, path string, depth int, strict bool) (*DataSchema, error) {
+	if depth > MaxSchemaDepth {
+		return nil, fmt.Errorf("%s: schema exceeds max depth %d", path, MaxSchemaDepth)
+	}
+	switch v := value.(type) {
+	case bool:
+		return &DataSchema{reject: !v}, nil
+	case map[string]interface{}:
+		return compileSchemaObject(v, path, depth, strict)
+	default:
+		return nil, fmt.Errorf("%s: schema must be an object or a boolean", path)
+	}
+}
+
+func compileSchemaObject(m map[string]interface{}, path string, depth int, strict bool) (*DataSchema, error) {
+	if _, ok := m["$ref"]; ok {
+		return nil, fmt.Errorf("%s: $ref is not supported", path)
+	}
+	if strict {
+		keywords := make([]string, 0, len(m))
+		for keyword := range m {
+			keywords = append(keywords, keyword)
+		}
+		sort.Strings(keywords)
+		for _, keyword := range keywords {
+			if !schemaKeywords[keyword] {
+				return nil, fmt.Errorf("%s: %s is not supported", path, keyword)
+			}
+		}
+	}
+	s := &DataSchema{}
+	var err error
+
+	if v, ok := m["type"]; ok {
+		switch t := v.(type) {
+		case string:
+			s.types = []string{t}
+		case []interface{}:
+			for _, item := range t {
+				str, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s/type: must be a string or an array of strings", path)
+				}
+				s.types = append(s.types, str)
+			}
+		default:
+			return nil, fmt.Errorf("%s/type: must be a string or an array of strings", path)
+		}
+		for _, t := range s.types {
+			if !schemaTypes[t] {
+				return nil, fmt.Errorf("%s/type: unknown type %s", path, t)
+			}
+		}
+	}
+	if v, ok := m["enum"]; ok {
+		enum, ok := v.([]interface{})
+		if !ok || len(enum) == 0 {
+			return nil, fmt.Errorf("%s/enum: must be a non empty array", path)
+		}
+		s.enum = enum
+	}
+	if v, ok := m["const"]; ok {
+		s.constant = v
+		s.hasConst = true
+	}
+
+	if v, ok := m["properties"]; ok {
+		properties, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s/properties: must be an object", path)
+		}
+		s.properties = make(map[string]*DataSchema, len(properties))
+		for key, property := range properties {
+			s.properties[key], err = compileSchema(property, fmt.Sprintf("%s/properties/%s", path, key), depth+1, strict)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if v, ok := m["required"]; ok {
+		required, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s/required: must be an array of strings", path)
+		}
+		for _, item := range required {
+			key, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s/required: must be an array of strings", path)
+			}
+			s.required = append(s.required, key)
+		}
+	}
+	if v, ok := m["additionalProperties"]; ok {
+		s.additionalProperties, err = compileSchema(v, path+"/additionalProperties", depth+1, strict)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := m["items"]; ok {
+		s.items, err = compileSchema(v, path+"/items", depth+1, strict)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if s.minItems, err = schemaUint(m, "minItems", path); err != nil {
+		return nil, err
+	}
+	if s.maxItems, err = schemaUint(m, "maxItems", path); err != nil {
+		return nil, err
+	}
+
+	if s.minLength, err = schemaUint(m, "minLength", path); err != nil {
+		return nil, err
+	}
+	if s.maxLength, err = schemaUint(m, "maxLength", path); err != nil {
+		return nil, err
+	}
+	if v, ok := m["pattern"]; ok {
+		pattern, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s/pattern: must be a string", path)
+		}
+		// go regexp guarantees linear time matching
+		s.pattern, err = regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s/pattern: %s", path, err.Error())
+		}
+	}
+
+	if s.minimum, err = schemaRat(m, "minimum", path); err != nil {
+		return nil, err
+	}
+	if s.maximum, err = schemaRat(m, "maximum", path); err != nil {
+		return nil, err
+	}
+	if s.exclusiveMinimum, err = schemaRat(m, "exclusiveMinimum", path); err != nil {
+		return nil, err
+	}
+	if s.exclusiveMaximum, err = schemaRat(m, "exclusiveMaximum", path); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func schemaUint(m map[string]interface{}, keyword, path string) (*uint64, error) {
+	v, ok := m[keyword]
+	if !ok {
+		return nil, nil
+	}
+	number, ok := v.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s/%s: must be a non negative integer", path, keyword)
+	}
+	rat, ok := parseNumber(number)
+	if !ok || !rat.IsInt() || rat.Sign() < 0 || !rat.Num().IsUint64() {
+		return nil, fmt.Errorf("%s/%s: must be a non negative integer", path, keyword)
+	}
+	value := rat.Num().Uint64()
+	return &value, nil
+}
+
+func schemaRat(m map[string]interface{}, keyword, path string) (*big.Rat, error) {
+	v, ok := m[keyword]
+	if !ok {
+		return nil, nil
+	}
+	number, ok := v.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s/%s: must be a number", path, keyword)
+	}
+	rat, ok := parseNumber(number)
+	if !ok {
+		return nil, fmt.Errorf("%s/%s: must be a number", path, keyword)
+	}
+	return rat, nil
+}
+
+// parseNumber converts a json number to a rational, numbers longer than MaxNumberLen
+// or with an exponent beyond MaxNumberExponent are rejected before the conversion
+func parseNumber(value json.Number) (*big.Rat, bool) {
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(value.String()), "e")
+	if len(mantissa) > MaxNumberLen {
+		return nil, false
+	}
+	if hasExponent {
+		exp, err := strconv.ParseInt(exponent, 10, 32)
+		if err != nil || exp > MaxNumberExponent || exp < -MaxNumberExponent {
+			return nil, false
+		}
+	}
+	return new(big.Rat).SetString(value.String())
+}
+
+func decodeJSON(s string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after json value")
+	}
+	return value, nil
+}
+
+func jsonType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		if rat, ok := parseNumber(v); ok && rat.IsInt() {
+			return "integer"
+		}
+		return "number"
+	}
+	return "unknown"
+}
+
+func matchesAnyType(value interface{}, types []string) bool {
+	valueType := jsonType(value)
+	for _, t := range types {
+		if t == valueType || (t == "number" && valueType == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonEqual(a, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		ar, aok := parseNumber(av)
+		br, bok := parseNumber(bv)
+		return aok && bok && ar.Cmp(br) == 0
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok || !jsonEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+func schemaError(path, format string, args ...interface{}) error {
+	return errorsmod.Wrapf(
+		ErrInvalidData,
+		"data does not match denom schema at %s: %s", path, fmt.Sprintf(format, args...),
+	)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Trait defines a trait type and value of an oNFT
+type Trait struct {
+	TraitType string
+	Value     string
+}
+
+// key returns the length prefixed trait type and value, traits are free
+// form strings and can't be separated by a delimiter
+func (t Trait) key() []byte {
+	return append(address.MustLengthPrefix([]byte(t.TraitType)), address.MustLengthPrefix([]byte(t.Value))...)
+}
+
+// ParseTraits returns the distinct traits of the standard attributes array of the
+// nft data, ex: {"attributes": [{"trait_type": "background", "value": "blue"}]}.
+// Data without an attributes array has no traits, and attributes without a trait
+// type or with a null or nested value are skipped.
+func ParseTraits(data string) ([]Trait, error) {
+	var metadata struct {
+		Attributes []struct {
+			TraitType string          `json:"trait_type"`
+			Value     json.RawMessage `json:"value"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal([]byte(data), &metadata); err != nil {
+		// data that is not a json object has no traits
+		return nil, nil
+	}
+
+	var traits []Trait
+	seen := make(map[Trait]bool)
+	for _, attribute := range metadata.Attributes {
+		trait := Trait{TraitType: strings.TrimSpace(attribute.TraitType)}
+		if trait.TraitType == "" {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(attribute.Value, &value); err != nil {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			trait.Value = strings.TrimSpace(v)
+		case float64, bool:
+			trait.Value = string(attribute.Value)
+		default:
+			continue
+		}
+		if trait.Value == "" || seen[trait] {
+			continue
+		}
+		if len(trait.TraitType) > MaxTraitLen || len(trait.Value) > MaxTraitLen {
+			return nil, errorsmod.Wrapf(
+				ErrInvalidTraits,
+				"trait %s exceeds the max length %d", trait.TraitType, MaxTraitLen,
+			)
+		}
+		seen[trait] = true
+		traits = append(traits, trait)
+	}
+	if len(traits) > MaxTraits {
+		return nil, errorsmod.Wrapf(ErrInvalidTraits, "nft has %d traits, max %d", len(traits), MaxTraits)
+	}
+	return traits, nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewONFTUser(denomID, onftID string, user sdk.AccAddress, expiry time.Time) ONFTUser {
+	return ONFTUser{
+		DenomId: denomID,
+		OnftId:  onftID,
+		User:    user.String(),
+		Expiry:  expiry,
+	}
+}
+
+func (u ONFTUser) GetUser() sdk.AccAddress {
+	user, _ := sdk.AccAddressFromBech32(u.User)
+	return user
+}
+
+// IsExpired returns true if the expiry of the user role is not after the given time
+func (u ONFTUser) IsExpired(blockTime time.Time) bool {
+	return !u.Expiry.After(blockTime)
+}
+
+func (u ONFTUser) Validate() error {
+	if strings.TrimSpace(u.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if strings.TrimSpace(u.OnftId) == "" {
+		return errorsmod.Wrap(ErrInvalidONFTID, "missing onft id")
+	}
+	if _, err := sdk.AccAddressFromBech32(u.User); err != nil {
+		return errorsmod.Wrapf(ErrInvalidONFTUser, "invalid user address %s", u.User)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -170,3 +171,27 @@ func ValidateJSONString(data string) error {
 	}
 	return nil
 }
+
+func ValidateBatchSize(size int) error {
+	if size == 0 || size > MaxBatchSize {
+		return errorsmod.Wrapf(
+			ErrInvalidBatch,
+			"invalid batch size %d, must be between [1, %d]",
+			size,
+			MaxBatchSize,
+		)
+	}
+	return nil
+}
+
+func ValidateRevocationReason(reason string) error {
+	if len(strings.TrimSpace(reason)) == 0 || len(reason) > MaxReasonLen {
+		return errorsmod.Wrapf(
+			ErrInvalidRevocation,
+			"invalid reason %s, length must be between [1, %d]",
+			reason,
+			MaxReasonLen,
+		)
+	}
+	return nil
+}
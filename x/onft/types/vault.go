@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VaultSubdenom returns the tokenfactory subdenom of the fractions of a vault
+func VaultSubdenom(vaultID uint64) string {
+	return fmt.Sprintf("vault%d", vaultID)
+}
+
+func (v Vault) GetDepositor() sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(v.Depositor)
+	return depositor
+}
+
+// HasBuyout returns true if the vault can be bought out
+func (v Vault) HasBuyout() bool {
+	return v.BuyoutPrice.IsPositive()
+}
+
+func (v Vault) Validate() error {
+	if v.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidVault, "vault id must be positive")
+	}
+	if strings.TrimSpace(v.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if err := ValidateONFTID(v.OnftId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Depositor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVault, "invalid depositor address %s", v.Depositor)
+	}
+	if err := sdk.ValidateDenom(v.FractionDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVault, "invalid fraction denom %s", v.FractionDenom)
+	}
+	if v.Supply.IsNil() || !v.Supply.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidVault, "supply must be positive")
+	}
+	if !v.BuyoutPrice.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidVault, "invalid buyout price %s", v.BuyoutPrice.String())
+	}
+	if _, ok := VaultStatus_name[int32(v.Status)]; !ok || v.Status == VaultStatus_VAULT_STATUS_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidVault, "invalid status %s", v.Status.String())
+	}
+	return nil
+}
@@ -0,0 +1,796 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/vault.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VaultStatus defines the status of a fractionalization vault
+type VaultStatus int32
+
+const (
+	VaultStatus_VAULT_STATUS_UNSPECIFIED VaultStatus = 0
+	// the oNFT is locked in the vault and fractions are circulating
+	VaultStatus_VAULT_STATUS_ACTIVE VaultStatus = 1
+	// the oNFT is released to the holder who burned the full fraction supply
+	VaultStatus_VAULT_STATUS_REDEEMED VaultStatus = 2
+	// the oNFT is bought out, fraction holders can claim the buyout proceeds
+	VaultStatus_VAULT_STATUS_BOUGHT_OUT VaultStatus = 3
+)
+
+var VaultStatus_name = map[int32]string{
+	0: "VAULT_STATUS_UNSPECIFIED",
+	1: "VAULT_STATUS_ACTIVE",
+	2: "VAULT_STATUS_REDEEMED",
+	3: "VAULT_STATUS_BOUGHT_OUT",
+}
+
+var VaultStatus_value = map[string]int32{
+	"VAULT_STATUS_UNSPECIFIED": 0,
+	"VAULT_STATUS_ACTIVE":      1,
+	"VAULT_STATUS_REDEEMED":    2,
+	"VAULT_STATUS_BOUGHT_OUT":  3,
+}
+
+func (x VaultStatus) String() string {
+	return proto.EnumName(VaultStatus_name, int32(x))
+}
+
+func (VaultStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_be23e2ba8a6ca808, []int{0}
+}
+
+// Vault defines an oNFT locked in the onft module account and fractionalized
+// into a fixed supply of a tokenfactory denom
+type Vault struct {
+	Id            uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DenomId       string                `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId        string                `protobuf:"bytes,3,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Depositor     string                `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	FractionDenom string                `protobuf:"bytes,5,opt,name=fraction_denom,json=fractionDenom,proto3" json:"fraction_denom,omitempty" yaml:"fraction_denom"`
+	Supply        cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// buyout_price of the oNFT, zero amount disables the buyout
+	BuyoutPrice types.Coin  `protobuf:"bytes,7,opt,name=buyout_price,json=buyoutPrice,proto3" json:"buyout_price" yaml:"buyout_price"`
+	Status      VaultStatus `protobuf:"varint,8,opt,name=status,proto3,enum=OmniFlix.onft.v1beta1.VaultStatus" json:"status,omitempty"`
+	// buyer of the oNFT when bought out
+	Buyer string `protobuf:"bytes,9,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// unclaimed buyout proceeds of the fraction holders
+	Proceeds types.Coin `protobuf:"bytes,10,opt,name=proceeds,proto3" json:"proceeds"`
+	// fractions not yet burned for the buyout proceeds
+	Unclaimed cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=unclaimed,proto3,customtype=cosmossdk.io/math.Int" json:"unclaimed"`
+}
+
+func (m *Vault) Reset()         { *m = Vault{} }
+func (m *Vault) String() string { return proto.CompactTextString(m) }
+func (*Vault) ProtoMessage()    {}
+func (*Vault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be23e2ba8a6ca808, []int{0}
+}
+func (m *Vault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vault.Merge(m, src)
+}
+func (m *Vault) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vault proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("OmniFlix.onft.v1beta1.VaultStatus", VaultStatus_name, VaultStatus_value)
+	proto.RegisterType((*Vault)(nil), "OmniFlix.onft.v1beta1.Vault")
+}
+
+func init() { proto.RegisterFile("OmniFlix/onft/v1beta1/vault.proto", fileDescriptor_be23e2ba8a6ca808) }
+
+var fileDescriptor_be23e2ba8a6ca808 = []byte{
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6a, 0xdb, 0x4e,
+	0x14, 0xc5, 0x25, 0xc7, 0x71, 0xe2, 0xf1, 0xff, 0xef, 0x9a, 0x49, 0x4c, 0x94, 0x8f, 0x4a, 0xae,
+	0x56, 0xa6, 0x85, 0x11, 0x49, 0x69, 0x17, 0xc9, 0xa6, 0x71, 0xac, 0xb4, 0x82, 0xa6, 0x09, 0xb2,
+	0x1c, 0x68, 0x37, 0x42, 0x5f, 0x89, 0x87, 0x4a, 0x1a, 0x21, 0x8d, 0x4c, 0x0c, 0x7d, 0x88, 0x3e,
+	0x4b, 0x9f, 0xc2, 0xcb, 0x2c, 0x4b, 0x17, 0xa2, 0xb5, 0xdf, 0xc0, 0x4f, 0x50, 0x46, 0xb2, 0xe3,
+	0x18, 0x0a, 0xed, 0xee, 0xde, 0x39, 0xbf, 0x7b, 0xb8, 0x9c, 0xe1, 0x82, 0x67, 0x97, 0x41, 0x88,
+	0xcf, 0x7d, 0x7c, 0xa7, 0x90, 0xf0, 0x86, 0x2a, 0xc3, 0x43, 0xdb, 0xa3, 0xd6, 0xa1, 0x32, 0xb4,
+	0x52, 0x9f, 0xa2, 0x28, 0x26, 0x94, 0xc0, 0xe6, 0x02, 0x41, 0x0c, 0x41, 0x73, 0x64, 0x6f, 0xfb,
+	0x96, 0xdc, 0x92, 0x9c, 0x50, 0x58, 0x55, 0xc0, 0x7b, 0xa2, 0x43, 0x92, 0x80, 0x24, 0x8a, 0x6d,
+	0x25, 0xde, 0x83, 0x9b, 0x43, 0x70, 0x58, 0xe8, 0xf2, 0xb7, 0x32, 0x58, 0xbf, 0x66, 0xe6, 0xb0,
+	0x0e, 0x4a, 0xd8, 0x15, 0xf8, 0x16, 0xdf, 0x2e, 0xeb, 0x25, 0xec, 0x42, 0x04, 0x36, 0x5d, 0x2f,
+	0x24, 0x81, 0x89, 0x5d, 0xa1, 0xd4, 0xe2, 0xdb, 0xd5, 0xce, 0xd6, 0x2c, 0x93, 0x9e, 0x8c, 0xac,
+	0xc0, 0x3f, 0x96, 0x17, 0x8a, 0xac, 0x6f, 0xe4, 0xa5, 0xe6, 0xc2, 0x17, 0x60, 0x83, 0xed, 0xc3,
+	0xf0, 0xb5, 0x1c, 0x87, 0xb3, 0x4c, 0xaa, 0x17, 0xf8, 0x5c, 0x90, 0xf5, 0x0a, 0xab, 0x34, 0x17,
+	0x1e, 0x80, 0xaa, 0xeb, 0x45, 0x24, 0xc1, 0x94, 0xc4, 0x42, 0x99, 0xe1, 0xfa, 0xf2, 0x01, 0xbe,
+	0x01, 0xf5, 0x9b, 0xd8, 0x72, 0x28, 0x26, 0xa1, 0x99, 0xdb, 0x0b, 0xeb, 0xb9, 0xe3, 0xee, 0x2c,
+	0x93, 0x9a, 0x85, 0xe3, 0xaa, 0x2e, 0xeb, 0xff, 0x2f, 0x1e, 0xba, 0xac, 0x87, 0xaf, 0x40, 0x25,
+	0x49, 0xa3, 0xc8, 0x1f, 0x09, 0x95, 0x7c, 0xf2, 0xe9, 0x38, 0x93, 0xb8, 0x1f, 0x99, 0xd4, 0x2c,
+	0xe2, 0x48, 0xdc, 0xcf, 0x08, 0x13, 0x25, 0xb0, 0xe8, 0x00, 0x69, 0x21, 0xd5, 0xe7, 0x30, 0xfc,
+	0x08, 0xfe, 0xb3, 0xd3, 0x11, 0x49, 0xa9, 0x19, 0xc5, 0xd8, 0xf1, 0x84, 0x8d, 0x16, 0xdf, 0xae,
+	0x1d, 0xed, 0xa2, 0x62, 0x0a, 0xb1, 0x10, 0x17, 0x79, 0xa3, 0x33, 0x82, 0xc3, 0xce, 0x3e, 0xf3,
+	0x9d, 0x65, 0xd2, 0x56, 0xb1, 0xd5, 0xe3, 0x61, 0x59, 0xaf, 0x15, 0xed, 0x15, 0xeb, 0xe0, 0x31,
+	0xa8, 0x24, 0xd4, 0xa2, 0x69, 0x22, 0x6c, 0xb6, 0xf8, 0x76, 0xfd, 0x48, 0x46, 0x7f, 0xfc, 0x46,
+	0x94, 0x7f, 0x46, 0x2f, 0x27, 0xf5, 0xf9, 0x04, 0xdc, 0x06, 0xeb, 0x76, 0x3a, 0xf2, 0x62, 0xa1,
+	0x9a, 0x27, 0x55, 0x34, 0xf0, 0x04, 0x6c, 0x46, 0x31, 0x71, 0x3c, 0xcf, 0x4d, 0x04, 0xf0, 0xb7,
+	0x45, 0xcb, 0x6c, 0x51, 0xfd, 0x61, 0x00, 0x9e, 0x80, 0x6a, 0x1a, 0x3a, 0xbe, 0x85, 0x03, 0xcf,
+	0x15, 0x6a, 0xff, 0x92, 0xd1, 0x92, 0x7f, 0xfe, 0x05, 0xd4, 0x1e, 0xad, 0x09, 0x0f, 0x80, 0x70,
+	0x7d, 0xda, 0x7f, 0x6f, 0x98, 0x3d, 0xe3, 0xd4, 0xe8, 0xf7, 0xcc, 0xfe, 0x87, 0xde, 0x95, 0x7a,
+	0xa6, 0x9d, 0x6b, 0x6a, 0xb7, 0xc1, 0xc1, 0x1d, 0xb0, 0xb5, 0xa2, 0x9e, 0x9e, 0x19, 0xda, 0xb5,
+	0xda, 0xe0, 0xe1, 0x2e, 0x68, 0xae, 0x08, 0xba, 0xda, 0x55, 0xd5, 0x0b, 0xb5, 0xdb, 0x28, 0xc1,
+	0x7d, 0xb0, 0xb3, 0x22, 0x75, 0x2e, 0xfb, 0x6f, 0xdf, 0x19, 0xe6, 0x65, 0xdf, 0x68, 0xac, 0x75,
+	0x2e, 0xc6, 0xbf, 0x44, 0x6e, 0x3c, 0x11, 0xf9, 0xfb, 0x89, 0xc8, 0xff, 0x9c, 0x88, 0xfc, 0xd7,
+	0xa9, 0xc8, 0xdd, 0x4f, 0x45, 0xee, 0xfb, 0x54, 0xe4, 0x3e, 0x29, 0xb7, 0x98, 0x0e, 0x52, 0x1b,
+	0x39, 0x24, 0x50, 0x96, 0xb7, 0x14, 0x84, 0xf8, 0xc6, 0xc7, 0x77, 0x83, 0xd4, 0x56, 0x86, 0xaf,
+	0x95, 0xf9, 0x71, 0xd1, 0x51, 0xe4, 0x25, 0x76, 0x25, 0x3f, 0x84, 0x97, 0xbf, 0x07, 0x00, 0x62,
+	0xd6, 0x5c, 0xe9, 0x7a, 0x03, 0x00, 0x00,
+}
+
+func (m *Vault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Unclaimed.Size()
+		i -= size
+		if _, err := m.Unclaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Proceeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.BuyoutPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.FractionDenom) > 0 {
+		i -= len(m.FractionDenom)
+		copy(dAtA[i:], m.FractionDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.FractionDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVault(uint64(m.Id))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.FractionDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.BuyoutPrice.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovVault(uint64(m.Status))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Proceeds.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.Unclaimed.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Vault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FractionDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FractionDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyoutPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyoutPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VaultStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unclaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unclaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVault(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVault
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVault
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVault
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVault        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVault          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVault = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (v MintVoucher) GetSigner() sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(v.Signer)
+	return signer
+}
+
+// IsExpired returns true if the expiry of the voucher is not after the given time
+func (v MintVoucher) IsExpired(blockTime time.Time) bool {
+	return !v.Expiry.After(blockTime)
+}
+
+func (v MintVoucher) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Signer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVoucher, "invalid signer address %s", v.Signer)
+	}
+	if strings.TrimSpace(v.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if err := ValidateName(v.Metadata.Name); err != nil {
+		return err
+	}
+	if err := ValidateDescription(v.Metadata.Description); err != nil {
+		return err
+	}
+	if err := ValidateMediaURI(v.Metadata.MediaURI); err != nil {
+		return err
+	}
+	if err := ValidateURI(v.Metadata.PreviewURI); err != nil {
+		return err
+	}
+	if v.RoyaltyShare.IsNil() || v.RoyaltyShare.IsNegative() || v.RoyaltyShare.GTE(sdkmath.LegacyNewDec(1)) {
+		return errorsmod.Wrapf(ErrInvalidPercentage, "invalid royalty share percentage decimal value; %s, must be positive and less than 1", v.RoyaltyShare)
+	}
+	if v.RoyaltyReceivers != nil {
+		if err := ValidateWeightedAddresses(v.RoyaltyReceivers); err != nil {
+			return errorsmod.Wrap(ErrInvalidRoyaltyReceivers, "royalty receivers value is invalid")
+		}
+	}
+	if !v.Price.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidVoucher, "invalid price %s", v.Price.String())
+	}
+	if v.Expiry.IsZero() {
+		return errorsmod.Wrap(ErrInvalidVoucher, "expiry is required")
+	}
+	return ValidateONFTID(v.Id)
+}
+
+// GetSignBytes returns the bytes signed by the voucher signer, the chain id binds
+// the voucher to a single chain
+func (v MintVoucher) GetSignBytes(chainID string) []byte {
+	signDoc := MintVoucherSignDoc{
+		ChainId: chainID,
+		Voucher: v,
+	}
+	bz, err := signDoc.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (n VoucherNonce) GetSigner() sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(n.Signer)
+	return signer
+}
+
+func (n VoucherNonce) Validate() error {
+	if strings.TrimSpace(n.DenomId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenom, "missing denom id")
+	}
+	if _, err := sdk.AccAddressFromBech32(n.Signer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVoucher, "invalid signer address %s", n.Signer)
+	}
+	return nil
+}
@@ -7,6 +7,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MintTo mints amount of a tokenfactory denom to mintTo, callers are responsible
+// for checking the denom admin
+func (k Keeper) MintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
+	return k.mintTo(ctx, amount, mintTo)
+}
+
+// BurnFrom burns amount of a tokenfactory denom from burnFrom, callers are responsible
+// for checking the denom admin
+func (k Keeper) BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
+	return k.burnFrom(ctx, amount, burnFrom)
+}
+
 func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
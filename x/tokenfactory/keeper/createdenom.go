@@ -23,6 +23,23 @@ func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string
 	return denom, err
 }
 
+// CreateDenomWithPayer creates a denom administered by creatorAddr and charges the
+// denom creation fee to payerAddr, used by modules creating denoms for their users
+func (k Keeper) CreateDenomWithPayer(ctx sdk.Context, creatorAddr, subdenom, payerAddr string) (newTokenDenom string, err error) {
+	denom, err := k.validateCreateDenom(ctx, creatorAddr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	err = k.chargeForCreateDenom(ctx, payerAddr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
+	return denom, err
+}
+
 // Runs CreateDenom logic after the charge and all denom validation has been handled.
 // Made into a second function for genesis initialization.
 func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string, denom string) (err error) {